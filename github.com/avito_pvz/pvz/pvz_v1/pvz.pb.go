@@ -22,6 +22,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Приостановленный ПВЗ не принимает поставки, архивный не возвращается в работу
+type PVZStatus int32
+
+const (
+	PVZStatus_PVZ_STATUS_UNSPECIFIED PVZStatus = 0
+	PVZStatus_PVZ_STATUS_ACTIVE      PVZStatus = 1
+	PVZStatus_PVZ_STATUS_SUSPENDED   PVZStatus = 2
+	PVZStatus_PVZ_STATUS_ARCHIVED    PVZStatus = 3
+)
+
+// Enum value maps for PVZStatus.
+var (
+	PVZStatus_name = map[int32]string{
+		0: "PVZ_STATUS_UNSPECIFIED",
+		1: "PVZ_STATUS_ACTIVE",
+		2: "PVZ_STATUS_SUSPENDED",
+		3: "PVZ_STATUS_ARCHIVED",
+	}
+	PVZStatus_value = map[string]int32{
+		"PVZ_STATUS_UNSPECIFIED": 0,
+		"PVZ_STATUS_ACTIVE":      1,
+		"PVZ_STATUS_SUSPENDED":   2,
+		"PVZ_STATUS_ARCHIVED":    3,
+	}
+)
+
+func (x PVZStatus) Enum() *PVZStatus {
+	p := new(PVZStatus)
+	*p = x
+	return p
+}
+
+func (x PVZStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pvz_proto_enumTypes[0].Descriptor()
+}
+
+func (PVZStatus) Type() protoreflect.EnumType {
+	return &file_proto_pvz_proto_enumTypes[0]
+}
+
+func (x PVZStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZStatus.Descriptor instead.
+func (PVZStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{0}
+}
+
 type ReceptionStatus int32
 
 const (
@@ -52,11 +105,11 @@ func (x ReceptionStatus) String() string {
 }
 
 func (ReceptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_pvz_proto_enumTypes[0].Descriptor()
+	return file_proto_pvz_proto_enumTypes[1].Descriptor()
 }
 
 func (ReceptionStatus) Type() protoreflect.EnumType {
-	return &file_proto_pvz_proto_enumTypes[0]
+	return &file_proto_pvz_proto_enumTypes[1]
 }
 
 func (x ReceptionStatus) Number() protoreflect.EnumNumber {
@@ -65,21 +118,305 @@ func (x ReceptionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReceptionStatus.Descriptor instead.
 func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+// Поставка приходит от перевозчика, возвраты приносят получатели
+type ReceptionKind int32
+
+const (
+	ReceptionKind_RECEPTION_KIND_UNSPECIFIED     ReceptionKind = 0
+	ReceptionKind_RECEPTION_KIND_INBOUND         ReceptionKind = 1
+	ReceptionKind_RECEPTION_KIND_CUSTOMER_RETURN ReceptionKind = 2
+)
+
+// Enum value maps for ReceptionKind.
+var (
+	ReceptionKind_name = map[int32]string{
+		0: "RECEPTION_KIND_UNSPECIFIED",
+		1: "RECEPTION_KIND_INBOUND",
+		2: "RECEPTION_KIND_CUSTOMER_RETURN",
+	}
+	ReceptionKind_value = map[string]int32{
+		"RECEPTION_KIND_UNSPECIFIED":     0,
+		"RECEPTION_KIND_INBOUND":         1,
+		"RECEPTION_KIND_CUSTOMER_RETURN": 2,
+	}
+)
+
+func (x ReceptionKind) Enum() *ReceptionKind {
+	p := new(ReceptionKind)
+	*p = x
+	return p
+}
+
+func (x ReceptionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceptionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pvz_proto_enumTypes[2].Descriptor()
+}
+
+func (ReceptionKind) Type() protoreflect.EnumType {
+	return &file_proto_pvz_proto_enumTypes[2]
+}
+
+func (x ReceptionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceptionKind.Descriptor instead.
+func (ReceptionKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+// UNSPECIFIED - приемку закрыл сотрудник ПВЗ
+type ReceptionCloseReason int32
+
+const (
+	ReceptionCloseReason_RECEPTION_CLOSE_REASON_UNSPECIFIED ReceptionCloseReason = 0
+	// В приемку долго не добавляли товары, ее закрыл сервис
+	ReceptionCloseReason_RECEPTION_CLOSE_REASON_TIMED_OUT ReceptionCloseReason = 1
+)
+
+// Enum value maps for ReceptionCloseReason.
+var (
+	ReceptionCloseReason_name = map[int32]string{
+		0: "RECEPTION_CLOSE_REASON_UNSPECIFIED",
+		1: "RECEPTION_CLOSE_REASON_TIMED_OUT",
+	}
+	ReceptionCloseReason_value = map[string]int32{
+		"RECEPTION_CLOSE_REASON_UNSPECIFIED": 0,
+		"RECEPTION_CLOSE_REASON_TIMED_OUT":   1,
+	}
+)
+
+func (x ReceptionCloseReason) Enum() *ReceptionCloseReason {
+	p := new(ReceptionCloseReason)
+	*p = x
+	return p
+}
+
+func (x ReceptionCloseReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceptionCloseReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pvz_proto_enumTypes[3].Descriptor()
+}
+
+func (ReceptionCloseReason) Type() protoreflect.EnumType {
+	return &file_proto_pvz_proto_enumTypes[3]
+}
+
+func (x ReceptionCloseReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceptionCloseReason.Descriptor instead.
+func (ReceptionCloseReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+// Принятый товар раскладывается для выдачи, затем выдается получателю или возвращается по отказу
+type ProductStatus int32
+
+const (
+	ProductStatus_PRODUCT_STATUS_UNSPECIFIED      ProductStatus = 0
+	ProductStatus_PRODUCT_STATUS_ACCEPTED         ProductStatus = 1
+	ProductStatus_PRODUCT_STATUS_READY_FOR_PICKUP ProductStatus = 2
+	ProductStatus_PRODUCT_STATUS_ISSUED           ProductStatus = 3
+	ProductStatus_PRODUCT_STATUS_REFUSED          ProductStatus = 4
+)
+
+// Enum value maps for ProductStatus.
+var (
+	ProductStatus_name = map[int32]string{
+		0: "PRODUCT_STATUS_UNSPECIFIED",
+		1: "PRODUCT_STATUS_ACCEPTED",
+		2: "PRODUCT_STATUS_READY_FOR_PICKUP",
+		3: "PRODUCT_STATUS_ISSUED",
+		4: "PRODUCT_STATUS_REFUSED",
+	}
+	ProductStatus_value = map[string]int32{
+		"PRODUCT_STATUS_UNSPECIFIED":      0,
+		"PRODUCT_STATUS_ACCEPTED":         1,
+		"PRODUCT_STATUS_READY_FOR_PICKUP": 2,
+		"PRODUCT_STATUS_ISSUED":           3,
+		"PRODUCT_STATUS_REFUSED":          4,
+	}
+)
+
+func (x ProductStatus) Enum() *ProductStatus {
+	p := new(ProductStatus)
+	*p = x
+	return p
+}
+
+func (x ProductStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pvz_proto_enumTypes[4].Descriptor()
+}
+
+func (ProductStatus) Type() protoreflect.EnumType {
+	return &file_proto_pvz_proto_enumTypes[4]
+}
+
+func (x ProductStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductStatus.Descriptor instead.
+func (ProductStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+type PVZEventType int32
+
+const (
+	PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED            PVZEventType = 0
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED       PVZEventType = 1
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED          PVZEventType = 2
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED        PVZEventType = 3
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED       PVZEventType = 4
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED     PVZEventType = 5
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_STATUS_CHANGED PVZEventType = 6
+	PVZEventType_PVZ_EVENT_TYPE_PVZ_NEARLY_FULL        PVZEventType = 7
+)
+
+// Enum value maps for PVZEventType.
+var (
+	PVZEventType_name = map[int32]string{
+		0: "PVZ_EVENT_TYPE_UNSPECIFIED",
+		1: "PVZ_EVENT_TYPE_RECEPTION_OPENED",
+		2: "PVZ_EVENT_TYPE_PRODUCT_ADDED",
+		3: "PVZ_EVENT_TYPE_PRODUCT_DELETED",
+		4: "PVZ_EVENT_TYPE_RECEPTION_CLOSED",
+		5: "PVZ_EVENT_TYPE_RECEPTION_REOPENED",
+		6: "PVZ_EVENT_TYPE_PRODUCT_STATUS_CHANGED",
+		7: "PVZ_EVENT_TYPE_PVZ_NEARLY_FULL",
+	}
+	PVZEventType_value = map[string]int32{
+		"PVZ_EVENT_TYPE_UNSPECIFIED":            0,
+		"PVZ_EVENT_TYPE_RECEPTION_OPENED":       1,
+		"PVZ_EVENT_TYPE_PRODUCT_ADDED":          2,
+		"PVZ_EVENT_TYPE_PRODUCT_DELETED":        3,
+		"PVZ_EVENT_TYPE_RECEPTION_CLOSED":       4,
+		"PVZ_EVENT_TYPE_RECEPTION_REOPENED":     5,
+		"PVZ_EVENT_TYPE_PRODUCT_STATUS_CHANGED": 6,
+		"PVZ_EVENT_TYPE_PVZ_NEARLY_FULL":        7,
+	}
+)
+
+func (x PVZEventType) Enum() *PVZEventType {
+	p := new(PVZEventType)
+	*p = x
+	return p
+}
+
+func (x PVZEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pvz_proto_enumTypes[5].Descriptor()
+}
+
+func (PVZEventType) Type() protoreflect.EnumType {
+	return &file_proto_pvz_proto_enumTypes[5]
+}
+
+func (x PVZEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZEventType.Descriptor instead.
+func (PVZEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+// Широта и долгота в градусах
+type Coordinates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	mi := &file_proto_pvz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
 	return file_proto_pvz_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Coordinates) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
 type PVZ struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Address          string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Не заданы у ПВЗ, созданных до появления адресов
+	Coordinates  *Coordinates `protobuf:"bytes,5,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	WorkingHours string       `protobuf:"bytes,6,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Phone        string       `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	Status       PVZStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	// Причина приостановки или архивации
+	StatusReason string `protobuf:"bytes,9,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// Вместимость в товарах, 0 - без ограничения
+	Capacity int32 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Occupied int32 `protobuf:"varint,11,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	// ПВЗ заполнен не меньше чем на 90%
+	NearlyFull    bool `protobuf:"varint,12,opt,name=nearly_full,json=nearlyFull,proto3" json:"nearly_full,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PVZ) Reset() {
 	*x = PVZ{}
-	mi := &file_proto_pvz_proto_msgTypes[0]
+	mi := &file_proto_pvz_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -91,7 +428,7 @@ func (x *PVZ) String() string {
 func (*PVZ) ProtoMessage() {}
 
 func (x *PVZ) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pvz_proto_msgTypes[0]
+	mi := &file_proto_pvz_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +441,7 @@ func (x *PVZ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PVZ.ProtoReflect.Descriptor instead.
 func (*PVZ) Descriptor() ([]byte, []int) {
-	return file_proto_pvz_proto_rawDescGZIP(), []int{0}
+	return file_proto_pvz_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *PVZ) GetId() string {
@@ -128,63 +465,102 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
-type GetPVZListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PVZ) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *GetPVZListRequest) Reset() {
-	*x = GetPVZListRequest{}
-	mi := &file_proto_pvz_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PVZ) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
 }
 
-func (x *GetPVZListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PVZ) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
 }
 
-func (*GetPVZListRequest) ProtoMessage() {}
+func (x *PVZ) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
 
-func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pvz_proto_msgTypes[1]
+func (x *PVZ) GetStatus() PVZStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return PVZStatus_PVZ_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
-func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pvz_proto_rawDescGZIP(), []int{1}
+func (x *PVZ) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
 }
 
-type GetPVZListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+func (x *PVZ) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PVZ) GetOccupied() int32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+func (x *PVZ) GetNearlyFull() bool {
+	if x != nil {
+		return x.NearlyFull
+	}
+	return false
+}
+
+type Reception struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId    string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status   ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	// Заполняются, если модератор открыл приемку повторно
+	ReopenReason string                 `protobuf:"bytes,5,opt,name=reopen_reason,json=reopenReason,proto3" json:"reopen_reason,omitempty"`
+	ReopenedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at,omitempty"`
+	Kind         ReceptionKind          `protobuf:"varint,7,opt,name=kind,proto3,enum=pvz.v1.ReceptionKind" json:"kind,omitempty"`
+	// Квота товаров в приемке, 0 - без ограничения
+	MaxItems int32 `protobuf:"varint,8,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	// Заполняются, если приемку закрыл сервис: closed_by = "system"
+	ClosedBy      string               `protobuf:"bytes,9,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	CloseReason   ReceptionCloseReason `protobuf:"varint,10,opt,name=close_reason,json=closeReason,proto3,enum=pvz.v1.ReceptionCloseReason" json:"close_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListResponse) Reset() {
-	*x = GetPVZListResponse{}
+func (x *Reception) Reset() {
+	*x = Reception{}
 	mi := &file_proto_pvz_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListResponse) String() string {
+func (x *Reception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListResponse) ProtoMessage() {}
+func (*Reception) ProtoMessage() {}
 
-func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+func (x *Reception) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pvz_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,37 +572,3590 @@ func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
-func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
 	return file_proto_pvz_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+func (x *Reception) GetId() string {
 	if x != nil {
-		return x.Pvzs
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
 	}
 	return nil
 }
 
-var File_proto_pvz_proto protoreflect.FileDescriptor
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
 
-const file_proto_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\x0fproto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
-	"\x0fReceptionStatus\x12 \n" +
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *Reception) GetReopenReason() string {
+	if x != nil {
+		return x.ReopenReason
+	}
+	return ""
+}
+
+func (x *Reception) GetReopenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReopenedAt
+	}
+	return nil
+}
+
+func (x *Reception) GetKind() ReceptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReceptionKind_RECEPTION_KIND_UNSPECIFIED
+}
+
+func (x *Reception) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *Reception) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *Reception) GetCloseReason() ReceptionCloseReason {
+	if x != nil {
+		return x.CloseReason
+	}
+	return ReceptionCloseReason_RECEPTION_CLOSE_REASON_UNSPECIFIED
+}
+
+type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	// Внешний номер заказа или отправления, пустой если не указан
+	OrderId         string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Barcode         string                 `protobuf:"bytes,6,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Status          ProductStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=pvz.v1.ProductStatus" json:"status,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	// Заполняются у товаров из приемки возвратов
+	OriginalProductId string `protobuf:"bytes,9,opt,name=original_product_id,json=originalProductId,proto3" json:"original_product_id,omitempty"`
+	ReturnReason      string `protobuf:"bytes,10,opt,name=return_reason,json=returnReason,proto3" json:"return_reason,omitempty"`
+	// Ячейка хранения, пустая если раскладка ПВЗ не задана
+	CellId        string `protobuf:"bytes,11,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *Product) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *Product) GetOriginalProductId() string {
+	if x != nil {
+		return x.OriginalProductId
+	}
+	return ""
+}
+
+func (x *Product) GetReturnReason() string {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ""
+}
+
+func (x *Product) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type GetPVZListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Архивные ПВЗ по умолчанию не возвращаются
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPVZListRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetPVZListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+type ReceptionWithProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_proto_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZWithReceptions struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pvz           *PVZ                     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionWithProducts `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZWithReceptions) Reset() {
+	*x = PVZWithReceptions{}
+	mi := &file_proto_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZWithReceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZWithReceptions) ProtoMessage() {}
+
+func (x *PVZWithReceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZWithReceptions.ProtoReflect.Descriptor instead.
+func (*PVZWithReceptions) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PVZWithReceptions) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+// Фильтр по дате регистрации ПВЗ и постраничная выборка, как в GET /pvz.
+// Нулевые page и limit означают значения по умолчанию (1 и 10).
+type ListPVZRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// Оставляет в ответе только приемки этого вида, UNSPECIFIED означает любой вид
+	ReceptionKind ReceptionKind `protobuf:"varint,6,opt,name=reception_kind,json=receptionKind,proto3,enum=pvz.v1.ReceptionKind" json:"reception_kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZRequest) Reset() {
+	*x = ListPVZRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZRequest) ProtoMessage() {}
+
+func (x *ListPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZRequest.ProtoReflect.Descriptor instead.
+func (*ListPVZRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPVZRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListPVZRequest) GetReceptionKind() ReceptionKind {
+	if x != nil {
+		return x.ReceptionKind
+	}
+	return ReceptionKind_RECEPTION_KIND_UNSPECIFIED
+}
+
+type ListPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs  []*PVZWithReceptions   `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	// Номер следующей страницы, 0 если страница последняя
+	NextPage      int32 `protobuf:"varint,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZResponse) Reset() {
+	*x = ListPVZResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZResponse) ProtoMessage() {}
+
+func (x *ListPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZResponse.ProtoReflect.Descriptor instead.
+func (*ListPVZResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPVZResponse) GetPvzs() []*PVZWithReceptions {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *ListPVZResponse) GetNextPage() int32 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+// Все поля, кроме города, необязательны. Телефон - в формате E.164.
+type CreatePVZRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	City         string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Address      string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Coordinates  *Coordinates           `protobuf:"bytes,3,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	WorkingHours string                 `protobuf:"bytes,4,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Phone        string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	// 0 - вместимость не ограничена
+	Capacity      int32 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *CreatePVZRequest) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+// Поиск ПВЗ в радиусе от точки, как в GET /pvz/nearest.
+// Нулевые radius и limit означают значения по умолчанию (5000 метров и 10).
+type FindNearestPVZRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Point *Coordinates           `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	// Радиус поиска в метрах
+	Radius        float64 `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	Limit         int32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestPVZRequest) Reset() {
+	*x = FindNearestPVZRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestPVZRequest) ProtoMessage() {}
+
+func (x *FindNearestPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestPVZRequest.ProtoReflect.Descriptor instead.
+func (*FindNearestPVZRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindNearestPVZRequest) GetPoint() *Coordinates {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *FindNearestPVZRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *FindNearestPVZRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyPVZ struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pvz   *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	// Расстояние до ПВЗ в метрах
+	Distance      float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
+	mi := &file_proto_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPVZ) ProtoMessage() {}
+
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NearbyPVZ) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type FindNearestPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*NearbyPVZ           `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestPVZResponse) Reset() {
+	*x = FindNearestPVZResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestPVZResponse) ProtoMessage() {}
+
+func (x *FindNearestPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestPVZResponse.ProtoReflect.Descriptor instead.
+func (*FindNearestPVZResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FindNearestPVZResponse) GetPvzs() []*NearbyPVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+// Для приостановки и архивации причина обязательна
+type ChangePVZStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        PVZStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePVZStatusRequest) Reset() {
+	*x = ChangePVZStatusRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePVZStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePVZStatusRequest) ProtoMessage() {}
+
+func (x *ChangePVZStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePVZStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangePVZStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangePVZStatusRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ChangePVZStatusRequest) GetStatus() PVZStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PVZStatus_PVZ_STATUS_UNSPECIFIED
+}
+
+func (x *ChangePVZStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChangePVZStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePVZStatusResponse) Reset() {
+	*x = ChangePVZStatusResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePVZStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePVZStatusResponse) ProtoMessage() {}
+
+func (x *ChangePVZStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePVZStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangePVZStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChangePVZStatusResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type SetPVZCapacityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// 0 снимает ограничение вместимости
+	Capacity      int32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPVZCapacityRequest) Reset() {
+	*x = SetPVZCapacityRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPVZCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPVZCapacityRequest) ProtoMessage() {}
+
+func (x *SetPVZCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPVZCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetPVZCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetPVZCapacityRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *SetPVZCapacityRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type SetPVZCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPVZCapacityResponse) Reset() {
+	*x = SetPVZCapacityResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPVZCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPVZCapacityResponse) ProtoMessage() {}
+
+func (x *SetPVZCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPVZCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetPVZCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetPVZCapacityResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+// Сотрудник работает с приемками и товарами только тех ПВЗ, за которыми закреплен
+type PVZAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PvzId         string                 `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZAssignment) Reset() {
+	*x = PVZAssignment{}
+	mi := &file_proto_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZAssignment) ProtoMessage() {}
+
+func (x *PVZAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZAssignment.ProtoReflect.Descriptor instead.
+func (*PVZAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PVZAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PVZAssignment) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZAssignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AssignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeRequest) Reset() {
+	*x = AssignEmployeeRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeRequest) ProtoMessage() {}
+
+func (x *AssignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AssignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AssignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *PVZAssignment         `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeResponse) Reset() {
+	*x = AssignEmployeeResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeResponse) ProtoMessage() {}
+
+func (x *AssignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AssignEmployeeResponse) GetAssignment() *PVZAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type UnassignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeRequest) Reset() {
+	*x = UnassignEmployeeRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeRequest) ProtoMessage() {}
+
+func (x *UnassignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnassignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UnassignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnassignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeResponse) Reset() {
+	*x = UnassignEmployeeResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeResponse) ProtoMessage() {}
+
+func (x *UnassignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+type ListPVZEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZEmployeesRequest) Reset() {
+	*x = ListPVZEmployeesRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZEmployeesRequest) ProtoMessage() {}
+
+func (x *ListPVZEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListPVZEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPVZEmployeesRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ListPVZEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*PVZAssignment       `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZEmployeesResponse) Reset() {
+	*x = ListPVZEmployeesResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZEmployeesResponse) ProtoMessage() {}
+
+func (x *ListPVZEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListPVZEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPVZEmployeesResponse) GetAssignments() []*PVZAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type CreateReceptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// UNSPECIFIED открывает обычную поставку
+	Kind ReceptionKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pvz.v1.ReceptionKind" json:"kind,omitempty"`
+	// 0 - без квоты
+	MaxItems      int32 `protobuf:"varint,3,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *CreateReceptionRequest) GetKind() ReceptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReceptionKind_RECEPTION_KIND_UNSPECIFIED
+}
+
+func (x *CreateReceptionRequest) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+type CreateReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionResponse) Reset() {
+	*x = CreateReceptionResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionResponse) ProtoMessage() {}
+
+func (x *CreateReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseLastReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionRequest) Reset() {
+	*x = CloseLastReceptionRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionRequest) ProtoMessage() {}
+
+func (x *CloseLastReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloseLastReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseLastReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionResponse) Reset() {
+	*x = CloseLastReceptionResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionResponse) ProtoMessage() {}
+
+func (x *CloseLastReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CloseLastReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+// Открыть можно только последнюю приемку ПВЗ, причина обязательна
+type ReopenReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionRequest) Reset() {
+	*x = ReopenReceptionRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionRequest) ProtoMessage() {}
+
+func (x *ReopenReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionRequest.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReopenReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReopenReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionResponse) Reset() {
+	*x = ReopenReceptionResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionResponse) ProtoMessage() {}
+
+func (x *ReopenReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionResponse.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReopenReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Необязательные номер заказа и штрихкод. Повторный штрихкод в приемке отклоняется.
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Barcode string `protobuf:"bytes,4,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	// Для приемки возвратов: причина обязательна, как и original_product_id или order_id
+	ReturnReason      string `protobuf:"bytes,5,opt,name=return_reason,json=returnReason,proto3" json:"return_reason,omitempty"`
+	OriginalProductId string `protobuf:"bytes,6,opt,name=original_product_id,json=originalProductId,proto3" json:"original_product_id,omitempty"`
+	// Ячейка для товара, без нее выбирается первая свободная
+	CellId        string `protobuf:"bytes,7,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetReturnReason() string {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOriginalProductId() string {
+	if x != nil {
+		return x.OriginalProductId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Пачка сохраняется целиком или не сохраняется совсем, в пачке от 1 до 100 товаров
+type AddProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Products      []*AddProductsItem     `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsRequest) Reset() {
+	*x = AddProductsRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsRequest) ProtoMessage() {}
+
+func (x *AddProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsRequest.ProtoReflect.Descriptor instead.
+func (*AddProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddProductsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductsRequest) GetProducts() []*AddProductsItem {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Поля товара пачки совпадают с полями AddProductRequest
+type AddProductsItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	OrderId           string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Barcode           string                 `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	ReturnReason      string                 `protobuf:"bytes,4,opt,name=return_reason,json=returnReason,proto3" json:"return_reason,omitempty"`
+	OriginalProductId string                 `protobuf:"bytes,5,opt,name=original_product_id,json=originalProductId,proto3" json:"original_product_id,omitempty"`
+	CellId            string                 `protobuf:"bytes,6,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddProductsItem) Reset() {
+	*x = AddProductsItem{}
+	mi := &file_proto_pvz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsItem) ProtoMessage() {}
+
+func (x *AddProductsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsItem.ProtoReflect.Descriptor instead.
+func (*AddProductsItem) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddProductsItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductsItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddProductsItem) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *AddProductsItem) GetReturnReason() string {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ""
+}
+
+func (x *AddProductsItem) GetOriginalProductId() string {
+	if x != nil {
+		return x.OriginalProductId
+	}
+	return ""
+}
+
+func (x *AddProductsItem) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+// Товары возвращаются в порядке запроса
+type AddProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{38}
+}
+
+// Удаление товара по идентификатору, пока его приемка открыта
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Удаленный товар
+	Product       *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type FindProductByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductByBarcodeRequest) Reset() {
+	*x = FindProductByBarcodeRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductByBarcodeRequest) ProtoMessage() {}
+
+func (x *FindProductByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*FindProductByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FindProductByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+// Последний принятый товар со штрихкодом и место, куда он поступил
+type FindProductByBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Reception     *Reception             `protobuf:"bytes,2,opt,name=reception,proto3" json:"reception,omitempty"`
+	Pvz           *PVZ                   `protobuf:"bytes,3,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductByBarcodeResponse) Reset() {
+	*x = FindProductByBarcodeResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductByBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductByBarcodeResponse) ProtoMessage() {}
+
+func (x *FindProductByBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductByBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*FindProductByBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FindProductByBarcodeResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *FindProductByBarcodeResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *FindProductByBarcodeResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ChangeProductStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        ProductStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=pvz.v1.ProductStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeProductStatusRequest) Reset() {
+	*x = ChangeProductStatusRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeProductStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeProductStatusRequest) ProtoMessage() {}
+
+func (x *ChangeProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChangeProductStatusRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ChangeProductStatusRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ChangeProductStatusRequest) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+type ChangeProductStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeProductStatusResponse) Reset() {
+	*x = ChangeProductStatusResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeProductStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeProductStatusResponse) ProtoMessage() {}
+
+func (x *ChangeProductStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeProductStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeProductStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangeProductStatusResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Выдача товара получателю, товар должен ожидать получателя
+type IssueProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductRequest) Reset() {
+	*x = IssueProductRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductRequest) ProtoMessage() {}
+
+func (x *IssueProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductRequest.ProtoReflect.Descriptor instead.
+func (*IssueProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IssueProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *IssueProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type IssueProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductResponse) Reset() {
+	*x = IssueProductResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductResponse) ProtoMessage() {}
+
+func (x *IssueProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductResponse.ProtoReflect.Descriptor instead.
+func (*IssueProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *IssueProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Товары на полке ПВЗ, как в GET /pvz/{pvzId}/shelf.
+// Пустой statuses означает все состояния товаров на полке, нулевые page и limit - значения по умолчанию (1 и 10).
+type ListShelfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Statuses      []ProductStatus        `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=pvz.v1.ProductStatus" json:"statuses,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShelfRequest) Reset() {
+	*x = ListShelfRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShelfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShelfRequest) ProtoMessage() {}
+
+func (x *ListShelfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShelfRequest.ProtoReflect.Descriptor instead.
+func (*ListShelfRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListShelfRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ListShelfRequest) GetStatuses() []ProductStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListShelfRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListShelfRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListShelfResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// Номер следующей страницы, 0 если страница последняя
+	NextPage      int32 `protobuf:"varint,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShelfResponse) Reset() {
+	*x = ListShelfResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShelfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShelfResponse) ProtoMessage() {}
+
+func (x *ListShelfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShelfResponse.ProtoReflect.Descriptor instead.
+func (*ListShelfResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListShelfResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListShelfResponse) GetNextPage() int32 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+// Товары, которые сейчас лежат в ячейке хранения
+type ListCellProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CellId        string                 `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCellProductsRequest) Reset() {
+	*x = ListCellProductsRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCellProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCellProductsRequest) ProtoMessage() {}
+
+func (x *ListCellProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCellProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListCellProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCellProductsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ListCellProductsRequest) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type ListCellProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCellProductsResponse) Reset() {
+	*x = ListCellProductsResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCellProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCellProductsResponse) ProtoMessage() {}
+
+func (x *ListCellProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCellProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListCellProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListCellProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Первой командой сессии должна быть start, затем scan и undo в любом порядке
+type ScanSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*ScanSessionRequest_Start
+	//	*ScanSessionRequest_Scan
+	//	*ScanSessionRequest_Undo
+	Command isScanSessionRequest_Command `protobuf_oneof:"command"`
+	// Произвольный идентификатор команды, возвращается в подтверждении
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanSessionRequest) Reset() {
+	*x = ScanSessionRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSessionRequest) ProtoMessage() {}
+
+func (x *ScanSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSessionRequest.ProtoReflect.Descriptor instead.
+func (*ScanSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ScanSessionRequest) GetCommand() isScanSessionRequest_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ScanSessionRequest) GetStart() *StartScanSession {
+	if x != nil {
+		if x, ok := x.Command.(*ScanSessionRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionRequest) GetScan() *ScanProduct {
+	if x != nil {
+		if x, ok := x.Command.(*ScanSessionRequest_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionRequest) GetUndo() *UndoScan {
+	if x != nil {
+		if x, ok := x.Command.(*ScanSessionRequest_Undo); ok {
+			return x.Undo
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type isScanSessionRequest_Command interface {
+	isScanSessionRequest_Command()
+}
+
+type ScanSessionRequest_Start struct {
+	Start *StartScanSession `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ScanSessionRequest_Scan struct {
+	Scan *ScanProduct `protobuf:"bytes,2,opt,name=scan,proto3,oneof"`
+}
+
+type ScanSessionRequest_Undo struct {
+	Undo *UndoScan `protobuf:"bytes,3,opt,name=undo,proto3,oneof"`
+}
+
+func (*ScanSessionRequest_Start) isScanSessionRequest_Command() {}
+
+func (*ScanSessionRequest_Scan) isScanSessionRequest_Command() {}
+
+func (*ScanSessionRequest_Undo) isScanSessionRequest_Command() {}
+
+type StartScanSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScanSession) Reset() {
+	*x = StartScanSession{}
+	mi := &file_proto_pvz_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScanSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScanSession) ProtoMessage() {}
+
+func (x *StartScanSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScanSession.ProtoReflect.Descriptor instead.
+func (*StartScanSession) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartScanSession) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ScanProduct struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	OrderId           string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Barcode           string                 `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	ReturnReason      string                 `protobuf:"bytes,4,opt,name=return_reason,json=returnReason,proto3" json:"return_reason,omitempty"`
+	OriginalProductId string                 `protobuf:"bytes,5,opt,name=original_product_id,json=originalProductId,proto3" json:"original_product_id,omitempty"`
+	CellId            string                 `protobuf:"bytes,6,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScanProduct) Reset() {
+	*x = ScanProduct{}
+	mi := &file_proto_pvz_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanProduct) ProtoMessage() {}
+
+func (x *ScanProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanProduct.ProtoReflect.Descriptor instead.
+func (*ScanProduct) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScanProduct) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScanProduct) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ScanProduct) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *ScanProduct) GetReturnReason() string {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ""
+}
+
+func (x *ScanProduct) GetOriginalProductId() string {
+	if x != nil {
+		return x.OriginalProductId
+	}
+	return ""
+}
+
+func (x *ScanProduct) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type UndoScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoScan) Reset() {
+	*x = UndoScan{}
+	mi := &file_proto_pvz_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoScan) ProtoMessage() {}
+
+func (x *UndoScan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoScan.ProtoReflect.Descriptor instead.
+func (*UndoScan) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{54}
+}
+
+// Подтверждение приходит на каждую команду в порядке их получения
+type ScanSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Ack:
+	//
+	//	*ScanSessionResponse_Started
+	//	*ScanSessionResponse_Scanned
+	//	*ScanSessionResponse_Undone
+	//	*ScanSessionResponse_Error
+	Ack isScanSessionResponse_Ack `protobuf_oneof:"ack"`
+	// correlation_id команды, на которую пришло подтверждение
+	CorrelationId string `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanSessionResponse) Reset() {
+	*x = ScanSessionResponse{}
+	mi := &file_proto_pvz_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSessionResponse) ProtoMessage() {}
+
+func (x *ScanSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSessionResponse.ProtoReflect.Descriptor instead.
+func (*ScanSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ScanSessionResponse) GetAck() isScanSessionResponse_Ack {
+	if x != nil {
+		return x.Ack
+	}
+	return nil
+}
+
+func (x *ScanSessionResponse) GetStarted() *Reception {
+	if x != nil {
+		if x, ok := x.Ack.(*ScanSessionResponse_Started); ok {
+			return x.Started
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionResponse) GetScanned() *Product {
+	if x != nil {
+		if x, ok := x.Ack.(*ScanSessionResponse_Scanned); ok {
+			return x.Scanned
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionResponse) GetUndone() *Product {
+	if x != nil {
+		if x, ok := x.Ack.(*ScanSessionResponse_Undone); ok {
+			return x.Undone
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionResponse) GetError() *ScanError {
+	if x != nil {
+		if x, ok := x.Ack.(*ScanSessionResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *ScanSessionResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type isScanSessionResponse_Ack interface {
+	isScanSessionResponse_Ack()
+}
+
+type ScanSessionResponse_Started struct {
+	// Открытая приемка, к которой подключилась сессия
+	Started *Reception `protobuf:"bytes,1,opt,name=started,proto3,oneof"`
+}
+
+type ScanSessionResponse_Scanned struct {
+	// Сохраненный товар
+	Scanned *Product `protobuf:"bytes,2,opt,name=scanned,proto3,oneof"`
+}
+
+type ScanSessionResponse_Undone struct {
+	// Удаленный товар
+	Undone *Product `protobuf:"bytes,3,opt,name=undone,proto3,oneof"`
+}
+
+type ScanSessionResponse_Error struct {
+	// Команда отклонена, сессия продолжается
+	Error *ScanError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+func (*ScanSessionResponse_Started) isScanSessionResponse_Ack() {}
+
+func (*ScanSessionResponse_Scanned) isScanSessionResponse_Ack() {}
+
+func (*ScanSessionResponse_Undone) isScanSessionResponse_Ack() {}
+
+func (*ScanSessionResponse_Error) isScanSessionResponse_Ack() {}
+
+// Ошибка отдельной команды сессии сканирования
+type ScanError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Код gRPC статуса, который вернул бы аналогичный unary вызов
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Стабильный код ошибки, как в google.rpc.ErrorInfo
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanError) Reset() {
+	*x = ScanError{}
+	mi := &file_proto_pvz_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanError) ProtoMessage() {}
+
+func (x *ScanError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanError.ProtoReflect.Descriptor instead.
+func (*ScanError) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ScanError) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ScanError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScanError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Пустые поля фильтра не участвуют в отборе событий
+type WatchPVZEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPVZEventsRequest) Reset() {
+	*x = WatchPVZEventsRequest{}
+	mi := &file_proto_pvz_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPVZEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPVZEventsRequest) ProtoMessage() {}
+
+func (x *WatchPVZEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPVZEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPVZEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WatchPVZEventsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *WatchPVZEventsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type PVZEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       PVZEventType           `protobuf:"varint,1,opt,name=type,proto3,enum=pvz.v1.PVZEventType" json:"type,omitempty"`
+	PvzId      string                 `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	City       string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Заполняется для событий приемки
+	Reception *Reception `protobuf:"bytes,5,opt,name=reception,proto3" json:"reception,omitempty"`
+	// Заполняется для событий товаров
+	Product *Product `protobuf:"bytes,6,opt,name=product,proto3" json:"product,omitempty"`
+	// Заполняется для сигнала о заполнении ПВЗ
+	Pvz           *PVZ `protobuf:"bytes,7,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEvent) Reset() {
+	*x = PVZEvent{}
+	mi := &file_proto_pvz_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEvent) ProtoMessage() {}
+
+func (x *PVZEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pvz_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEvent.ProtoReflect.Descriptor instead.
+func (*PVZEvent) Descriptor() ([]byte, []int) {
+	return file_proto_pvz_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PVZEvent) GetType() PVZEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PVZEvent) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *PVZEvent) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *PVZEvent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *PVZEvent) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+var File_proto_pvz_proto protoreflect.FileDescriptor
+
+const file_proto_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x0fproto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
+	"\vCoordinates\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xa7\x03\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x125\n" +
+	"\vcoordinates\x18\x05 \x01(\v2\x13.pvz.v1.CoordinatesR\vcoordinates\x12#\n" +
+	"\rworking_hours\x18\x06 \x01(\tR\fworkingHours\x12\x14\n" +
+	"\x05phone\x18\a \x01(\tR\x05phone\x12)\n" +
+	"\x06status\x18\b \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\t \x01(\tR\fstatusReason\x12\x1a\n" +
+	"\bcapacity\x18\n" +
+	" \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\boccupied\x18\v \x01(\x05R\boccupied\x12\x1f\n" +
+	"\vnearly_full\x18\f \x01(\bR\n" +
+	"nearlyFull\"\xa4\x03\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\x12#\n" +
+	"\rreopen_reason\x18\x05 \x01(\tR\freopenReason\x12;\n" +
+	"\vreopened_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reopenedAt\x12)\n" +
+	"\x04kind\x18\a \x01(\x0e2\x15.pvz.v1.ReceptionKindR\x04kind\x12\x1b\n" +
+	"\tmax_items\x18\b \x01(\x05R\bmaxItems\x12\x1b\n" +
+	"\tclosed_by\x18\t \x01(\tR\bclosedBy\x12?\n" +
+	"\fclose_reason\x18\n" +
+	" \x01(\x0e2\x1c.pvz.v1.ReceptionCloseReasonR\vcloseReason\"\xa3\x03\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x18\n" +
+	"\abarcode\x18\x06 \x01(\tR\abarcode\x12-\n" +
+	"\x06status\x18\a \x01(\x0e2\x15.pvz.v1.ProductStatusR\x06status\x12F\n" +
+	"\x11status_changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12.\n" +
+	"\x13original_product_id\x18\t \x01(\tR\x11originalProductId\x12#\n" +
+	"\rreturn_reason\x18\n" +
+	" \x01(\tR\freturnReason\x12\x17\n" +
+	"\acell_id\x18\v \x01(\tR\x06cellId\">\n" +
+	"\x11GetPVZListRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\"u\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"q\n" +
+	"\x11PVZWithReceptions\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12=\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\"\x95\x02\n" +
+	"\x0eListPVZRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\x12<\n" +
+	"\x0ereception_kind\x18\x06 \x01(\x0e2\x15.pvz.v1.ReceptionKindR\rreceptionKind\"]\n" +
+	"\x0fListPVZResponse\x12-\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x19.pvz.v1.PVZWithReceptionsR\x04pvzs\x12\x1b\n" +
+	"\tnext_page\x18\x02 \x01(\x05R\bnextPage\"\xce\x01\n" +
+	"\x10CreatePVZRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x125\n" +
+	"\vcoordinates\x18\x03 \x01(\v2\x13.pvz.v1.CoordinatesR\vcoordinates\x12#\n" +
+	"\rworking_hours\x18\x04 \x01(\tR\fworkingHours\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\"2\n" +
+	"\x11CreatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"p\n" +
+	"\x15FindNearestPVZRequest\x12)\n" +
+	"\x05point\x18\x01 \x01(\v2\x13.pvz.v1.CoordinatesR\x05point\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x01R\x06radius\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"F\n" +
+	"\tNearbyPVZ\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\"?\n" +
+	"\x16FindNearestPVZResponse\x12%\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x11.pvz.v1.NearbyPVZR\x04pvzs\"r\n" +
+	"\x16ChangePVZStatusRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12)\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"8\n" +
+	"\x17ChangePVZStatusResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"J\n" +
+	"\x15SetPVZCapacityRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\"7\n" +
+	"\x16SetPVZCapacityResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"z\n" +
+	"\rPVZAssignment\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06pvz_id\x18\x02 \x01(\tR\x05pvzId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"G\n" +
+	"\x15AssignEmployeeRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"O\n" +
+	"\x16AssignEmployeeResponse\x125\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x15.pvz.v1.PVZAssignmentR\n" +
+	"assignment\"I\n" +
+	"\x17UnassignEmployeeRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1a\n" +
+	"\x18UnassignEmployeeResponse\"0\n" +
+	"\x17ListPVZEmployeesRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"S\n" +
+	"\x18ListPVZEmployeesResponse\x127\n" +
+	"\vassignments\x18\x01 \x03(\v2\x15.pvz.v1.PVZAssignmentR\vassignments\"w\n" +
+	"\x16CreateReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.pvz.v1.ReceptionKindR\x04kind\x12\x1b\n" +
+	"\tmax_items\x18\x03 \x01(\x05R\bmaxItems\"J\n" +
+	"\x17CreateReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"2\n" +
+	"\x19CloseLastReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x1aCloseLastReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"S\n" +
+	"\x16ReopenReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x17ReopenReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"\xe1\x01\n" +
+	"\x11AddProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x18\n" +
+	"\abarcode\x18\x04 \x01(\tR\abarcode\x12#\n" +
+	"\rreturn_reason\x18\x05 \x01(\tR\freturnReason\x12.\n" +
+	"\x13original_product_id\x18\x06 \x01(\tR\x11originalProductId\x12\x17\n" +
+	"\acell_id\x18\a \x01(\tR\x06cellId\"?\n" +
+	"\x12AddProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"`\n" +
+	"\x12AddProductsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x123\n" +
+	"\bproducts\x18\x02 \x03(\v2\x17.pvz.v1.AddProductsItemR\bproducts\"\xc8\x01\n" +
+	"\x0fAddProductsItem\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x18\n" +
+	"\abarcode\x18\x03 \x01(\tR\abarcode\x12#\n" +
+	"\rreturn_reason\x18\x04 \x01(\tR\freturnReason\x12.\n" +
+	"\x13original_product_id\x18\x05 \x01(\tR\x11originalProductId\x12\x17\n" +
+	"\acell_id\x18\x06 \x01(\tR\x06cellId\"B\n" +
+	"\x13AddProductsResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"1\n" +
+	"\x18DeleteLastProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"\x1b\n" +
+	"\x19DeleteLastProductResponse\"L\n" +
+	"\x14DeleteProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"B\n" +
+	"\x15DeleteProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"7\n" +
+	"\x1bFindProductByBarcodeRequest\x12\x18\n" +
+	"\abarcode\x18\x01 \x01(\tR\abarcode\"\x99\x01\n" +
+	"\x1cFindProductByBarcodeResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12/\n" +
+	"\treception\x18\x02 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12\x1d\n" +
+	"\x03pvz\x18\x03 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"\x81\x01\n" +
+	"\x1aChangeProductStatusRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12-\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x15.pvz.v1.ProductStatusR\x06status\"H\n" +
+	"\x1bChangeProductStatusResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"K\n" +
+	"\x13IssueProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"A\n" +
+	"\x14IssueProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"\x86\x01\n" +
+	"\x10ListShelfRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x121\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x15.pvz.v1.ProductStatusR\bstatuses\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"]\n" +
+	"\x11ListShelfResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\x12\x1b\n" +
+	"\tnext_page\x18\x02 \x01(\x05R\bnextPage\"I\n" +
+	"\x17ListCellProductsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\acell_id\x18\x02 \x01(\tR\x06cellId\"G\n" +
+	"\x18ListCellProductsResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\xcb\x01\n" +
+	"\x12ScanSessionRequest\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x18.pvz.v1.StartScanSessionH\x00R\x05start\x12)\n" +
+	"\x04scan\x18\x02 \x01(\v2\x13.pvz.v1.ScanProductH\x00R\x04scan\x12&\n" +
+	"\x04undo\x18\x03 \x01(\v2\x10.pvz.v1.UndoScanH\x00R\x04undo\x12%\n" +
+	"\x0ecorrelation_id\x18\x04 \x01(\tR\rcorrelationIdB\t\n" +
+	"\acommand\")\n" +
+	"\x10StartScanSession\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"\xc4\x01\n" +
+	"\vScanProduct\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x18\n" +
+	"\abarcode\x18\x03 \x01(\tR\abarcode\x12#\n" +
+	"\rreturn_reason\x18\x04 \x01(\tR\freturnReason\x12.\n" +
+	"\x13original_product_id\x18\x05 \x01(\tR\x11originalProductId\x12\x17\n" +
+	"\acell_id\x18\x06 \x01(\tR\x06cellId\"\n" +
+	"\n" +
+	"\bUndoScan\"\xf5\x01\n" +
+	"\x13ScanSessionResponse\x12-\n" +
+	"\astarted\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionH\x00R\astarted\x12+\n" +
+	"\ascanned\x18\x02 \x01(\v2\x0f.pvz.v1.ProductH\x00R\ascanned\x12)\n" +
+	"\x06undone\x18\x03 \x01(\v2\x0f.pvz.v1.ProductH\x00R\x06undone\x12)\n" +
+	"\x05error\x18\x04 \x01(\v2\x11.pvz.v1.ScanErrorH\x00R\x05error\x12%\n" +
+	"\x0ecorrelation_id\x18\x05 \x01(\tR\rcorrelationIdB\x05\n" +
+	"\x03ack\"Q\n" +
+	"\tScanError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"B\n" +
+	"\x15WatchPVZEventsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\"\x97\x02\n" +
+	"\bPVZEvent\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.pvz.v1.PVZEventTypeR\x04type\x12\x15\n" +
+	"\x06pvz_id\x18\x02 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12/\n" +
+	"\treception\x18\x05 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12)\n" +
+	"\aproduct\x18\x06 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12\x1d\n" +
+	"\x03pvz\x18\a \x01(\v2\v.pvz.v1.PVZR\x03pvz*q\n" +
+	"\tPVZStatus\x12\x1a\n" +
+	"\x16PVZ_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PVZ_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14PVZ_STATUS_SUSPENDED\x10\x02\x12\x17\n" +
+	"\x13PVZ_STATUS_ARCHIVED\x10\x03*P\n" +
+	"\x0fReceptionStatus\x12 \n" +
 	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01*o\n" +
+	"\rReceptionKind\x12\x1e\n" +
+	"\x1aRECEPTION_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RECEPTION_KIND_INBOUND\x10\x01\x12\"\n" +
+	"\x1eRECEPTION_KIND_CUSTOMER_RETURN\x10\x02*d\n" +
+	"\x14ReceptionCloseReason\x12&\n" +
+	"\"RECEPTION_CLOSE_REASON_UNSPECIFIED\x10\x00\x12$\n" +
+	" RECEPTION_CLOSE_REASON_TIMED_OUT\x10\x01*\xa8\x01\n" +
+	"\rProductStatus\x12\x1e\n" +
+	"\x1aPRODUCT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PRODUCT_STATUS_ACCEPTED\x10\x01\x12#\n" +
+	"\x1fPRODUCT_STATUS_READY_FOR_PICKUP\x10\x02\x12\x19\n" +
+	"\x15PRODUCT_STATUS_ISSUED\x10\x03\x12\x1a\n" +
+	"\x16PRODUCT_STATUS_REFUSED\x10\x04*\xb4\x02\n" +
+	"\fPVZEventType\x12\x1e\n" +
+	"\x1aPVZ_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_OPENED\x10\x01\x12 \n" +
+	"\x1cPVZ_EVENT_TYPE_PRODUCT_ADDED\x10\x02\x12\"\n" +
+	"\x1ePVZ_EVENT_TYPE_PRODUCT_DELETED\x10\x03\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_CLOSED\x10\x04\x12%\n" +
+	"!PVZ_EVENT_TYPE_RECEPTION_REOPENED\x10\x05\x12)\n" +
+	"%PVZ_EVENT_TYPE_PRODUCT_STATUS_CHANGED\x10\x06\x12\"\n" +
+	"\x1ePVZ_EVENT_TYPE_PVZ_NEARLY_FULL\x10\a2\xb6\x0e\n" +
 	"\n" +
 	"PVZService\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseB(Z&github.com/avito_pvz/pvz/pvz_v1;pvz_v1b\x06proto3"
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12:\n" +
+	"\aListPVZ\x12\x16.pvz.v1.ListPVZRequest\x1a\x17.pvz.v1.ListPVZResponse\x12@\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\x12O\n" +
+	"\x0eFindNearestPVZ\x12\x1d.pvz.v1.FindNearestPVZRequest\x1a\x1e.pvz.v1.FindNearestPVZResponse\x12R\n" +
+	"\x0fChangePVZStatus\x12\x1e.pvz.v1.ChangePVZStatusRequest\x1a\x1f.pvz.v1.ChangePVZStatusResponse\x12O\n" +
+	"\x0eSetPVZCapacity\x12\x1d.pvz.v1.SetPVZCapacityRequest\x1a\x1e.pvz.v1.SetPVZCapacityResponse\x12O\n" +
+	"\x0eAssignEmployee\x12\x1d.pvz.v1.AssignEmployeeRequest\x1a\x1e.pvz.v1.AssignEmployeeResponse\x12U\n" +
+	"\x10UnassignEmployee\x12\x1f.pvz.v1.UnassignEmployeeRequest\x1a .pvz.v1.UnassignEmployeeResponse\x12U\n" +
+	"\x10ListPVZEmployees\x12\x1f.pvz.v1.ListPVZEmployeesRequest\x1a .pvz.v1.ListPVZEmployeesResponse\x12R\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x1f.pvz.v1.CreateReceptionResponse\x12[\n" +
+	"\x12CloseLastReception\x12!.pvz.v1.CloseLastReceptionRequest\x1a\".pvz.v1.CloseLastReceptionResponse\x12R\n" +
+	"\x0fReopenReception\x12\x1e.pvz.v1.ReopenReceptionRequest\x1a\x1f.pvz.v1.ReopenReceptionResponse\x12C\n" +
+	"\n" +
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\x12F\n" +
+	"\vAddProducts\x12\x1a.pvz.v1.AddProductsRequest\x1a\x1b.pvz.v1.AddProductsResponse\x12X\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\x12L\n" +
+	"\rDeleteProduct\x12\x1c.pvz.v1.DeleteProductRequest\x1a\x1d.pvz.v1.DeleteProductResponse\x12a\n" +
+	"\x14FindProductByBarcode\x12#.pvz.v1.FindProductByBarcodeRequest\x1a$.pvz.v1.FindProductByBarcodeResponse\x12^\n" +
+	"\x13ChangeProductStatus\x12\".pvz.v1.ChangeProductStatusRequest\x1a#.pvz.v1.ChangeProductStatusResponse\x12I\n" +
+	"\fIssueProduct\x12\x1b.pvz.v1.IssueProductRequest\x1a\x1c.pvz.v1.IssueProductResponse\x12@\n" +
+	"\tListShelf\x12\x18.pvz.v1.ListShelfRequest\x1a\x19.pvz.v1.ListShelfResponse\x12U\n" +
+	"\x10ListCellProducts\x12\x1f.pvz.v1.ListCellProductsRequest\x1a .pvz.v1.ListCellProductsResponse\x12J\n" +
+	"\vScanSession\x12\x1a.pvz.v1.ScanSessionRequest\x1a\x1b.pvz.v1.ScanSessionResponse(\x010\x01\x12C\n" +
+	"\x0eWatchPVZEvents\x12\x1d.pvz.v1.WatchPVZEventsRequest\x1a\x10.pvz.v1.PVZEvent0\x01B(Z&github.com/avito_pvz/pvz/pvz_v1;pvz_v1b\x06proto3"
 
 var (
 	file_proto_pvz_proto_rawDescOnce sync.Once
@@ -240,25 +4169,188 @@ func file_proto_pvz_proto_rawDescGZIP() []byte {
 	return file_proto_pvz_proto_rawDescData
 }
 
-var file_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_proto_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PVZStatus)(0),                       // 0: pvz.v1.PVZStatus
+	(ReceptionStatus)(0),                 // 1: pvz.v1.ReceptionStatus
+	(ReceptionKind)(0),                   // 2: pvz.v1.ReceptionKind
+	(ReceptionCloseReason)(0),            // 3: pvz.v1.ReceptionCloseReason
+	(ProductStatus)(0),                   // 4: pvz.v1.ProductStatus
+	(PVZEventType)(0),                    // 5: pvz.v1.PVZEventType
+	(*Coordinates)(nil),                  // 6: pvz.v1.Coordinates
+	(*PVZ)(nil),                          // 7: pvz.v1.PVZ
+	(*Reception)(nil),                    // 8: pvz.v1.Reception
+	(*Product)(nil),                      // 9: pvz.v1.Product
+	(*GetPVZListRequest)(nil),            // 10: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),           // 11: pvz.v1.GetPVZListResponse
+	(*ReceptionWithProducts)(nil),        // 12: pvz.v1.ReceptionWithProducts
+	(*PVZWithReceptions)(nil),            // 13: pvz.v1.PVZWithReceptions
+	(*ListPVZRequest)(nil),               // 14: pvz.v1.ListPVZRequest
+	(*ListPVZResponse)(nil),              // 15: pvz.v1.ListPVZResponse
+	(*CreatePVZRequest)(nil),             // 16: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),            // 17: pvz.v1.CreatePVZResponse
+	(*FindNearestPVZRequest)(nil),        // 18: pvz.v1.FindNearestPVZRequest
+	(*NearbyPVZ)(nil),                    // 19: pvz.v1.NearbyPVZ
+	(*FindNearestPVZResponse)(nil),       // 20: pvz.v1.FindNearestPVZResponse
+	(*ChangePVZStatusRequest)(nil),       // 21: pvz.v1.ChangePVZStatusRequest
+	(*ChangePVZStatusResponse)(nil),      // 22: pvz.v1.ChangePVZStatusResponse
+	(*SetPVZCapacityRequest)(nil),        // 23: pvz.v1.SetPVZCapacityRequest
+	(*SetPVZCapacityResponse)(nil),       // 24: pvz.v1.SetPVZCapacityResponse
+	(*PVZAssignment)(nil),                // 25: pvz.v1.PVZAssignment
+	(*AssignEmployeeRequest)(nil),        // 26: pvz.v1.AssignEmployeeRequest
+	(*AssignEmployeeResponse)(nil),       // 27: pvz.v1.AssignEmployeeResponse
+	(*UnassignEmployeeRequest)(nil),      // 28: pvz.v1.UnassignEmployeeRequest
+	(*UnassignEmployeeResponse)(nil),     // 29: pvz.v1.UnassignEmployeeResponse
+	(*ListPVZEmployeesRequest)(nil),      // 30: pvz.v1.ListPVZEmployeesRequest
+	(*ListPVZEmployeesResponse)(nil),     // 31: pvz.v1.ListPVZEmployeesResponse
+	(*CreateReceptionRequest)(nil),       // 32: pvz.v1.CreateReceptionRequest
+	(*CreateReceptionResponse)(nil),      // 33: pvz.v1.CreateReceptionResponse
+	(*CloseLastReceptionRequest)(nil),    // 34: pvz.v1.CloseLastReceptionRequest
+	(*CloseLastReceptionResponse)(nil),   // 35: pvz.v1.CloseLastReceptionResponse
+	(*ReopenReceptionRequest)(nil),       // 36: pvz.v1.ReopenReceptionRequest
+	(*ReopenReceptionResponse)(nil),      // 37: pvz.v1.ReopenReceptionResponse
+	(*AddProductRequest)(nil),            // 38: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),           // 39: pvz.v1.AddProductResponse
+	(*AddProductsRequest)(nil),           // 40: pvz.v1.AddProductsRequest
+	(*AddProductsItem)(nil),              // 41: pvz.v1.AddProductsItem
+	(*AddProductsResponse)(nil),          // 42: pvz.v1.AddProductsResponse
+	(*DeleteLastProductRequest)(nil),     // 43: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),    // 44: pvz.v1.DeleteLastProductResponse
+	(*DeleteProductRequest)(nil),         // 45: pvz.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),        // 46: pvz.v1.DeleteProductResponse
+	(*FindProductByBarcodeRequest)(nil),  // 47: pvz.v1.FindProductByBarcodeRequest
+	(*FindProductByBarcodeResponse)(nil), // 48: pvz.v1.FindProductByBarcodeResponse
+	(*ChangeProductStatusRequest)(nil),   // 49: pvz.v1.ChangeProductStatusRequest
+	(*ChangeProductStatusResponse)(nil),  // 50: pvz.v1.ChangeProductStatusResponse
+	(*IssueProductRequest)(nil),          // 51: pvz.v1.IssueProductRequest
+	(*IssueProductResponse)(nil),         // 52: pvz.v1.IssueProductResponse
+	(*ListShelfRequest)(nil),             // 53: pvz.v1.ListShelfRequest
+	(*ListShelfResponse)(nil),            // 54: pvz.v1.ListShelfResponse
+	(*ListCellProductsRequest)(nil),      // 55: pvz.v1.ListCellProductsRequest
+	(*ListCellProductsResponse)(nil),     // 56: pvz.v1.ListCellProductsResponse
+	(*ScanSessionRequest)(nil),           // 57: pvz.v1.ScanSessionRequest
+	(*StartScanSession)(nil),             // 58: pvz.v1.StartScanSession
+	(*ScanProduct)(nil),                  // 59: pvz.v1.ScanProduct
+	(*UndoScan)(nil),                     // 60: pvz.v1.UndoScan
+	(*ScanSessionResponse)(nil),          // 61: pvz.v1.ScanSessionResponse
+	(*ScanError)(nil),                    // 62: pvz.v1.ScanError
+	(*WatchPVZEventsRequest)(nil),        // 63: pvz.v1.WatchPVZEventsRequest
+	(*PVZEvent)(nil),                     // 64: pvz.v1.PVZEvent
+	(*timestamppb.Timestamp)(nil),        // 65: google.protobuf.Timestamp
 }
 var file_proto_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	65, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	6,  // 1: pvz.v1.PVZ.coordinates:type_name -> pvz.v1.Coordinates
+	0,  // 2: pvz.v1.PVZ.status:type_name -> pvz.v1.PVZStatus
+	65, // 3: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	65, // 5: pvz.v1.Reception.reopened_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: pvz.v1.Reception.kind:type_name -> pvz.v1.ReceptionKind
+	3,  // 7: pvz.v1.Reception.close_reason:type_name -> pvz.v1.ReceptionCloseReason
+	65, // 8: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	4,  // 9: pvz.v1.Product.status:type_name -> pvz.v1.ProductStatus
+	65, // 10: pvz.v1.Product.status_changed_at:type_name -> google.protobuf.Timestamp
+	7,  // 11: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	8,  // 12: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	9,  // 13: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	7,  // 14: pvz.v1.PVZWithReceptions.pvz:type_name -> pvz.v1.PVZ
+	12, // 15: pvz.v1.PVZWithReceptions.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	65, // 16: pvz.v1.ListPVZRequest.start_date:type_name -> google.protobuf.Timestamp
+	65, // 17: pvz.v1.ListPVZRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,  // 18: pvz.v1.ListPVZRequest.reception_kind:type_name -> pvz.v1.ReceptionKind
+	13, // 19: pvz.v1.ListPVZResponse.pvzs:type_name -> pvz.v1.PVZWithReceptions
+	6,  // 20: pvz.v1.CreatePVZRequest.coordinates:type_name -> pvz.v1.Coordinates
+	7,  // 21: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	6,  // 22: pvz.v1.FindNearestPVZRequest.point:type_name -> pvz.v1.Coordinates
+	7,  // 23: pvz.v1.NearbyPVZ.pvz:type_name -> pvz.v1.PVZ
+	19, // 24: pvz.v1.FindNearestPVZResponse.pvzs:type_name -> pvz.v1.NearbyPVZ
+	0,  // 25: pvz.v1.ChangePVZStatusRequest.status:type_name -> pvz.v1.PVZStatus
+	7,  // 26: pvz.v1.ChangePVZStatusResponse.pvz:type_name -> pvz.v1.PVZ
+	7,  // 27: pvz.v1.SetPVZCapacityResponse.pvz:type_name -> pvz.v1.PVZ
+	65, // 28: pvz.v1.PVZAssignment.created_at:type_name -> google.protobuf.Timestamp
+	25, // 29: pvz.v1.AssignEmployeeResponse.assignment:type_name -> pvz.v1.PVZAssignment
+	25, // 30: pvz.v1.ListPVZEmployeesResponse.assignments:type_name -> pvz.v1.PVZAssignment
+	2,  // 31: pvz.v1.CreateReceptionRequest.kind:type_name -> pvz.v1.ReceptionKind
+	8,  // 32: pvz.v1.CreateReceptionResponse.reception:type_name -> pvz.v1.Reception
+	8,  // 33: pvz.v1.CloseLastReceptionResponse.reception:type_name -> pvz.v1.Reception
+	8,  // 34: pvz.v1.ReopenReceptionResponse.reception:type_name -> pvz.v1.Reception
+	9,  // 35: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	41, // 36: pvz.v1.AddProductsRequest.products:type_name -> pvz.v1.AddProductsItem
+	9,  // 37: pvz.v1.AddProductsResponse.products:type_name -> pvz.v1.Product
+	9,  // 38: pvz.v1.DeleteProductResponse.product:type_name -> pvz.v1.Product
+	9,  // 39: pvz.v1.FindProductByBarcodeResponse.product:type_name -> pvz.v1.Product
+	8,  // 40: pvz.v1.FindProductByBarcodeResponse.reception:type_name -> pvz.v1.Reception
+	7,  // 41: pvz.v1.FindProductByBarcodeResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 42: pvz.v1.ChangeProductStatusRequest.status:type_name -> pvz.v1.ProductStatus
+	9,  // 43: pvz.v1.ChangeProductStatusResponse.product:type_name -> pvz.v1.Product
+	9,  // 44: pvz.v1.IssueProductResponse.product:type_name -> pvz.v1.Product
+	4,  // 45: pvz.v1.ListShelfRequest.statuses:type_name -> pvz.v1.ProductStatus
+	9,  // 46: pvz.v1.ListShelfResponse.products:type_name -> pvz.v1.Product
+	9,  // 47: pvz.v1.ListCellProductsResponse.products:type_name -> pvz.v1.Product
+	58, // 48: pvz.v1.ScanSessionRequest.start:type_name -> pvz.v1.StartScanSession
+	59, // 49: pvz.v1.ScanSessionRequest.scan:type_name -> pvz.v1.ScanProduct
+	60, // 50: pvz.v1.ScanSessionRequest.undo:type_name -> pvz.v1.UndoScan
+	8,  // 51: pvz.v1.ScanSessionResponse.started:type_name -> pvz.v1.Reception
+	9,  // 52: pvz.v1.ScanSessionResponse.scanned:type_name -> pvz.v1.Product
+	9,  // 53: pvz.v1.ScanSessionResponse.undone:type_name -> pvz.v1.Product
+	62, // 54: pvz.v1.ScanSessionResponse.error:type_name -> pvz.v1.ScanError
+	5,  // 55: pvz.v1.PVZEvent.type:type_name -> pvz.v1.PVZEventType
+	65, // 56: pvz.v1.PVZEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	8,  // 57: pvz.v1.PVZEvent.reception:type_name -> pvz.v1.Reception
+	9,  // 58: pvz.v1.PVZEvent.product:type_name -> pvz.v1.Product
+	7,  // 59: pvz.v1.PVZEvent.pvz:type_name -> pvz.v1.PVZ
+	10, // 60: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	14, // 61: pvz.v1.PVZService.ListPVZ:input_type -> pvz.v1.ListPVZRequest
+	16, // 62: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	18, // 63: pvz.v1.PVZService.FindNearestPVZ:input_type -> pvz.v1.FindNearestPVZRequest
+	21, // 64: pvz.v1.PVZService.ChangePVZStatus:input_type -> pvz.v1.ChangePVZStatusRequest
+	23, // 65: pvz.v1.PVZService.SetPVZCapacity:input_type -> pvz.v1.SetPVZCapacityRequest
+	26, // 66: pvz.v1.PVZService.AssignEmployee:input_type -> pvz.v1.AssignEmployeeRequest
+	28, // 67: pvz.v1.PVZService.UnassignEmployee:input_type -> pvz.v1.UnassignEmployeeRequest
+	30, // 68: pvz.v1.PVZService.ListPVZEmployees:input_type -> pvz.v1.ListPVZEmployeesRequest
+	32, // 69: pvz.v1.PVZService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	34, // 70: pvz.v1.PVZService.CloseLastReception:input_type -> pvz.v1.CloseLastReceptionRequest
+	36, // 71: pvz.v1.PVZService.ReopenReception:input_type -> pvz.v1.ReopenReceptionRequest
+	38, // 72: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	40, // 73: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductsRequest
+	43, // 74: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	45, // 75: pvz.v1.PVZService.DeleteProduct:input_type -> pvz.v1.DeleteProductRequest
+	47, // 76: pvz.v1.PVZService.FindProductByBarcode:input_type -> pvz.v1.FindProductByBarcodeRequest
+	49, // 77: pvz.v1.PVZService.ChangeProductStatus:input_type -> pvz.v1.ChangeProductStatusRequest
+	51, // 78: pvz.v1.PVZService.IssueProduct:input_type -> pvz.v1.IssueProductRequest
+	53, // 79: pvz.v1.PVZService.ListShelf:input_type -> pvz.v1.ListShelfRequest
+	55, // 80: pvz.v1.PVZService.ListCellProducts:input_type -> pvz.v1.ListCellProductsRequest
+	57, // 81: pvz.v1.PVZService.ScanSession:input_type -> pvz.v1.ScanSessionRequest
+	63, // 82: pvz.v1.PVZService.WatchPVZEvents:input_type -> pvz.v1.WatchPVZEventsRequest
+	11, // 83: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	15, // 84: pvz.v1.PVZService.ListPVZ:output_type -> pvz.v1.ListPVZResponse
+	17, // 85: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	20, // 86: pvz.v1.PVZService.FindNearestPVZ:output_type -> pvz.v1.FindNearestPVZResponse
+	22, // 87: pvz.v1.PVZService.ChangePVZStatus:output_type -> pvz.v1.ChangePVZStatusResponse
+	24, // 88: pvz.v1.PVZService.SetPVZCapacity:output_type -> pvz.v1.SetPVZCapacityResponse
+	27, // 89: pvz.v1.PVZService.AssignEmployee:output_type -> pvz.v1.AssignEmployeeResponse
+	29, // 90: pvz.v1.PVZService.UnassignEmployee:output_type -> pvz.v1.UnassignEmployeeResponse
+	31, // 91: pvz.v1.PVZService.ListPVZEmployees:output_type -> pvz.v1.ListPVZEmployeesResponse
+	33, // 92: pvz.v1.PVZService.CreateReception:output_type -> pvz.v1.CreateReceptionResponse
+	35, // 93: pvz.v1.PVZService.CloseLastReception:output_type -> pvz.v1.CloseLastReceptionResponse
+	37, // 94: pvz.v1.PVZService.ReopenReception:output_type -> pvz.v1.ReopenReceptionResponse
+	39, // 95: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	42, // 96: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	44, // 97: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	46, // 98: pvz.v1.PVZService.DeleteProduct:output_type -> pvz.v1.DeleteProductResponse
+	48, // 99: pvz.v1.PVZService.FindProductByBarcode:output_type -> pvz.v1.FindProductByBarcodeResponse
+	50, // 100: pvz.v1.PVZService.ChangeProductStatus:output_type -> pvz.v1.ChangeProductStatusResponse
+	52, // 101: pvz.v1.PVZService.IssueProduct:output_type -> pvz.v1.IssueProductResponse
+	54, // 102: pvz.v1.PVZService.ListShelf:output_type -> pvz.v1.ListShelfResponse
+	56, // 103: pvz.v1.PVZService.ListCellProducts:output_type -> pvz.v1.ListCellProductsResponse
+	61, // 104: pvz.v1.PVZService.ScanSession:output_type -> pvz.v1.ScanSessionResponse
+	64, // 105: pvz.v1.PVZService.WatchPVZEvents:output_type -> pvz.v1.PVZEvent
+	83, // [83:106] is the sub-list for method output_type
+	60, // [60:83] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_proto_pvz_proto_init() }
@@ -266,13 +4358,24 @@ func file_proto_pvz_proto_init() {
 	if File_proto_pvz_proto != nil {
 		return
 	}
+	file_proto_pvz_proto_msgTypes[51].OneofWrappers = []any{
+		(*ScanSessionRequest_Start)(nil),
+		(*ScanSessionRequest_Scan)(nil),
+		(*ScanSessionRequest_Undo)(nil),
+	}
+	file_proto_pvz_proto_msgTypes[55].OneofWrappers = []any{
+		(*ScanSessionResponse_Started)(nil),
+		(*ScanSessionResponse_Scanned)(nil),
+		(*ScanSessionResponse_Undone)(nil),
+		(*ScanSessionResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pvz_proto_rawDesc), len(file_proto_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      6,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZList_FullMethodName           = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_ListPVZ_FullMethodName              = "/pvz.v1.PVZService/ListPVZ"
+	PVZService_CreatePVZ_FullMethodName            = "/pvz.v1.PVZService/CreatePVZ"
+	PVZService_FindNearestPVZ_FullMethodName       = "/pvz.v1.PVZService/FindNearestPVZ"
+	PVZService_ChangePVZStatus_FullMethodName      = "/pvz.v1.PVZService/ChangePVZStatus"
+	PVZService_SetPVZCapacity_FullMethodName       = "/pvz.v1.PVZService/SetPVZCapacity"
+	PVZService_AssignEmployee_FullMethodName       = "/pvz.v1.PVZService/AssignEmployee"
+	PVZService_UnassignEmployee_FullMethodName     = "/pvz.v1.PVZService/UnassignEmployee"
+	PVZService_ListPVZEmployees_FullMethodName     = "/pvz.v1.PVZService/ListPVZEmployees"
+	PVZService_CreateReception_FullMethodName      = "/pvz.v1.PVZService/CreateReception"
+	PVZService_CloseLastReception_FullMethodName   = "/pvz.v1.PVZService/CloseLastReception"
+	PVZService_ReopenReception_FullMethodName      = "/pvz.v1.PVZService/ReopenReception"
+	PVZService_AddProduct_FullMethodName           = "/pvz.v1.PVZService/AddProduct"
+	PVZService_AddProducts_FullMethodName          = "/pvz.v1.PVZService/AddProducts"
+	PVZService_DeleteLastProduct_FullMethodName    = "/pvz.v1.PVZService/DeleteLastProduct"
+	PVZService_DeleteProduct_FullMethodName        = "/pvz.v1.PVZService/DeleteProduct"
+	PVZService_FindProductByBarcode_FullMethodName = "/pvz.v1.PVZService/FindProductByBarcode"
+	PVZService_ChangeProductStatus_FullMethodName  = "/pvz.v1.PVZService/ChangeProductStatus"
+	PVZService_IssueProduct_FullMethodName         = "/pvz.v1.PVZService/IssueProduct"
+	PVZService_ListShelf_FullMethodName            = "/pvz.v1.PVZService/ListShelf"
+	PVZService_ListCellProducts_FullMethodName     = "/pvz.v1.PVZService/ListCellProducts"
+	PVZService_ScanSession_FullMethodName          = "/pvz.v1.PVZService/ScanSession"
+	PVZService_WatchPVZEvents_FullMethodName       = "/pvz.v1.PVZService/WatchPVZEvents"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -27,6 +49,28 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PVZServiceClient interface {
 	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+	ListPVZ(ctx context.Context, in *ListPVZRequest, opts ...grpc.CallOption) (*ListPVZResponse, error)
+	CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error)
+	FindNearestPVZ(ctx context.Context, in *FindNearestPVZRequest, opts ...grpc.CallOption) (*FindNearestPVZResponse, error)
+	ChangePVZStatus(ctx context.Context, in *ChangePVZStatusRequest, opts ...grpc.CallOption) (*ChangePVZStatusResponse, error)
+	SetPVZCapacity(ctx context.Context, in *SetPVZCapacityRequest, opts ...grpc.CallOption) (*SetPVZCapacityResponse, error)
+	AssignEmployee(ctx context.Context, in *AssignEmployeeRequest, opts ...grpc.CallOption) (*AssignEmployeeResponse, error)
+	UnassignEmployee(ctx context.Context, in *UnassignEmployeeRequest, opts ...grpc.CallOption) (*UnassignEmployeeResponse, error)
+	ListPVZEmployees(ctx context.Context, in *ListPVZEmployeesRequest, opts ...grpc.CallOption) (*ListPVZEmployeesResponse, error)
+	CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error)
+	CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error)
+	ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error)
+	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error)
+	AddProducts(ctx context.Context, in *AddProductsRequest, opts ...grpc.CallOption) (*AddProductsResponse, error)
+	DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	FindProductByBarcode(ctx context.Context, in *FindProductByBarcodeRequest, opts ...grpc.CallOption) (*FindProductByBarcodeResponse, error)
+	ChangeProductStatus(ctx context.Context, in *ChangeProductStatusRequest, opts ...grpc.CallOption) (*ChangeProductStatusResponse, error)
+	IssueProduct(ctx context.Context, in *IssueProductRequest, opts ...grpc.CallOption) (*IssueProductResponse, error)
+	ListShelf(ctx context.Context, in *ListShelfRequest, opts ...grpc.CallOption) (*ListShelfResponse, error)
+	ListCellProducts(ctx context.Context, in *ListCellProductsRequest, opts ...grpc.CallOption) (*ListCellProductsResponse, error)
+	ScanSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ScanSessionRequest, ScanSessionResponse], error)
+	WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error)
 }
 
 type pVZServiceClient struct {
@@ -47,11 +91,265 @@ func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) ListPVZ(ctx context.Context, in *ListPVZRequest, opts ...grpc.CallOption) (*ListPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) FindNearestPVZ(ctx context.Context, in *FindNearestPVZRequest, opts ...grpc.CallOption) (*FindNearestPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNearestPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_FindNearestPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ChangePVZStatus(ctx context.Context, in *ChangePVZStatusRequest, opts ...grpc.CallOption) (*ChangePVZStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePVZStatusResponse)
+	err := c.cc.Invoke(ctx, PVZService_ChangePVZStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) SetPVZCapacity(ctx context.Context, in *SetPVZCapacityRequest, opts ...grpc.CallOption) (*SetPVZCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPVZCapacityResponse)
+	err := c.cc.Invoke(ctx, PVZService_SetPVZCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AssignEmployee(ctx context.Context, in *AssignEmployeeRequest, opts ...grpc.CallOption) (*AssignEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignEmployeeResponse)
+	err := c.cc.Invoke(ctx, PVZService_AssignEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) UnassignEmployee(ctx context.Context, in *UnassignEmployeeRequest, opts ...grpc.CallOption) (*UnassignEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignEmployeeResponse)
+	err := c.cc.Invoke(ctx, PVZService_UnassignEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListPVZEmployees(ctx context.Context, in *ListPVZEmployeesRequest, opts ...grpc.CallOption) (*ListPVZEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPVZEmployeesResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListPVZEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseLastReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CloseLastReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_ReopenReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_AddProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProducts(ctx context.Context, in *AddProductsRequest, opts ...grpc.CallOption) (*AddProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductsResponse)
+	err := c.cc.Invoke(ctx, PVZService_AddProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLastProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteLastProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) FindProductByBarcode(ctx context.Context, in *FindProductByBarcodeRequest, opts ...grpc.CallOption) (*FindProductByBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindProductByBarcodeResponse)
+	err := c.cc.Invoke(ctx, PVZService_FindProductByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ChangeProductStatus(ctx context.Context, in *ChangeProductStatusRequest, opts ...grpc.CallOption) (*ChangeProductStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeProductStatusResponse)
+	err := c.cc.Invoke(ctx, PVZService_ChangeProductStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) IssueProduct(ctx context.Context, in *IssueProductRequest, opts ...grpc.CallOption) (*IssueProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_IssueProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListShelf(ctx context.Context, in *ListShelfRequest, opts ...grpc.CallOption) (*ListShelfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShelfResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListShelf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListCellProducts(ctx context.Context, in *ListCellProductsRequest, opts ...grpc.CallOption) (*ListCellProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCellProductsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListCellProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ScanSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ScanSessionRequest, ScanSessionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[0], PVZService_ScanSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScanSessionRequest, ScanSessionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_ScanSessionClient = grpc.BidiStreamingClient[ScanSessionRequest, ScanSessionResponse]
+
+func (c *pVZServiceClient) WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[1], PVZService_WatchPVZEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPVZEventsRequest, PVZEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsClient = grpc.ServerStreamingClient[PVZEvent]
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
 type PVZServiceServer interface {
 	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	ListPVZ(context.Context, *ListPVZRequest) (*ListPVZResponse, error)
+	CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error)
+	FindNearestPVZ(context.Context, *FindNearestPVZRequest) (*FindNearestPVZResponse, error)
+	ChangePVZStatus(context.Context, *ChangePVZStatusRequest) (*ChangePVZStatusResponse, error)
+	SetPVZCapacity(context.Context, *SetPVZCapacityRequest) (*SetPVZCapacityResponse, error)
+	AssignEmployee(context.Context, *AssignEmployeeRequest) (*AssignEmployeeResponse, error)
+	UnassignEmployee(context.Context, *UnassignEmployeeRequest) (*UnassignEmployeeResponse, error)
+	ListPVZEmployees(context.Context, *ListPVZEmployeesRequest) (*ListPVZEmployeesResponse, error)
+	CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error)
+	CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error)
+	ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error)
+	AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error)
+	AddProducts(context.Context, *AddProductsRequest) (*AddProductsResponse, error)
+	DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	FindProductByBarcode(context.Context, *FindProductByBarcodeRequest) (*FindProductByBarcodeResponse, error)
+	ChangeProductStatus(context.Context, *ChangeProductStatusRequest) (*ChangeProductStatusResponse, error)
+	IssueProduct(context.Context, *IssueProductRequest) (*IssueProductResponse, error)
+	ListShelf(context.Context, *ListShelfRequest) (*ListShelfResponse, error)
+	ListCellProducts(context.Context, *ListCellProductsRequest) (*ListCellProductsResponse, error)
+	ScanSession(grpc.BidiStreamingServer[ScanSessionRequest, ScanSessionResponse]) error
+	WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -65,6 +363,72 @@ type UnimplementedPVZServiceServer struct{}
 func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
 }
+func (UnimplementedPVZServiceServer) ListPVZ(context.Context, *ListPVZRequest) (*ListPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) FindNearestPVZ(context.Context, *FindNearestPVZRequest) (*FindNearestPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearestPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ChangePVZStatus(context.Context, *ChangePVZStatusRequest) (*ChangePVZStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePVZStatus not implemented")
+}
+func (UnimplementedPVZServiceServer) SetPVZCapacity(context.Context, *SetPVZCapacityRequest) (*SetPVZCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPVZCapacity not implemented")
+}
+func (UnimplementedPVZServiceServer) AssignEmployee(context.Context, *AssignEmployeeRequest) (*AssignEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignEmployee not implemented")
+}
+func (UnimplementedPVZServiceServer) UnassignEmployee(context.Context, *UnassignEmployeeRequest) (*UnassignEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignEmployee not implemented")
+}
+func (UnimplementedPVZServiceServer) ListPVZEmployees(context.Context, *ListPVZEmployeesRequest) (*ListPVZEmployeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPVZEmployees not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReception not implemented")
+}
+func (UnimplementedPVZServiceServer) CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLastReception not implemented")
+}
+func (UnimplementedPVZServiceServer) ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenReception not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProducts(context.Context, *AddProductsRequest) (*AddProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLastProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) FindProductByBarcode(context.Context, *FindProductByBarcodeRequest) (*FindProductByBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindProductByBarcode not implemented")
+}
+func (UnimplementedPVZServiceServer) ChangeProductStatus(context.Context, *ChangeProductStatusRequest) (*ChangeProductStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeProductStatus not implemented")
+}
+func (UnimplementedPVZServiceServer) IssueProduct(context.Context, *IssueProductRequest) (*IssueProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) ListShelf(context.Context, *ListShelfRequest) (*ListShelfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShelf not implemented")
+}
+func (UnimplementedPVZServiceServer) ListCellProducts(context.Context, *ListCellProductsRequest) (*ListCellProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCellProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) ScanSession(grpc.BidiStreamingServer[ScanSessionRequest, ScanSessionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ScanSession not implemented")
+}
+func (UnimplementedPVZServiceServer) WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPVZEvents not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
@@ -104,6 +468,384 @@ func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PVZService_ListPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListPVZ(ctx, req.(*ListPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, req.(*CreatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_FindNearestPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearestPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).FindNearestPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_FindNearestPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).FindNearestPVZ(ctx, req.(*FindNearestPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ChangePVZStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePVZStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ChangePVZStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ChangePVZStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ChangePVZStatus(ctx, req.(*ChangePVZStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_SetPVZCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPVZCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).SetPVZCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_SetPVZCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).SetPVZCapacity(ctx, req.(*SetPVZCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AssignEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AssignEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AssignEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AssignEmployee(ctx, req.(*AssignEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_UnassignEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).UnassignEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_UnassignEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).UnassignEmployee(ctx, req.(*UnassignEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListPVZEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPVZEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListPVZEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListPVZEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListPVZEmployees(ctx, req.(*ListPVZEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreateReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateReception(ctx, req.(*CreateReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CloseLastReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLastReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CloseLastReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, req.(*CloseLastReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ReopenReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ReopenReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ReopenReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ReopenReception(ctx, req.(*ReopenReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AddProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AddProduct(ctx, req.(*AddProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AddProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AddProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AddProducts(ctx, req.(*AddProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteLastProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLastProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteLastProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, req.(*DeleteLastProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_FindProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindProductByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).FindProductByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_FindProductByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).FindProductByBarcode(ctx, req.(*FindProductByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ChangeProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProductStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ChangeProductStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ChangeProductStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ChangeProductStatus(ctx, req.(*ChangeProductStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_IssueProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).IssueProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_IssueProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).IssueProduct(ctx, req.(*IssueProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListShelf(ctx, req.(*ListShelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListCellProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCellProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListCellProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListCellProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListCellProducts(ctx, req.(*ListCellProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ScanSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PVZServiceServer).ScanSession(&grpc.GenericServerStream[ScanSessionRequest, ScanSessionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_ScanSessionServer = grpc.BidiStreamingServer[ScanSessionRequest, ScanSessionResponse]
+
+func _PVZService_WatchPVZEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPVZEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PVZServiceServer).WatchPVZEvents(m, &grpc.GenericServerStream[WatchPVZEventsRequest, PVZEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsServer = grpc.ServerStreamingServer[PVZEvent]
+
 // PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +857,99 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPVZList",
 			Handler:    _PVZService_GetPVZList_Handler,
 		},
+		{
+			MethodName: "ListPVZ",
+			Handler:    _PVZService_ListPVZ_Handler,
+		},
+		{
+			MethodName: "CreatePVZ",
+			Handler:    _PVZService_CreatePVZ_Handler,
+		},
+		{
+			MethodName: "FindNearestPVZ",
+			Handler:    _PVZService_FindNearestPVZ_Handler,
+		},
+		{
+			MethodName: "ChangePVZStatus",
+			Handler:    _PVZService_ChangePVZStatus_Handler,
+		},
+		{
+			MethodName: "SetPVZCapacity",
+			Handler:    _PVZService_SetPVZCapacity_Handler,
+		},
+		{
+			MethodName: "AssignEmployee",
+			Handler:    _PVZService_AssignEmployee_Handler,
+		},
+		{
+			MethodName: "UnassignEmployee",
+			Handler:    _PVZService_UnassignEmployee_Handler,
+		},
+		{
+			MethodName: "ListPVZEmployees",
+			Handler:    _PVZService_ListPVZEmployees_Handler,
+		},
+		{
+			MethodName: "CreateReception",
+			Handler:    _PVZService_CreateReception_Handler,
+		},
+		{
+			MethodName: "CloseLastReception",
+			Handler:    _PVZService_CloseLastReception_Handler,
+		},
+		{
+			MethodName: "ReopenReception",
+			Handler:    _PVZService_ReopenReception_Handler,
+		},
+		{
+			MethodName: "AddProduct",
+			Handler:    _PVZService_AddProduct_Handler,
+		},
+		{
+			MethodName: "AddProducts",
+			Handler:    _PVZService_AddProducts_Handler,
+		},
+		{
+			MethodName: "DeleteLastProduct",
+			Handler:    _PVZService_DeleteLastProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _PVZService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "FindProductByBarcode",
+			Handler:    _PVZService_FindProductByBarcode_Handler,
+		},
+		{
+			MethodName: "ChangeProductStatus",
+			Handler:    _PVZService_ChangeProductStatus_Handler,
+		},
+		{
+			MethodName: "IssueProduct",
+			Handler:    _PVZService_IssueProduct_Handler,
+		},
+		{
+			MethodName: "ListShelf",
+			Handler:    _PVZService_ListShelf_Handler,
+		},
+		{
+			MethodName: "ListCellProducts",
+			Handler:    _PVZService_ListCellProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ScanSession",
+			Handler:       _PVZService_ScanSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchPVZEvents",
+			Handler:       _PVZService_WatchPVZEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/pvz.proto",
 }
@@ -3,19 +3,21 @@ package app
 import (
 	"context"
 	"fmt"
-	"net"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"google.golang.org/grpc"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
-	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
 	"github.com/smthjapanese/avito_pvz/internal/config"
+	grpcDelivery "github.com/smthjapanese/avito_pvz/internal/delivery/grpc"
 	"github.com/smthjapanese/avito_pvz/internal/delivery/http/handler"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/openapi"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/health"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/jwt"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
@@ -26,15 +28,21 @@ import (
 type App struct {
 	cfg           *config.Config
 	httpServer    *http.Server
-	grpcServer    *grpc.Server
+	grpcServer    *grpcDelivery.Server
 	metricsServer *http.Server
 	logger        logger.Logger
 	metrics       *metrics.Metrics
 	db            *database.Database
+	healthChecker *health.Checker
 	repositories  *repository.Repositories
 	useCases      *implUsecase.UseCases
 	tokenManager  *jwt.Manager
 	httpHandler   *handler.Handler
+	eventBroker   *events.Broker
+	// receptionCloser закрывает зависшие приемки, nil если автозакрытие отключено
+	receptionCloser *receptionCloser
+	// idempotencyCleaner удаляет истекшие ключи идемпотентности
+	idempotencyCleaner *idempotencyCleaner
 }
 
 // GetPVZUseCase возвращает PVZ use case
@@ -58,24 +66,40 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	// Проверка готовности для HTTP проб и gRPC health сервиса
+	healthChecker := health.NewChecker(db)
+
 	// Инициализация JWT менеджера
 	tokenManager := jwt.NewManager(cfg.Auth.JWTSecret, cfg.Auth.JWTExpiration)
 
 	// Инициализация репозиториев
 	repos := repository.NewRepositories(db)
 
+	// Инициализация брокера событий ПВЗ
+	eventBroker := events.NewBroker()
+
 	// Инициализация use cases
-	useCases := implUsecase.NewUseCases(repos, tokenManager)
+	useCases := implUsecase.NewUseCases(repos, tokenManager, eventBroker, m, cfg.Idempotency.TTL)
 
 	// Инициализация HTTP-сервера
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	router.Use(gin.Recovery())
 	router.Use(gin.Logger())
+	// Идентификатор запроса попадает в конверт ошибки и в лог внутренних ошибок
+	router.Use(middleware.RequestID())
+	router.Use(middleware.ErrorLogger(l))
+
+	// Проверка запросов и ответов по спецификации OpenAPI
+	apiValidator, err := openapi.NewValidator(l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize openapi validator: %w", err)
+	}
+	router.GET("/openapi.json", apiValidator.SpecHandler())
 
 	// Инициализация обработчиков
 	httpHandler := handler.NewHandler(useCases, l, m)
-	httpHandler.Init(router)
+	httpHandler.Init(router, apiValidator.Middleware())
 
 	httpServer := &http.Server{
 		Addr:         ":" + cfg.Server.HTTPPort,
@@ -85,30 +109,39 @@ func NewApp(cfg *config.Config) (*App, error) {
 	}
 
 	// Создание gRPC сервера
-	grpcServer := grpc.NewServer()
-	pvzServer := &PVZServer{pvzUseCase: useCases.PVZ}
-	pbv1.RegisterPVZServiceServer(grpcServer, pvzServer)
+	grpcServer := grpcDelivery.NewServer(useCases, eventBroker, healthChecker, l, m)
 
-	// Создание сервера для метрик
+	// Создание сервера для метрик и проб живости и готовности
 	metricsRouter := gin.New()
 	metricsRouter.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	handler.NewHealthHandler(healthChecker, l).Init(metricsRouter)
 	metricsServer := &http.Server{
 		Addr:    ":" + cfg.Server.MetricsPort,
 		Handler: metricsRouter,
 	}
 
+	// Фоновое закрытие зависших приемок
+	var closer *receptionCloser
+	if cfg.Reception.AutoCloseAfter > 0 {
+		closer = newReceptionCloser(useCases.Reception, cfg.Reception.AutoCloseAfter, cfg.Reception.AutoCloseInterval, l, m)
+	}
+
 	return &App{
-		cfg:           cfg,
-		httpServer:    httpServer,
-		grpcServer:    grpcServer,
-		metricsServer: metricsServer,
-		logger:        l,
-		metrics:       m,
-		db:            db,
-		repositories:  repos,
-		useCases:      useCases,
-		tokenManager:  tokenManager,
-		httpHandler:   httpHandler,
+		cfg:                cfg,
+		httpServer:         httpServer,
+		grpcServer:         grpcServer,
+		metricsServer:      metricsServer,
+		logger:             l,
+		metrics:            m,
+		db:                 db,
+		healthChecker:      healthChecker,
+		repositories:       repos,
+		useCases:           useCases,
+		tokenManager:       tokenManager,
+		httpHandler:        httpHandler,
+		eventBroker:        eventBroker,
+		receptionCloser:    closer,
+		idempotencyCleaner: newIdempotencyCleaner(useCases.Idempotency, cfg.Idempotency.CleanupInterval, l),
 	}, nil
 }
 
@@ -123,16 +156,19 @@ func (a *App) Run() error {
 	}()
 
 	// Запуск gRPC сервера
-	go func() {
-		a.logger.Info(fmt.Sprintf("Starting gRPC server on port %s", a.cfg.Server.GRPCPort))
-		lis, err := net.Listen("tcp", ":"+a.cfg.Server.GRPCPort)
-		if err != nil {
-			a.logger.Fatal(fmt.Sprintf("Failed to listen for gRPC: %v", err))
-		}
-		if err := a.grpcServer.Serve(lis); err != nil {
-			a.logger.Fatal(fmt.Sprintf("Failed to start gRPC server: %v", err))
-		}
-	}()
+	a.logger.Info(fmt.Sprintf("Starting gRPC server on port %s", a.cfg.Server.GRPCPort))
+	if err := a.grpcServer.Start(a.cfg.Server.GRPCPort); err != nil {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
+
+	if a.receptionCloser != nil {
+		a.logger.Info(fmt.Sprintf("Closing receptions idle for %s", a.cfg.Reception.AutoCloseAfter))
+		a.receptionCloser.Start()
+	}
+
+	if a.idempotencyCleaner != nil {
+		a.idempotencyCleaner.Start()
+	}
 
 	// Запуск сервера метрик
 	go func() {
@@ -146,6 +182,16 @@ func (a *App) Run() error {
 }
 
 func (a *App) Shutdown(ctx context.Context) error {
+	// Сначала снимаем готовность и даем балансировщику время перестать присылать запросы
+	a.healthChecker.SetShuttingDown()
+	if a.cfg.Server.ShutdownDelay > 0 {
+		a.logger.Info(fmt.Sprintf("Draining traffic for %s", a.cfg.Server.ShutdownDelay))
+		select {
+		case <-time.After(a.cfg.Server.ShutdownDelay):
+		case <-ctx.Done():
+		}
+	}
+
 	// Остановка HTTP сервера
 	if err := a.httpServer.Shutdown(ctx); err != nil {
 		return fmt.Errorf("failed to shutdown HTTP server: %w", err)
@@ -155,7 +201,15 @@ func (a *App) Shutdown(ctx context.Context) error {
 		return fmt.Errorf("failed to shutdown metrics server: %w", err)
 	}
 
-	a.grpcServer.GracefulStop()
+	a.grpcServer.Stop()
+
+	if a.receptionCloser != nil {
+		a.receptionCloser.Stop()
+	}
+
+	if a.idempotencyCleaner != nil {
+		a.idempotencyCleaner.Stop()
+	}
 
 	if a.db != nil {
 		if err := a.db.Close(); err != nil {
@@ -165,28 +219,3 @@ func (a *App) Shutdown(ctx context.Context) error {
 
 	return nil
 }
-
-// PVZServer реализует gRPC сервер для PVZ
-type PVZServer struct {
-	pbv1.UnimplementedPVZServiceServer
-	pvzUseCase usecase.PVZUseCase
-}
-
-// GetPVZList реализует gRPC метод для получения списка ПВЗ
-func (s *PVZServer) GetPVZList(ctx context.Context, req *pbv1.GetPVZListRequest) (*pbv1.GetPVZListResponse, error) {
-	pvzs, err := s.pvzUseCase.GetAll(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	response := &pbv1.GetPVZListResponse{}
-	for _, pvz := range pvzs {
-		response.Pvzs = append(response.Pvzs, &pbv1.PVZ{
-			Id:               pvz.ID.String(),
-			RegistrationDate: timestamppb.New(pvz.RegistrationDate),
-			City:             string(pvz.City),
-		})
-	}
-
-	return response, nil
-}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+// defaultIdempotencyCleanupInterval используется, если интервал очистки не задан в конфигурации
+const defaultIdempotencyCleanupInterval = time.Hour
+
+// idempotencyCleaner периодически удаляет истекшие ключи идемпотентности.
+// Истекший ключ перезаписывается и без очистки, она только не дает таблице расти.
+type idempotencyCleaner struct {
+	idempotencyUseCase usecase.IdempotencyUseCase
+	interval           time.Duration
+	logger             logger.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newIdempotencyCleaner(idempotencyUseCase usecase.IdempotencyUseCase, interval time.Duration, logger logger.Logger) *idempotencyCleaner {
+	if interval <= 0 {
+		interval = defaultIdempotencyCleanupInterval
+	}
+	return &idempotencyCleaner{
+		idempotencyUseCase: idempotencyUseCase,
+		interval:           interval,
+		logger:             logger,
+	}
+}
+
+// Start запускает очистку в отдельной горутине
+func (c *idempotencyCleaner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.deleteExpired(ctx)
+			}
+		}
+	}()
+}
+
+// Stop останавливает очистку и дожидается завершения текущего прохода
+func (c *idempotencyCleaner) Stop() {
+	if c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+}
+
+func (c *idempotencyCleaner) deleteExpired(ctx context.Context) {
+	deleted, err := c.idempotencyUseCase.DeleteExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Error("failed to delete expired idempotency keys", zap.Error(err))
+		}
+		return
+	}
+	if deleted > 0 {
+		c.logger.Info(fmt.Sprintf("Deleted %d expired idempotency keys", deleted))
+	}
+}
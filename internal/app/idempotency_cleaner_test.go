@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+func TestIdempotencyCleaner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idempotencyUseCase := mock_usecase.NewMockIdempotencyUseCase(ctrl)
+	l, _ := logger.NewLogger("error")
+
+	var passes atomic.Int64
+	count := func(context.Context) { passes.Add(1) }
+
+	gomock.InOrder(
+		idempotencyUseCase.EXPECT().DeleteExpired(gomock.Any()).Do(count).Return(int64(3), nil),
+		// Ошибка одного прохода не останавливает очистку
+		idempotencyUseCase.EXPECT().DeleteExpired(gomock.Any()).Do(count).Return(int64(0), errors.ErrDBQuery),
+		idempotencyUseCase.EXPECT().DeleteExpired(gomock.Any()).Do(count).Return(int64(0), nil).AnyTimes(),
+	)
+
+	cleaner := newIdempotencyCleaner(idempotencyUseCase, 5*time.Millisecond, l)
+	cleaner.Start()
+
+	assert.Eventually(t, func() bool {
+		return passes.Load() >= 3
+	}, time.Second, 5*time.Millisecond)
+
+	cleaner.Stop()
+
+	stopped := newIdempotencyCleaner(nil, 0, l)
+	assert.Equal(t, defaultIdempotencyCleanupInterval, stopped.interval)
+	stopped.Stop()
+}
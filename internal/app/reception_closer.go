@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+)
+
+// defaultAutoCloseInterval используется, если интервал проверки не задан в конфигурации
+const defaultAutoCloseInterval = time.Minute
+
+// receptionCloser периодически закрывает приемки, которые сотрудники забыли закрыть.
+// Каждую приемку закрывает ровно одна реплика: выборка в хранилище пропускает занятые строки.
+type receptionCloser struct {
+	receptionUseCase usecase.ReceptionUseCase
+	idleFor          time.Duration
+	interval         time.Duration
+	logger           logger.Logger
+	metrics          metrics.MetricsInterface
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newReceptionCloser(receptionUseCase usecase.ReceptionUseCase, idleFor, interval time.Duration, logger logger.Logger, metrics metrics.MetricsInterface) *receptionCloser {
+	if interval <= 0 {
+		interval = defaultAutoCloseInterval
+	}
+	return &receptionCloser{
+		receptionUseCase: receptionUseCase,
+		idleFor:          idleFor,
+		interval:         interval,
+		logger:           logger,
+		metrics:          metrics,
+	}
+}
+
+// Start запускает проверку в отдельной горутине
+func (c *receptionCloser) Start() {
+	ctx, cancel := context.WithCancel(usecase.WithSystemCaller(context.Background()))
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.closeStale(ctx)
+			}
+		}
+	}()
+}
+
+// Stop останавливает проверку и дожидается завершения текущего прохода
+func (c *receptionCloser) Stop() {
+	if c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+}
+
+func (c *receptionCloser) closeStale(ctx context.Context) {
+	closed, err := c.receptionUseCase.CloseStale(ctx, c.idleFor)
+	// Часть приемок могла закрыться до ошибки, их тоже учитываем
+	if len(closed) > 0 {
+		c.metrics.AddReceptionAutoClosed(len(closed))
+		c.logger.Info(fmt.Sprintf("Closed %d stale receptions", len(closed)))
+	}
+	if err != nil && ctx.Err() == nil {
+		c.logger.Error("failed to close stale receptions", zap.Error(err))
+	}
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+)
+
+// countingMetrics запоминает, сколько приемок закрыто по таймауту
+type countingMetrics struct {
+	*metrics.MockMetrics
+	autoClosed atomic.Int64
+}
+
+func (m *countingMetrics) AddReceptionAutoClosed(count int) {
+	m.autoClosed.Add(int64(count))
+}
+
+func TestReceptionCloser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	l, _ := logger.NewLogger("error")
+	m := &countingMetrics{MockMetrics: metrics.NewMockMetrics()}
+
+	first := models.NewReception(uuid.New())
+	second := models.NewReception(uuid.New())
+
+	gomock.InOrder(
+		receptionUseCase.EXPECT().CloseStale(gomock.Any(), time.Hour).Return([]*models.Reception{first, second}, nil),
+		// Закрытая до ошибки приемка тоже попадает в метрику
+		receptionUseCase.EXPECT().CloseStale(gomock.Any(), time.Hour).Return([]*models.Reception{first}, errors.ErrDBQuery),
+		receptionUseCase.EXPECT().CloseStale(gomock.Any(), time.Hour).Return(nil, nil).AnyTimes(),
+	)
+
+	closer := newReceptionCloser(receptionUseCase, time.Hour, 5*time.Millisecond, l, m)
+	closer.Start()
+
+	assert.Eventually(t, func() bool {
+		return m.autoClosed.Load() == 3
+	}, time.Second, 5*time.Millisecond)
+
+	closer.Stop()
+}
+
+func TestReceptionCloser_StopWithoutStart(t *testing.T) {
+	l, _ := logger.NewLogger("error")
+	closer := newReceptionCloser(nil, time.Hour, 0, l, metrics.NewMockMetrics())
+	assert.Equal(t, defaultAutoCloseInterval, closer.interval)
+
+	closer.Stop()
+}
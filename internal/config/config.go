@@ -11,6 +11,10 @@ type Config struct {
 	Database DatabaseConfig `mapstructure:"database"`
 	Auth     AuthConfig     `mapstructure:"auth"`
 	Log      LogConfig      `mapstructure:"log"`
+	// Reception настраивает фоновое закрытие зависших приемок
+	Reception ReceptionConfig `mapstructure:"reception"`
+	// Idempotency настраивает хранение ответов на запросы с Idempotency-Key
+	Idempotency IdempotencyConfig `mapstructure:"idempotency"`
 }
 
 type ServerConfig struct {
@@ -19,6 +23,8 @@ type ServerConfig struct {
 	MetricsPort  string        `mapstructure:"metrics_port"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// ShutdownDelay - время между снятием готовности и остановкой серверов
+	ShutdownDelay time.Duration `mapstructure:"shutdown_delay"`
 }
 
 type DatabaseConfig struct {
@@ -35,6 +41,20 @@ type AuthConfig struct {
 	JWTExpiration time.Duration `mapstructure:"jwt_expiration"`
 }
 
+type ReceptionConfig struct {
+	// AutoCloseAfter - сколько приемка может простоять без товаров, 0 отключает автозакрытие
+	AutoCloseAfter time.Duration `mapstructure:"auto_close_after"`
+	// AutoCloseInterval - как часто искать зависшие приемки
+	AutoCloseInterval time.Duration `mapstructure:"auto_close_interval"`
+}
+
+type IdempotencyConfig struct {
+	// TTL - сколько хранится ответ на запрос, по умолчанию сутки
+	TTL time.Duration `mapstructure:"ttl"`
+	// CleanupInterval - как часто удалять истекшие ключи
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+}
+
 type LogConfig struct {
 	Level string `mapstructure:"level"`
 }
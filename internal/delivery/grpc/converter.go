@@ -0,0 +1,269 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+func toPVZ(pvz *models.PVZ) *pbv1.PVZ {
+	return &pbv1.PVZ{
+		Id:               pvz.ID.String(),
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+		City:             string(pvz.City),
+		Address:          pvz.Address,
+		Coordinates:      toCoordinates(pvz.Coordinates),
+		WorkingHours:     pvz.WorkingHours,
+		Phone:            pvz.Phone,
+		Status:           toPVZStatus(pvz.Status),
+		StatusReason:     pvz.StatusReason,
+		Capacity:         int32(pvz.Capacity),
+		Occupied:         int32(pvz.Occupied),
+		NearlyFull:       pvz.IsNearlyFull(),
+	}
+}
+
+var pvzStatuses = map[models.PVZStatus]pbv1.PVZStatus{
+	models.PVZStatusActive:    pbv1.PVZStatus_PVZ_STATUS_ACTIVE,
+	models.PVZStatusSuspended: pbv1.PVZStatus_PVZ_STATUS_SUSPENDED,
+	models.PVZStatusArchived:  pbv1.PVZStatus_PVZ_STATUS_ARCHIVED,
+}
+
+func toPVZStatus(status models.PVZStatus) pbv1.PVZStatus {
+	return pvzStatuses[status]
+}
+
+// fromPVZStatus возвращает false для неизвестного или незаданного статуса
+func fromPVZStatus(status pbv1.PVZStatus) (models.PVZStatus, bool) {
+	for result, value := range pvzStatuses {
+		if value == status {
+			return result, true
+		}
+	}
+	return "", false
+}
+
+func toCoordinates(coordinates *models.Coordinates) *pbv1.Coordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &pbv1.Coordinates{
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+	}
+}
+
+func fromCoordinates(coordinates *pbv1.Coordinates) *models.Coordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &models.Coordinates{
+		Latitude:  coordinates.GetLatitude(),
+		Longitude: coordinates.GetLongitude(),
+	}
+}
+
+func toReception(reception *models.Reception) *pbv1.Reception {
+	result := &pbv1.Reception{
+		Id:       reception.ID.String(),
+		DateTime: timestamppb.New(reception.DateTime),
+		PvzId:    reception.PVZID.String(),
+		Status:   toReceptionStatus(reception.Status),
+		Kind:     receptionKinds[reception.Kind],
+		MaxItems: int32(reception.MaxItems),
+	}
+	if reception.ReopenedAt != nil {
+		result.ReopenReason = reception.ReopenReason
+		result.ReopenedAt = timestamppb.New(*reception.ReopenedAt)
+	}
+	if reception.IsTimedOut() {
+		result.ClosedBy = reception.ClosedBy
+		result.CloseReason = pbv1.ReceptionCloseReason_RECEPTION_CLOSE_REASON_TIMED_OUT
+	}
+	return result
+}
+
+func toReceptionStatus(status models.ReceptionStatus) pbv1.ReceptionStatus {
+	if status == models.ReceptionStatusClose {
+		return pbv1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	}
+	return pbv1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+var receptionKinds = map[models.ReceptionKind]pbv1.ReceptionKind{
+	models.ReceptionKindInbound:        pbv1.ReceptionKind_RECEPTION_KIND_INBOUND,
+	models.ReceptionKindCustomerReturn: pbv1.ReceptionKind_RECEPTION_KIND_CUSTOMER_RETURN,
+}
+
+// fromReceptionKind возвращает пустой вид для UNSPECIFIED и false для неизвестного значения
+func fromReceptionKind(kind pbv1.ReceptionKind) (models.ReceptionKind, bool) {
+	if kind == pbv1.ReceptionKind_RECEPTION_KIND_UNSPECIFIED {
+		return "", true
+	}
+	for result, value := range receptionKinds {
+		if value == kind {
+			return result, true
+		}
+	}
+	return "", false
+}
+
+func toPVZAssignment(assignment *models.PVZAssignment) *pbv1.PVZAssignment {
+	return &pbv1.PVZAssignment{
+		UserId:    assignment.UserID.String(),
+		PvzId:     assignment.PVZID.String(),
+		CreatedAt: timestamppb.New(assignment.CreatedAt),
+	}
+}
+
+func toProduct(product *models.Product) *pbv1.Product {
+	result := &pbv1.Product{
+		Id:              product.ID.String(),
+		DateTime:        timestamppb.New(product.DateTime),
+		Type:            string(product.Type),
+		ReceptionId:     product.ReceptionID.String(),
+		OrderId:         product.OrderID,
+		Barcode:         product.Barcode,
+		Status:          productStatuses[product.Status],
+		StatusChangedAt: timestamppb.New(product.StatusChangedAt),
+		ReturnReason:    product.ReturnReason,
+	}
+	if product.OriginalProductID != nil {
+		result.OriginalProductId = product.OriginalProductID.String()
+	}
+	if product.CellID != nil {
+		result.CellId = product.CellID.String()
+	}
+	return result
+}
+
+var productStatuses = map[models.ProductStatus]pbv1.ProductStatus{
+	models.ProductStatusAccepted:       pbv1.ProductStatus_PRODUCT_STATUS_ACCEPTED,
+	models.ProductStatusReadyForPickup: pbv1.ProductStatus_PRODUCT_STATUS_READY_FOR_PICKUP,
+	models.ProductStatusIssued:         pbv1.ProductStatus_PRODUCT_STATUS_ISSUED,
+	models.ProductStatusRefused:        pbv1.ProductStatus_PRODUCT_STATUS_REFUSED,
+}
+
+// fromProductStatus возвращает false для неизвестного или незаданного состояния
+func fromProductStatus(status pbv1.ProductStatus) (models.ProductStatus, bool) {
+	for result, value := range productStatuses {
+		if value == status {
+			return result, true
+		}
+	}
+	return "", false
+}
+
+func toPVZWithReceptions(pvz *usecase.PVZWithReceptions) *pbv1.PVZWithReceptions {
+	result := &pbv1.PVZWithReceptions{
+		Pvz:        toPVZ(pvz.PVZ),
+		Receptions: make([]*pbv1.ReceptionWithProducts, 0, len(pvz.Receptions)),
+	}
+
+	for _, reception := range pvz.Receptions {
+		products := make([]*pbv1.Product, 0, len(reception.Products))
+		for _, product := range reception.Products {
+			products = append(products, toProduct(product))
+		}
+		result.Receptions = append(result.Receptions, &pbv1.ReceptionWithProducts{
+			Reception: toReception(reception.Reception),
+			Products:  products,
+		})
+	}
+
+	return result
+}
+
+var pvzEventTypes = map[models.PVZEventType]pbv1.PVZEventType{
+	models.PVZEventReceptionOpened:      pbv1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED,
+	models.PVZEventProductAdded:         pbv1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED,
+	models.PVZEventProductDeleted:       pbv1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED,
+	models.PVZEventReceptionClosed:      pbv1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED,
+	models.PVZEventReceptionReopened:    pbv1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED,
+	models.PVZEventProductStatusChanged: pbv1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_STATUS_CHANGED,
+	models.PVZEventNearlyFull:           pbv1.PVZEventType_PVZ_EVENT_TYPE_PVZ_NEARLY_FULL,
+}
+
+func toPVZEvent(event *models.PVZEvent) *pbv1.PVZEvent {
+	result := &pbv1.PVZEvent{
+		Type:       pvzEventTypes[event.Type],
+		PvzId:      event.PVZID.String(),
+		City:       string(event.City),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+	if event.Reception != nil {
+		result.Reception = toReception(event.Reception)
+	}
+	if event.Product != nil {
+		result.Product = toProduct(event.Product)
+	}
+	if event.PVZ != nil {
+		result.Pvz = toPVZ(event.PVZ)
+	}
+	return result
+}
+
+// parseProductID разбирает идентификатор товара из запроса
+func parseProductID(value string) (uuid.UUID, error) {
+	productID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+	return productID, nil
+}
+
+// parseOriginalProductID разбирает необязательную ссылку на возвращаемый товар, пустая строка дает nil
+func parseOriginalProductID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	productID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid original product id")
+	}
+	return &productID, nil
+}
+
+// parseCellID разбирает необязательный идентификатор ячейки хранения, пустая строка дает nil
+func parseCellID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	cellID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cell id")
+	}
+	return &cellID, nil
+}
+
+// parseUserID разбирает идентификатор пользователя из запроса
+func parseUserID(value string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return userID, nil
+}
+
+// parseReceptionID разбирает идентификатор приемки из запроса
+func parseReceptionID(value string) (uuid.UUID, error) {
+	receptionID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid reception id")
+	}
+	return receptionID, nil
+}
+
+// parsePVZID разбирает идентификатор ПВЗ из запроса
+func parsePVZID(value string) (uuid.UUID, error) {
+	pvzID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid pvz id")
+	}
+	return pvzID, nil
+}
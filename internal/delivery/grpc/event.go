@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+// WatchPVZEvents реализует gRPC метод для подписки на события приемок и товаров.
+// Сотрудник подписывается только на события назначенного ему ПВЗ, отбор по городу и
+// подписка на все ПВЗ доступны модераторам.
+func (s *Server) WatchPVZEvents(req *pbv1.WatchPVZEventsRequest, stream pbv1.PVZService_WatchPVZEventsServer) error {
+	filter := models.PVZEventFilter{City: models.City(req.GetCity())}
+	if req.GetPvzId() != "" {
+		pvzID, err := parsePVZID(req.GetPvzId())
+		if err != nil {
+			return err
+		}
+		filter.PVZID = pvzID
+	}
+
+	if filter.PVZID == uuid.Nil {
+		caller, ok := usecase.CallerFromContext(stream.Context())
+		if !ok || caller.Role != models.ModeratorRole {
+			return errors.ErrPVZAccessDenied
+		}
+	} else if err := s.assignmentUseCase.CheckAccess(stream.Context(), filter.PVZID); err != nil {
+		return err
+	}
+
+	events, unsubscribe := s.events.Subscribe(filter)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toPVZEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
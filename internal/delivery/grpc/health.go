@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/health"
+)
+
+// healthWatchInterval задает период перепроверки готовности для подписчиков Watch
+const healthWatchInterval = time.Second
+
+// healthMethods не требуют авторизации, чтобы их могли вызывать балансировщики и пробы
+var healthMethods = []string{
+	grpc_health_v1.Health_Check_FullMethodName,
+	grpc_health_v1.Health_Watch_FullMethodName,
+}
+
+// healthServer реализует протокол grpc.health.v1 поверх той же проверки готовности, что и /readyz.
+// Пустое имя сервиса означает состояние сервера целиком.
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	checker health.ReadinessChecker
+	done    <-chan struct{}
+}
+
+func (h *healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	servingStatus := h.servingStatus(ctx, req.GetService())
+	if servingStatus == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch отправляет текущее состояние и затем каждое его изменение
+func (h *healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	lastStatus := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		servingStatus := h.servingStatus(stream.Context(), req.GetService())
+		if servingStatus != lastStatus {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			lastStatus = servingStatus
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-h.done:
+			return stream.Send(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING})
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthServer) servingStatus(ctx context.Context, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if service != "" && service != pbv1.PVZService_ServiceDesc.ServiceName {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
+	if err := h.checker.Ready(ctx); err != nil {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
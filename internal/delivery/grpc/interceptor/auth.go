@@ -0,0 +1,133 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+const authorizationMetadata = "authorization"
+
+// AuthInterceptor проверяет JWT токен из метаданных и права доступа к методам
+type AuthInterceptor struct {
+	userUseCase   usecase.UserUseCase
+	methodRoles   map[string][]models.UserRole
+	publicMethods map[string]struct{}
+}
+
+// NewAuthInterceptor создает интерцептор авторизации.
+// methodRoles задает допустимые роли для полного имени метода,
+// пустой список означает доступ для любого аутентифицированного пользователя,
+// а метод без записи в methodRoles запрещен всем. publicMethods вызываются без токена.
+func NewAuthInterceptor(userUseCase usecase.UserUseCase, methodRoles map[string][]models.UserRole, publicMethods ...string) *AuthInterceptor {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = struct{}{}
+	}
+
+	return &AuthInterceptor{
+		userUseCase:   userUseCase,
+		methodRoles:   methodRoles,
+		publicMethods: public,
+	}
+}
+
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	if _, ok := i.publicMethods[method]; ok {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "empty auth metadata")
+	}
+
+	values := md.Get(authorizationMetadata)
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "empty auth metadata")
+	}
+
+	headerParts := strings.Split(values[0], " ")
+	if len(headerParts) != 2 || headerParts[0] != "Bearer" {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth metadata")
+	}
+
+	user, err := i.userUseCase.ValidateToken(ctx, headerParts[1])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if !i.hasAccess(user, method) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return usecase.WithCaller(ctx, user), nil
+}
+
+func (i *AuthInterceptor) hasAccess(user *models.User, method string) bool {
+	roles, ok := i.methodRoles[method]
+	if !ok {
+		return false
+	}
+	if len(roles) == 0 {
+		return true
+	}
+
+	for _, role := range roles {
+		if user.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetUser возвращает пользователя, сохраненного интерцептором в контексте
+func GetUser(ctx context.Context) (*models.User, error) {
+	user, ok := usecase.CallerFromContext(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	return user, nil
+}
+
+// wrappedStream подменяет контекст серверного стрима
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,163 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+const (
+	testModeratorMethod = "/pvz.v1.PVZService/CreatePVZ"
+	testAnyRoleMethod   = "/pvz.v1.PVZService/GetPVZList"
+	testPublicMethod    = "/grpc.health.v1.Health/Check"
+	testUnlistedMethod  = "/pvz.v1.PVZService/Unlisted"
+)
+
+var testMethodRoles = map[string][]models.UserRole{
+	testModeratorMethod: {models.ModeratorRole},
+	testAnyRoleMethod:   {},
+}
+
+func incomingContext(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadata, authorization))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock_usecase.NewMockUserUseCase(ctrl)
+	authInterceptor := NewAuthInterceptor(mockUserUseCase, testMethodRoles, testPublicMethod)
+	unary := authInterceptor.Unary()
+
+	moderator := &models.User{ID: uuid.New(), Email: "moderator@example.com", Role: models.ModeratorRole}
+	employee := &models.User{ID: uuid.New(), Email: "employee@example.com", Role: models.EmployeeRole}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "valid_token").Return(moderator, nil)
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			user, err := GetUser(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, moderator, user)
+			return "ok", nil
+		}
+
+		resp, err := unary(incomingContext("Bearer valid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testModeratorMethod}, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Any Role", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "valid_token").Return(employee, nil)
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+
+		_, err := unary(incomingContext("Bearer valid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testAnyRoleMethod}, handler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Empty Metadata", func(t *testing.T) {
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testAnyRoleMethod}, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Public Method", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, err := GetUser(ctx)
+			assert.ErrorIs(t, err, errors.ErrUnauthorized)
+			return "ok", nil
+		}
+
+		resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testPublicMethod}, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Invalid Header", func(t *testing.T) {
+		_, err := unary(incomingContext("Token valid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testAnyRoleMethod}, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "invalid_token").Return(nil, errors.ErrUnauthorized)
+
+		_, err := unary(incomingContext("Bearer invalid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testAnyRoleMethod}, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Permission Denied", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "valid_token").Return(employee, nil)
+
+		_, err := unary(incomingContext("Bearer valid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testModeratorMethod}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Unlisted Method", func(t *testing.T) {
+		// Метод без правила доступа запрещен любой роли
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "valid_token").Return(moderator, nil)
+
+		_, err := unary(incomingContext("Bearer valid_token"), nil, &grpc.UnaryServerInfo{FullMethod: testUnlistedMethod}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestAuthInterceptor_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock_usecase.NewMockUserUseCase(ctrl)
+	authInterceptor := NewAuthInterceptor(mockUserUseCase, testMethodRoles)
+	stream := authInterceptor.Stream()
+
+	moderator := &models.User{ID: uuid.New(), Email: "moderator@example.com", Role: models.ModeratorRole}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ValidateToken(gomock.Any(), "valid_token").Return(moderator, nil)
+
+		handler := func(srv interface{}, ss grpc.ServerStream) error {
+			user, err := GetUser(ss.Context())
+			require.NoError(t, err)
+			assert.Equal(t, moderator, user)
+			return nil
+		}
+
+		ss := &testServerStream{ctx: incomingContext("Bearer valid_token")}
+		err := stream(nil, ss, &grpc.StreamServerInfo{FullMethod: testModeratorMethod}, handler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		ss := &testServerStream{ctx: context.Background()}
+		err := stream(nil, ss, &grpc.StreamServerInfo{FullMethod: testModeratorMethod}, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	_, err := GetUser(context.Background())
+	assert.ErrorIs(t, err, errors.ErrUnauthorized)
+}
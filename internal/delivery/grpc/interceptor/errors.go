@@ -0,0 +1,117 @@
+package interceptor
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+// errorDomain указывается в ErrorInfo для всех ошибок сервиса
+const errorDomain = "pvz.v1"
+
+// ErrorInterceptor переводит доменные ошибки в gRPC статусы
+type ErrorInterceptor struct {
+	logger logger.Logger
+}
+
+func NewErrorInterceptor(logger logger.Logger) *ErrorInterceptor {
+	return &ErrorInterceptor{
+		logger: logger,
+	}
+}
+
+func (i *ErrorInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, i.toStatusError(info.FullMethod, err)
+		}
+
+		return resp, nil
+	}
+}
+
+func (i *ErrorInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return i.toStatusError(info.FullMethod, err)
+		}
+
+		return nil
+	}
+}
+
+func (i *ErrorInterceptor) toStatusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	st := Status(err)
+	if st.Code() == codes.Internal {
+		i.logger.Error("grpc request failed", zap.String("method", method), zap.Error(err))
+	}
+
+	return st.Err()
+}
+
+// Status переводит доменную ошибку в gRPC статус с ErrorInfo в деталях.
+// Сведения из errors.WithDetails передаются в метаданных ErrorInfo.
+func Status(err error) *status.Status {
+	code := Code(err)
+
+	message := err.Error()
+	metadata := errors.Details(err)
+	if code == codes.Internal {
+		message = "internal server error"
+		metadata = nil
+	}
+
+	st := status.New(code, message)
+	withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   errors.Reason(err),
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if detailsErr != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// Code возвращает gRPC код, соответствующий доменной ошибке
+func Code(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.IsUnauthorized(err), errors.Is(err, errors.ErrInvalidCredentials):
+		return codes.Unauthenticated
+	case errors.IsForbidden(err):
+		return codes.PermissionDenied
+	case errors.Is(err, errors.ErrOpenReceptionNotFound):
+		return codes.FailedPrecondition
+	case errors.IsNotFound(err):
+		return codes.NotFound
+	case errors.IsAlreadyExists(err):
+		return codes.AlreadyExists
+	case errors.IsInvalidInput(err):
+		return codes.InvalidArgument
+	case errors.IsConflict(err):
+		return codes.FailedPrecondition
+	case errors.Is(err, errors.ErrShuttingDown):
+		return codes.Unavailable
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
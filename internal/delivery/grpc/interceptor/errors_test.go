@@ -0,0 +1,134 @@
+package interceptor
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{"PVZ Not Found", errors.ErrPVZNotFound, codes.NotFound},
+		{"Open Reception Not Found", errors.ErrOpenReceptionNotFound, codes.FailedPrecondition},
+		{"Open Reception Exists", errors.ErrOpenReceptionExists, codes.FailedPrecondition},
+		{"No Products To Delete", errors.ErrNoProductsToDelete, codes.FailedPrecondition},
+		{"User Already Exists", errors.ErrUserAlreadyExists, codes.AlreadyExists},
+		{"Duplicate Barcode", errors.ErrDuplicateBarcode, codes.AlreadyExists},
+		{"Invalid City", errors.ErrInvalidCity, codes.InvalidArgument},
+		{"Invalid Product Type", errors.ErrInvalidProductType, codes.InvalidArgument},
+		{"Invalid Credentials", errors.ErrInvalidCredentials, codes.Unauthenticated},
+		{"Forbidden", errors.ErrForbidden, codes.PermissionDenied},
+		{"Shutting Down", errors.ErrShuttingDown, codes.Unavailable},
+		{"Deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"Unknown", stderrors.New("boom"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Code(tt.err))
+		})
+	}
+}
+
+func TestStatus_Details(t *testing.T) {
+	st := Status(errors.ErrPVZNotFound)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, errors.ErrPVZNotFound.Error(), st.Message())
+
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "PVZ_NOT_FOUND", info.Reason)
+	assert.Equal(t, errorDomain, info.Domain)
+}
+
+func TestStatus_Metadata(t *testing.T) {
+	err := errors.WithDetails(errors.Wrap(errors.ErrDuplicateBarcode, "products[1]"), map[string]string{"index": "1"})
+	st := Status(err)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "DUPLICATE_BARCODE", info.Reason)
+	assert.Equal(t, map[string]string{"index": "1"}, info.Metadata)
+}
+
+func TestStatus_ShuttingDown(t *testing.T) {
+	// Причина совпадает с кодом ошибки HTTP API для того же состояния
+	st := Status(errors.ErrShuttingDown)
+	assert.Equal(t, codes.Unavailable, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "SHUTTING_DOWN", info.Reason)
+}
+
+func TestStatus_InternalHidesMessage(t *testing.T) {
+	st := Status(stderrors.New("pq: connection refused"))
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "internal server error", st.Message())
+}
+
+func TestErrorInterceptor_Unary(t *testing.T) {
+	mockLogger, _ := logger.NewLogger("debug")
+	unary := NewErrorInterceptor(mockLogger).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pvz.v1.PVZService/CreateReception"}
+
+	t.Run("Domain Error", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.ErrOpenReceptionExists
+		}
+
+		_, err := unary(context.Background(), nil, info, handler)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Status Passthrough", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pvz id")
+		}
+
+		_, err := unary(context.Background(), nil, info, handler)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid pvz id", st.Message())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+
+		resp, err := unary(context.Background(), nil, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestErrorInterceptor_Stream(t *testing.T) {
+	mockLogger, _ := logger.NewLogger("debug")
+	stream := NewErrorInterceptor(mockLogger).Stream()
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return errors.ErrPVZNotFound
+	}
+
+	err := stream(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/pvz.v1.PVZService/Watch"}, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+const (
+	idempotencyKeyMetadata = "idempotency-key"
+	// idempotentReplayedMetadata помечает ответ, который вернули из сохраненного, не выполняя метод
+	idempotentReplayedMetadata = "idempotent-replayed"
+)
+
+// IdempotencyInterceptor выполняет изменяющий метод с ключом idempotency-key в метаданных один раз.
+// Повтор с тем же ключом и запросом получает сохраненный ответ, с другим запросом - InvalidArgument.
+// Ошибки не сохраняются, чтобы вызов можно было повторить.
+type IdempotencyInterceptor struct {
+	idempotencyUseCase usecase.IdempotencyUseCase
+	methods            map[string]struct{}
+}
+
+// NewIdempotencyInterceptor создает интерцептор для перечисленных методов.
+// Ключи действуют в пределах пользователя, поэтому интерцептор ставится после авторизации.
+func NewIdempotencyInterceptor(idempotencyUseCase usecase.IdempotencyUseCase, methods ...string) *IdempotencyInterceptor {
+	idempotent := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		idempotent[method] = struct{}{}
+	}
+
+	return &IdempotencyInterceptor{
+		idempotencyUseCase: idempotencyUseCase,
+		methods:            idempotent,
+	}
+}
+
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := i.methods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		user, err := GetUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to marshal request")
+		}
+
+		record, err := i.idempotencyUseCase.Begin(ctx, user.ID, key, models.HashIdempotentRequest("grpc", info.FullMethod, body))
+		if err != nil {
+			return nil, err
+		}
+		if record.IsCompleted() {
+			return replay(ctx, record)
+		}
+
+		resp, err := handler(ctx, req)
+
+		// Результат сохраняется, даже если клиент перестал ждать ответ
+		saveCtx := context.WithoutCancel(ctx)
+		if err != nil {
+			_ = i.idempotencyUseCase.Release(saveCtx, record)
+			return nil, err
+		}
+
+		if response, ok := resp.(proto.Message); ok {
+			if packed, err := anypb.New(response); err == nil {
+				if data, err := proto.Marshal(packed); err == nil {
+					_ = i.idempotencyUseCase.Complete(saveCtx, record, 0, data)
+					return resp, nil
+				}
+			}
+		}
+		_ = i.idempotencyUseCase.Release(saveCtx, record)
+		return resp, nil
+	}
+}
+
+// replay восстанавливает сохраненный ответ. Тип ответа хранится вместе с ним.
+func replay(ctx context.Context, record *models.IdempotencyRecord) (interface{}, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(record.Response, &packed); err != nil {
+		return nil, status.Error(codes.Internal, "failed to restore stored response")
+	}
+	response, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to restore stored response")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedMetadata, "true"))
+	return response, nil
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(idempotencyKeyMetadata)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
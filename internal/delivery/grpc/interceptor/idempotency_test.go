@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+const testIdempotentMethod = "/pvz.v1.PVZService/AddProduct"
+
+func TestIdempotencyInterceptor_Unary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIdempotencyUseCase := mock_usecase.NewMockIdempotencyUseCase(ctrl)
+	unary := NewIdempotencyInterceptor(mockIdempotencyUseCase, testIdempotentMethod).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: testIdempotentMethod}
+
+	user := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+	ctx := usecase.WithCaller(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyMetadata, "scan-42")),
+		user,
+	)
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return wrapperspb.String("product-1"), nil
+	}
+	req := wrapperspb.String("обувь")
+
+	t.Run("First call is executed and stored", func(t *testing.T) {
+		record := models.NewIdempotencyRecord(user.ID, "scan-42", "hash", time.Minute)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", gomock.Any()).Return(record, nil)
+		mockIdempotencyUseCase.EXPECT().Complete(gomock.Any(), record, 0, gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *models.IdempotencyRecord, statusCode int, response []byte) error {
+				record.Complete(statusCode, response, time.Hour)
+				return nil
+			})
+
+		resp, err := unary(ctx, req, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "product-1", resp.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, 1, calls)
+		assert.True(t, record.IsCompleted())
+	})
+
+	t.Run("Replay returns stored response", func(t *testing.T) {
+		packed, err := anypb.New(wrapperspb.String("product-1"))
+		require.NoError(t, err)
+		data, err := proto.Marshal(packed)
+		require.NoError(t, err)
+
+		record := models.NewIdempotencyRecord(user.ID, "scan-42", "hash", time.Minute)
+		record.Complete(0, data, time.Hour)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", gomock.Any()).Return(record, nil)
+
+		resp, err := unary(ctx, req, info, handler)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(wrapperspb.String("product-1"), resp.(proto.Message)))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Key reused with another request", func(t *testing.T) {
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", gomock.Any()).Return(nil, errors.ErrIdempotencyKeyReused)
+
+		_, err := unary(ctx, wrapperspb.String("одежда"), info, handler)
+		assert.ErrorIs(t, err, errors.ErrIdempotencyKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Failed call releases key", func(t *testing.T) {
+		record := models.NewIdempotencyRecord(user.ID, "scan-42", "hash", time.Minute)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", gomock.Any()).Return(record, nil)
+		mockIdempotencyUseCase.EXPECT().Release(gomock.Any(), record).Return(nil)
+
+		failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.ErrPVZFull
+		}
+
+		_, err := unary(ctx, req, info, failing)
+		assert.ErrorIs(t, err, errors.ErrPVZFull)
+	})
+
+	t.Run("Call without key or to another method", func(t *testing.T) {
+		withoutKey := usecase.WithCaller(context.Background(), user)
+		_, err := unary(withoutKey, req, info, handler)
+		require.NoError(t, err)
+
+		_, err = unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: testAnyRoleMethod}, handler)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+}
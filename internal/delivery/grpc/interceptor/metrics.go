@@ -0,0 +1,51 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+)
+
+// MetricsInterceptor собирает метрики gRPC запросов по методам и кодам ответа
+type MetricsInterceptor struct {
+	metrics metrics.MetricsInterface
+}
+
+func NewMetricsInterceptor(metrics metrics.MetricsInterface) *MetricsInterceptor {
+	return &MetricsInterceptor{
+		metrics: metrics,
+	}
+}
+
+func (i *MetricsInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		i.observe(info.FullMethod, startTime, err)
+
+		return resp, err
+	}
+}
+
+func (i *MetricsInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+
+		err := handler(srv, ss)
+
+		i.observe(info.FullMethod, startTime, err)
+
+		return err
+	}
+}
+
+func (i *MetricsInterceptor) observe(method string, startTime time.Time, err error) {
+	i.metrics.IncGRPCRequestCount(method, status.Code(err).String())
+	i.metrics.ObserveGRPCRequestDuration(method, time.Since(startTime).Seconds())
+}
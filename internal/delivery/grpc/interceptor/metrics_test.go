@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+)
+
+// recordingMetrics запоминает вызовы gRPC метрик
+type recordingMetrics struct {
+	metrics.MockMetrics
+	counts    map[string]int
+	durations map[string]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{
+		counts:    make(map[string]int),
+		durations: make(map[string]int),
+	}
+}
+
+func (m *recordingMetrics) IncGRPCRequestCount(method, status string) {
+	m.counts[method+" "+status]++
+}
+
+func (m *recordingMetrics) ObserveGRPCRequestDuration(method string, duration float64) {
+	m.durations[method]++
+}
+
+func TestMetricsInterceptor_Unary(t *testing.T) {
+	m := newRecordingMetrics()
+	unary := NewMetricsInterceptor(m).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pvz.v1.PVZService/GetPVZList"}
+
+	_, _ = unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	_, _ = unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+
+	assert.Equal(t, 1, m.counts["/pvz.v1.PVZService/GetPVZList OK"])
+	assert.Equal(t, 1, m.counts["/pvz.v1.PVZService/GetPVZList NotFound"])
+	assert.Equal(t, 2, m.durations["/pvz.v1.PVZService/GetPVZList"])
+}
+
+func TestMetricsInterceptor_Stream(t *testing.T) {
+	m := newRecordingMetrics()
+	stream := NewMetricsInterceptor(m).Stream()
+	info := &grpc.StreamServerInfo{FullMethod: "/pvz.v1.PVZService/Watch"}
+
+	_ = stream(nil, &testServerStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	})
+
+	assert.Equal(t, 1, m.counts["/pvz.v1.PVZService/Watch Unauthenticated"])
+	assert.Equal(t, 1, m.durations["/pvz.v1.PVZService/Watch"])
+}
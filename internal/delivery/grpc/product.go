@@ -0,0 +1,249 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+// AddProduct реализует gRPC метод для добавления товара в открытую приемку
+func (s *Server) AddProduct(ctx context.Context, req *pbv1.AddProductRequest) (*pbv1.AddProductResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	originalProductID, err := parseOriginalProductID(req.GetOriginalProductId())
+	if err != nil {
+		return nil, err
+	}
+	cellID, err := parseCellID(req.GetCellId())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUseCase.Create(ctx, pvzID, usecase.ProductInput{
+		Type:              models.ProductType(req.GetType()),
+		OrderID:           req.GetOrderId(),
+		Barcode:           req.GetBarcode(),
+		ReturnReason:      req.GetReturnReason(),
+		OriginalProductID: originalProductID,
+		CellID:            cellID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.IncProductAdded()
+
+	return &pbv1.AddProductResponse{Product: toProduct(product)}, nil
+}
+
+// AddProducts реализует gRPC метод для добавления пачки товаров в открытую приемку
+func (s *Server) AddProducts(ctx context.Context, req *pbv1.AddProductsRequest) (*pbv1.AddProductsResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]usecase.ProductInput, 0, len(req.GetProducts()))
+	for _, item := range req.GetProducts() {
+		originalProductID, err := parseOriginalProductID(item.GetOriginalProductId())
+		if err != nil {
+			return nil, err
+		}
+		cellID, err := parseCellID(item.GetCellId())
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, usecase.ProductInput{
+			Type:              models.ProductType(item.GetType()),
+			OrderID:           item.GetOrderId(),
+			Barcode:           item.GetBarcode(),
+			ReturnReason:      item.GetReturnReason(),
+			OriginalProductID: originalProductID,
+			CellID:            cellID,
+		})
+	}
+
+	products, err := s.productUseCase.CreateBatch(ctx, pvzID, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.AddProductsResponse{}
+	for _, product := range products {
+		s.metrics.IncProductAdded()
+		response.Products = append(response.Products, toProduct(product))
+	}
+	return response, nil
+}
+
+// DeleteLastProduct реализует gRPC метод для удаления последнего товара из открытой приемки
+func (s *Server) DeleteLastProduct(ctx context.Context, req *pbv1.DeleteLastProductRequest) (*pbv1.DeleteLastProductResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.productUseCase.DeleteLastFromReception(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	return &pbv1.DeleteLastProductResponse{}, nil
+}
+
+// DeleteProduct реализует gRPC метод для удаления товара по идентификатору из открытой приемки
+func (s *Server) DeleteProduct(ctx context.Context, req *pbv1.DeleteProductRequest) (*pbv1.DeleteProductResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+	productID, err := parseProductID(req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUseCase.Delete(ctx, pvzID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.DeleteProductResponse{Product: toProduct(product)}, nil
+}
+
+// FindProductByBarcode реализует gRPC метод поиска товара и его ПВЗ по штрихкоду
+func (s *Server) FindProductByBarcode(ctx context.Context, req *pbv1.FindProductByBarcodeRequest) (*pbv1.FindProductByBarcodeResponse, error) {
+	location, err := s.productUseCase.FindByBarcode(ctx, req.GetBarcode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.FindProductByBarcodeResponse{
+		Product:   toProduct(location.Product),
+		Reception: toReception(location.Reception),
+		Pvz:       toPVZ(location.PVZ),
+	}, nil
+}
+
+// ChangeProductStatus реализует gRPC метод для смены состояния товара в ПВЗ
+func (s *Server) ChangeProductStatus(ctx context.Context, req *pbv1.ChangeProductStatusRequest) (*pbv1.ChangeProductStatusResponse, error) {
+	productStatus, ok := fromProductStatus(req.GetStatus())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid product status")
+	}
+
+	product, err := s.changeProductStatus(ctx, req.GetPvzId(), req.GetProductId(), productStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.ChangeProductStatusResponse{Product: toProduct(product)}, nil
+}
+
+// IssueProduct реализует gRPC метод для выдачи товара получателю
+func (s *Server) IssueProduct(ctx context.Context, req *pbv1.IssueProductRequest) (*pbv1.IssueProductResponse, error) {
+	product, err := s.changeProductStatus(ctx, req.GetPvzId(), req.GetProductId(), models.ProductStatusIssued)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.IssueProductResponse{Product: toProduct(product)}, nil
+}
+
+func (s *Server) changeProductStatus(ctx context.Context, rawPVZID, rawProductID string, productStatus models.ProductStatus) (*models.Product, error) {
+	pvzID, err := parsePVZID(rawPVZID)
+	if err != nil {
+		return nil, err
+	}
+	productID, err := parseProductID(rawProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUseCase.ChangeStatus(ctx, pvzID, productID, productStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.IncProductStatusChanged(string(product.Status))
+
+	return product, nil
+}
+
+// ListShelf реализует gRPC метод для получения товаров, которые сейчас находятся в ПВЗ
+func (s *Server) ListShelf(ctx context.Context, req *pbv1.ListShelfRequest) (*pbv1.ListShelfResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	page := int(req.GetPage())
+	if page == 0 {
+		page = defaultListPage
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if page < 1 || limit < 1 || limit > maxListLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "page must be positive and limit must be between 1 and %d", maxListLimit)
+	}
+
+	statuses := make([]models.ProductStatus, 0, len(req.GetStatuses()))
+	for _, value := range req.GetStatuses() {
+		productStatus, ok := fromProductStatus(value)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid product status")
+		}
+		statuses = append(statuses, productStatus)
+	}
+
+	products, err := s.productUseCase.ListShelf(ctx, pvzID, statuses, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.ListShelfResponse{}
+	for _, product := range products {
+		response.Products = append(response.Products, toProduct(product))
+	}
+	if len(products) == limit {
+		response.NextPage = int32(page + 1)
+	}
+
+	return response, nil
+}
+
+// ListCellProducts реализует gRPC метод для получения товаров, которые лежат в ячейке хранения
+func (s *Server) ListCellProducts(ctx context.Context, req *pbv1.ListCellProductsRequest) (*pbv1.ListCellProductsResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+	cellID, err := parseCellID(req.GetCellId())
+	if err != nil {
+		return nil, err
+	}
+	if cellID == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cell id")
+	}
+
+	products, err := s.cellUseCase.ListProducts(ctx, pvzID, *cellID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.ListCellProductsResponse{}
+	for _, product := range products {
+		response.Products = append(response.Products, toProduct(product))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+// GetPVZList реализует gRPC метод для получения списка ПВЗ
+func (s *Server) GetPVZList(ctx context.Context, req *pbv1.GetPVZListRequest) (*pbv1.GetPVZListResponse, error) {
+	pvzs, err := s.pvzUseCase.GetAll(ctx, req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.GetPVZListResponse{}
+	for _, pvz := range pvzs {
+		response.Pvzs = append(response.Pvzs, toPVZ(pvz))
+	}
+
+	return response, nil
+}
+
+const (
+	defaultListPage  = 1
+	defaultListLimit = 10
+	maxListLimit     = 30
+)
+
+// ListPVZ реализует gRPC метод для получения ПВЗ с приемками и товарами
+// с фильтрацией по дате регистрации и постраничной выборкой
+func (s *Server) ListPVZ(ctx context.Context, req *pbv1.ListPVZRequest) (*pbv1.ListPVZResponse, error) {
+	page := int(req.GetPage())
+	if page == 0 {
+		page = defaultListPage
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if page < 1 || limit < 1 || limit > maxListLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "page must be positive and limit must be between 1 and %d", maxListLimit)
+	}
+
+	var startDate, endDate *time.Time
+	if req.GetStartDate() != nil {
+		parsedStartDate := req.GetStartDate().AsTime()
+		startDate = &parsedStartDate
+	}
+	if req.GetEndDate() != nil {
+		parsedEndDate := req.GetEndDate().AsTime()
+		endDate = &parsedEndDate
+	}
+
+	receptionKind, ok := fromReceptionKind(req.GetReceptionKind())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid reception kind")
+	}
+
+	pvzs, err := s.pvzUseCase.List(ctx, startDate, endDate, page, limit, req.GetIncludeArchived(), receptionKind)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.ListPVZResponse{}
+	for _, pvz := range pvzs {
+		response.Pvzs = append(response.Pvzs, toPVZWithReceptions(pvz))
+	}
+	if len(pvzs) == limit {
+		response.NextPage = int32(page + 1)
+	}
+
+	return response, nil
+}
+
+// CreatePVZ реализует gRPC метод для создания ПВЗ
+func (s *Server) CreatePVZ(ctx context.Context, req *pbv1.CreatePVZRequest) (*pbv1.CreatePVZResponse, error) {
+	pvz, err := s.pvzUseCase.Create(ctx, usecase.PVZInput{
+		City:         models.City(req.GetCity()),
+		Address:      req.GetAddress(),
+		Coordinates:  fromCoordinates(req.GetCoordinates()),
+		WorkingHours: req.GetWorkingHours(),
+		Phone:        req.GetPhone(),
+		Capacity:     int(req.GetCapacity()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.IncPVZCreated()
+
+	return &pbv1.CreatePVZResponse{Pvz: toPVZ(pvz)}, nil
+}
+
+// defaultNearestRadius - радиус поиска ближайших ПВЗ по умолчанию, метры
+const defaultNearestRadius = 5000
+
+// FindNearestPVZ реализует gRPC метод поиска ПВЗ в радиусе от точки
+func (s *Server) FindNearestPVZ(ctx context.Context, req *pbv1.FindNearestPVZRequest) (*pbv1.FindNearestPVZResponse, error) {
+	point := fromCoordinates(req.GetPoint())
+	if point == nil {
+		return nil, status.Error(codes.InvalidArgument, "point is required")
+	}
+	radius := req.GetRadius()
+	if radius == 0 {
+		radius = defaultNearestRadius
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 1 || limit > maxListLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxListLimit)
+	}
+
+	pvzs, err := s.pvzUseCase.ListNearest(ctx, *point, radius, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.FindNearestPVZResponse{}
+	for _, pvz := range pvzs {
+		response.Pvzs = append(response.Pvzs, &pbv1.NearbyPVZ{
+			Pvz:      toPVZ(pvz.PVZ),
+			Distance: pvz.Distance,
+		})
+	}
+
+	return response, nil
+}
+
+// ChangePVZStatus реализует gRPC метод для приостановки, возобновления и архивации ПВЗ
+func (s *Server) ChangePVZStatus(ctx context.Context, req *pbv1.ChangePVZStatusRequest) (*pbv1.ChangePVZStatusResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+	pvzStatus, ok := fromPVZStatus(req.GetStatus())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	pvz, err := s.pvzUseCase.ChangeStatus(ctx, pvzID, pvzStatus, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.ChangePVZStatusResponse{Pvz: toPVZ(pvz)}, nil
+}
+
+// SetPVZCapacity реализует gRPC метод для изменения вместимости ПВЗ
+func (s *Server) SetPVZCapacity(ctx context.Context, req *pbv1.SetPVZCapacityRequest) (*pbv1.SetPVZCapacityResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	pvz, err := s.pvzUseCase.SetCapacity(ctx, pvzID, int(req.GetCapacity()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.SetPVZCapacityResponse{Pvz: toPVZ(pvz)}, nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+)
+
+// AssignEmployee реализует gRPC метод для закрепления сотрудника за ПВЗ
+func (s *Server) AssignEmployee(ctx context.Context, req *pbv1.AssignEmployeeRequest) (*pbv1.AssignEmployeeResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.assignmentUseCase.Assign(ctx, pvzID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.AssignEmployeeResponse{Assignment: toPVZAssignment(assignment)}, nil
+}
+
+// UnassignEmployee реализует gRPC метод для открепления сотрудника от ПВЗ
+func (s *Server) UnassignEmployee(ctx context.Context, req *pbv1.UnassignEmployeeRequest) (*pbv1.UnassignEmployeeResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentUseCase.Unassign(ctx, pvzID, userID); err != nil {
+		return nil, err
+	}
+
+	return &pbv1.UnassignEmployeeResponse{}, nil
+}
+
+// ListPVZEmployees реализует gRPC метод для получения сотрудников, закрепленных за ПВЗ
+func (s *Server) ListPVZEmployees(ctx context.Context, req *pbv1.ListPVZEmployeesRequest) (*pbv1.ListPVZEmployeesResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.assignmentUseCase.List(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv1.ListPVZEmployeesResponse{}
+	for _, assignment := range assignments {
+		response.Assignments = append(response.Assignments, toPVZAssignment(assignment))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+// CreateReception реализует gRPC метод для создания приемки
+func (s *Server) CreateReception(ctx context.Context, req *pbv1.CreateReceptionRequest) (*pbv1.CreateReceptionResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	kind, ok := fromReceptionKind(req.GetKind())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid reception kind")
+	}
+	if kind == "" {
+		kind = models.ReceptionKindInbound
+	}
+
+	input := usecase.ReceptionInput{Kind: kind, MaxItems: int(req.GetMaxItems())}
+
+	reception, err := s.receptionUseCase.Create(ctx, pvzID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.IncReceptionCreated()
+
+	return &pbv1.CreateReceptionResponse{Reception: toReception(reception)}, nil
+}
+
+// CloseLastReception реализует gRPC метод для закрытия последней открытой приемки
+func (s *Server) CloseLastReception(ctx context.Context, req *pbv1.CloseLastReceptionRequest) (*pbv1.CloseLastReceptionResponse, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.receptionUseCase.CloseLastReception(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.CloseLastReceptionResponse{Reception: toReception(reception)}, nil
+}
+
+// ReopenReception реализует gRPC метод для повторного открытия закрытой приемки
+func (s *Server) ReopenReception(ctx context.Context, req *pbv1.ReopenReceptionRequest) (*pbv1.ReopenReceptionResponse, error) {
+	receptionID, err := parseReceptionID(req.GetReceptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.receptionUseCase.Reopen(ctx, receptionID, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv1.ReopenReceptionResponse{Reception: toReception(reception)}, nil
+}
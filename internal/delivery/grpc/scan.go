@@ -0,0 +1,179 @@
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/grpc/interceptor"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+// ScanSession реализует gRPC метод потокового сканирования товаров в открытую приемку.
+// ПВЗ и приемка ищутся один раз при старте сессии, каждая команда подтверждается ответом.
+// Отклоненная команда подтверждается ошибкой и не прерывает сессию, стрим завершается
+// только при обрыве соединения, остановке сервера или потере доступа к ПВЗ.
+func (s *Server) ScanSession(stream pbv1.PVZService_ScanSessionServer) error {
+	requests, recvErr := s.receiveScanRequests(stream)
+
+	var session *usecase.ScanSession
+	for {
+		var req *pbv1.ScanSessionRequest
+		select {
+		case <-s.done:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case req = <-requests:
+		}
+
+		var resp *pbv1.ScanSessionResponse
+		var err error
+		if start := req.GetStart(); start != nil {
+			var started *usecase.ScanSession
+			started, resp, err = s.startScanSession(stream.Context(), session, start)
+			if err == nil {
+				session = started
+			}
+		} else {
+			resp, err = s.handleScanCommand(stream.Context(), session, req)
+		}
+		if err != nil {
+			if endsScanSession(err) {
+				return err
+			}
+			resp = s.scanErrorAck(err)
+		}
+		resp.CorrelationId = req.GetCorrelationId()
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) startScanSession(ctx context.Context, current *usecase.ScanSession, req *pbv1.StartScanSession) (*usecase.ScanSession, *pbv1.ScanSessionResponse, error) {
+	if current != nil {
+		return nil, nil, errors.Wrap(errors.ErrConflict, "scan session already started")
+	}
+
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return nil, nil, invalidScanCommand(err)
+	}
+
+	session, err := s.productUseCase.StartScanSession(ctx, pvzID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return session, &pbv1.ScanSessionResponse{Ack: &pbv1.ScanSessionResponse_Started{Started: toReception(session.Reception)}}, nil
+}
+
+func (s *Server) handleScanCommand(ctx context.Context, session *usecase.ScanSession, req *pbv1.ScanSessionRequest) (*pbv1.ScanSessionResponse, error) {
+	if session == nil {
+		return nil, errors.Wrap(errors.ErrConflict, "scan session is not started")
+	}
+
+	switch {
+	case req.GetScan() != nil:
+		scan := req.GetScan()
+		originalProductID, err := parseOriginalProductID(scan.GetOriginalProductId())
+		if err != nil {
+			return nil, invalidScanCommand(err)
+		}
+		cellID, err := parseCellID(scan.GetCellId())
+		if err != nil {
+			return nil, invalidScanCommand(err)
+		}
+
+		product, err := s.productUseCase.AddToSession(ctx, session, usecase.ProductInput{
+			Type:              models.ProductType(scan.GetType()),
+			OrderID:           scan.GetOrderId(),
+			Barcode:           scan.GetBarcode(),
+			ReturnReason:      scan.GetReturnReason(),
+			OriginalProductID: originalProductID,
+			CellID:            cellID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.metrics.IncProductAdded()
+
+		return &pbv1.ScanSessionResponse{Ack: &pbv1.ScanSessionResponse_Scanned{Scanned: toProduct(product)}}, nil
+	case req.GetUndo() != nil:
+		product, err := s.productUseCase.UndoInSession(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pbv1.ScanSessionResponse{Ack: &pbv1.ScanSessionResponse_Undone{Undone: toProduct(product)}}, nil
+	default:
+		return nil, errors.Wrap(errors.ErrInvalidInput, "empty scan command")
+	}
+}
+
+// endsScanSession сообщает, что после ошибки команды сессию нельзя продолжать:
+// клиент потерял доступ к ПВЗ или стрим уже завершен
+func endsScanSession(err error) bool {
+	switch interceptor.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.Canceled, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// scanErrorAck подтверждает отклоненную команду с тем же кодом и reason, что вернул бы unary вызов
+func (s *Server) scanErrorAck(err error) *pbv1.ScanSessionResponse {
+	st := interceptor.Status(err)
+	if st.Code() == codes.Internal {
+		s.logger.Error("scan session command failed", zap.Error(err))
+	}
+
+	return &pbv1.ScanSessionResponse{Ack: &pbv1.ScanSessionResponse_Error{Error: &pbv1.ScanError{
+		Code:    uint32(st.Code()),
+		Reason:  errors.Reason(err),
+		Message: st.Message(),
+	}}}
+}
+
+// invalidScanCommand переводит ошибку разбора поля команды в доменную, чтобы подтверждение получило reason
+func invalidScanCommand(err error) error {
+	return errors.Wrap(errors.ErrInvalidInput, status.Convert(err).Message())
+}
+
+// receiveScanRequests читает команды в отдельной горутине, чтобы сессию можно было
+// прервать при остановке сервера, не дожидаясь следующей команды клиента
+func (s *Server) receiveScanRequests(stream pbv1.PVZService_ScanSessionServer) (<-chan *pbv1.ScanSessionRequest, <-chan error) {
+	requests := make(chan *pbv1.ScanSessionRequest)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case requests <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	return requests, recvErr
+}
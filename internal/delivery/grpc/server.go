@@ -1,50 +1,116 @@
 package grpc
 
 import (
-	"context"
 	"log"
 	"net"
 	"sync"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
-
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/grpc/interceptor"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/health"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+	implUsecase "github.com/smthjapanese/avito_pvz/internal/usecase"
 )
 
+// methodRoles описывает права доступа к методам так же, как маршруты HTTP API
+var methodRoles = map[string][]models.UserRole{
+	pbv1.PVZService_GetPVZList_FullMethodName:           {},
+	pbv1.PVZService_ListPVZ_FullMethodName:              {},
+	pbv1.PVZService_CreatePVZ_FullMethodName:            {models.ModeratorRole},
+	pbv1.PVZService_FindNearestPVZ_FullMethodName:       {},
+	pbv1.PVZService_ChangePVZStatus_FullMethodName:      {models.ModeratorRole},
+	pbv1.PVZService_SetPVZCapacity_FullMethodName:       {models.ModeratorRole},
+	pbv1.PVZService_AssignEmployee_FullMethodName:       {models.ModeratorRole},
+	pbv1.PVZService_UnassignEmployee_FullMethodName:     {models.ModeratorRole},
+	pbv1.PVZService_ListPVZEmployees_FullMethodName:     {models.ModeratorRole},
+	pbv1.PVZService_CreateReception_FullMethodName:      {models.EmployeeRole},
+	pbv1.PVZService_CloseLastReception_FullMethodName:   {models.EmployeeRole},
+	pbv1.PVZService_ReopenReception_FullMethodName:      {models.ModeratorRole},
+	pbv1.PVZService_AddProduct_FullMethodName:           {models.EmployeeRole},
+	pbv1.PVZService_AddProducts_FullMethodName:          {models.EmployeeRole},
+	pbv1.PVZService_DeleteLastProduct_FullMethodName:    {models.EmployeeRole},
+	pbv1.PVZService_DeleteProduct_FullMethodName:        {models.EmployeeRole},
+	pbv1.PVZService_FindProductByBarcode_FullMethodName: {},
+	pbv1.PVZService_ChangeProductStatus_FullMethodName:  {models.EmployeeRole},
+	pbv1.PVZService_IssueProduct_FullMethodName:         {models.EmployeeRole},
+	pbv1.PVZService_ListShelf_FullMethodName:            {},
+	pbv1.PVZService_ListCellProducts_FullMethodName:     {models.EmployeeRole},
+	pbv1.PVZService_ScanSession_FullMethodName:          {models.EmployeeRole},
+	pbv1.PVZService_WatchPVZEvents_FullMethodName:       {},
+}
+
+// idempotentMethods изменяют данные и принимают ключ idempotency-key в метаданных
+var idempotentMethods = []string{
+	pbv1.PVZService_CreatePVZ_FullMethodName,
+	pbv1.PVZService_ChangePVZStatus_FullMethodName,
+	pbv1.PVZService_SetPVZCapacity_FullMethodName,
+	pbv1.PVZService_AssignEmployee_FullMethodName,
+	pbv1.PVZService_UnassignEmployee_FullMethodName,
+	pbv1.PVZService_CreateReception_FullMethodName,
+	pbv1.PVZService_CloseLastReception_FullMethodName,
+	pbv1.PVZService_ReopenReception_FullMethodName,
+	pbv1.PVZService_AddProduct_FullMethodName,
+	pbv1.PVZService_AddProducts_FullMethodName,
+	pbv1.PVZService_DeleteLastProduct_FullMethodName,
+	pbv1.PVZService_DeleteProduct_FullMethodName,
+	pbv1.PVZService_ChangeProductStatus_FullMethodName,
+	pbv1.PVZService_IssueProduct_FullMethodName,
+}
+
 type Server struct {
 	pbv1.UnimplementedPVZServiceServer
-	pvzUseCase usecase.PVZUseCase
-	grpcServer *grpc.Server
-	wg         sync.WaitGroup
+	pvzUseCase        usecase.PVZUseCase
+	receptionUseCase  usecase.ReceptionUseCase
+	productUseCase    usecase.ProductUseCase
+	cellUseCase       usecase.StorageCellUseCase
+	assignmentUseCase usecase.PVZAssignmentUseCase
+	events            events.Subscriber
+	logger            logger.Logger
+	metrics           metrics.MetricsInterface
+	grpcServer        *grpc.Server
+	wg                sync.WaitGroup
+	// done закрывается при остановке, чтобы завершить открытые стримы до GracefulStop
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
-func NewServer(pvzUseCase usecase.PVZUseCase) *Server {
-	return &Server{
-		pvzUseCase: pvzUseCase,
-		grpcServer: grpc.NewServer(),
-	}
-}
+func NewServer(useCases *implUsecase.UseCases, eventSubscriber events.Subscriber, checker health.ReadinessChecker, logger logger.Logger, metrics metrics.MetricsInterface, opts ...grpc.ServerOption) *Server {
+	metricsInterceptor := interceptor.NewMetricsInterceptor(metrics)
+	errorInterceptor := interceptor.NewErrorInterceptor(logger)
+	authInterceptor := interceptor.NewAuthInterceptor(useCases.User, methodRoles, healthMethods...)
+	idempotencyInterceptor := interceptor.NewIdempotencyInterceptor(useCases.Idempotency, idempotentMethods...)
 
-// GetPVZList реализует gRPC метод для получения списка ПВЗ
-func (s *Server) GetPVZList(ctx context.Context, req *pbv1.GetPVZListRequest) (*pbv1.GetPVZListResponse, error) {
-	pvzs, err := s.pvzUseCase.GetAll(ctx)
-	if err != nil {
-		return nil, err
-	}
+	// Метрики снаружи, чтобы учитывать итоговый код ответа, в том числе ошибки авторизации.
+	// Идемпотентность после авторизации: ключи действуют в пределах пользователя.
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(metricsInterceptor.Unary(), errorInterceptor.Unary(), authInterceptor.Unary(), idempotencyInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(metricsInterceptor.Stream(), errorInterceptor.Stream(), authInterceptor.Stream()),
+	}, opts...)
 
-	response := &pbv1.GetPVZListResponse{}
-	for _, pvz := range pvzs {
-		response.Pvzs = append(response.Pvzs, &pbv1.PVZ{
-			Id:               pvz.ID.String(),
-			RegistrationDate: timestamppb.New(pvz.RegistrationDate),
-			City:             string(pvz.City),
-		})
+	s := &Server{
+		pvzUseCase:        useCases.PVZ,
+		receptionUseCase:  useCases.Reception,
+		productUseCase:    useCases.Product,
+		cellUseCase:       useCases.Cell,
+		assignmentUseCase: useCases.Assignment,
+		events:            eventSubscriber,
+		logger:            logger,
+		metrics:           metrics,
+		grpcServer:        grpc.NewServer(opts...),
+		done:              make(chan struct{}),
 	}
 
-	return response, nil
+	pbv1.RegisterPVZServiceServer(s.grpcServer, s)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, &healthServer{checker: checker, done: s.done})
+
+	return s
 }
 
 func (s *Server) Start(port string) error {
@@ -53,8 +119,6 @@ func (s *Server) Start(port string) error {
 		return err
 	}
 
-	pbv1.RegisterPVZServiceServer(s.grpcServer, s)
-
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -68,14 +132,18 @@ func (s *Server) Start(port string) error {
 }
 
 func (s *Server) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+	})
+
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 		s.wg.Wait()
 	}
 }
 
-func Start(pvzUseCase usecase.PVZUseCase, port string) {
-	server := NewServer(pvzUseCase)
+func Start(useCases *implUsecase.UseCases, eventSubscriber events.Subscriber, checker health.ReadinessChecker, logger logger.Logger, metrics metrics.MetricsInterface, port string) {
+	server := NewServer(useCases, eventSubscriber, checker, logger, metrics)
 	if err := server.Start(port); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
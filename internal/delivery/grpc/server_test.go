@@ -0,0 +1,1093 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbv1 "github.com/smthjapanese/avito_pvz/github.com/avito_pvz/pvz/pvz_v1"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+	"github.com/smthjapanese/avito_pvz/internal/usecase"
+)
+
+type testServer struct {
+	server           *Server
+	pvzUseCase       *mock_usecase.MockPVZUseCase
+	receptionUseCase *mock_usecase.MockReceptionUseCase
+	productUseCase   *mock_usecase.MockProductUseCase
+	cellUseCase      *mock_usecase.MockStorageCellUseCase
+	userUseCase      *mock_usecase.MockUserUseCase
+	assignUseCase    *mock_usecase.MockPVZAssignmentUseCase
+	events           *events.Broker
+	checker          *testReadinessChecker
+}
+
+type testReadinessChecker struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (c *testReadinessChecker) Ready(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *testReadinessChecker) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func newTestServer(t *testing.T) *testServer {
+	ctrl := gomock.NewController(t)
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockCellUseCase := mock_usecase.NewMockStorageCellUseCase(ctrl)
+	mockUserUseCase := mock_usecase.NewMockUserUseCase(ctrl)
+	mockAssignmentUseCase := mock_usecase.NewMockPVZAssignmentUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	broker := events.NewBroker()
+	checker := &testReadinessChecker{}
+
+	useCases := &usecase.UseCases{
+		PVZ:        mockPVZUseCase,
+		Reception:  mockReceptionUseCase,
+		Product:    mockProductUseCase,
+		Cell:       mockCellUseCase,
+		User:       mockUserUseCase,
+		Assignment: mockAssignmentUseCase,
+	}
+
+	return &testServer{
+		server:           NewServer(useCases, broker, checker, mockLogger, metrics.NewMockMetrics()),
+		pvzUseCase:       mockPVZUseCase,
+		receptionUseCase: mockReceptionUseCase,
+		productUseCase:   mockProductUseCase,
+		cellUseCase:      mockCellUseCase,
+		userUseCase:      mockUserUseCase,
+		assignUseCase:    mockAssignmentUseCase,
+		events:           broker,
+		checker:          checker,
+	}
+}
+
+func TestServer_GetPVZList(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := &models.PVZ{
+		ID:               uuid.New(),
+		RegistrationDate: time.Now(),
+		City:             models.CityMoscow,
+	}
+	ts.pvzUseCase.EXPECT().GetAll(gomock.Any(), false).Return([]*models.PVZ{pvz}, nil)
+
+	resp, err := ts.server.GetPVZList(context.Background(), &pbv1.GetPVZListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Pvzs, 1)
+	assert.Equal(t, pvz.ID.String(), resp.Pvzs[0].Id)
+	assert.Equal(t, string(pvz.City), resp.Pvzs[0].City)
+}
+
+func TestServer_ListPVZ(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	ts.pvzUseCase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), 2, 1, true, models.ReceptionKind("")).
+		DoAndReturn(func(_ context.Context, start, end *time.Time, page, limit int, _ bool, _ models.ReceptionKind) ([]*domainUsecase.PVZWithReceptions, error) {
+			require.NotNil(t, start)
+			require.NotNil(t, end)
+			assert.True(t, startDate.Equal(*start))
+			assert.True(t, endDate.Equal(*end))
+			return []*domainUsecase.PVZWithReceptions{
+				{
+					PVZ: pvz,
+					Receptions: []*domainUsecase.ReceptionWithProducts{
+						{Reception: reception, Products: []*models.Product{product}},
+					},
+				},
+			}, nil
+		})
+
+	resp, err := ts.server.ListPVZ(context.Background(), &pbv1.ListPVZRequest{
+		StartDate:       timestamppb.New(startDate),
+		EndDate:         timestamppb.New(endDate),
+		Page:            2,
+		Limit:           1,
+		IncludeArchived: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Pvzs, 1)
+	assert.Equal(t, pvz.ID.String(), resp.Pvzs[0].Pvz.Id)
+	require.Len(t, resp.Pvzs[0].Receptions, 1)
+	assert.Equal(t, reception.ID.String(), resp.Pvzs[0].Receptions[0].Reception.Id)
+	require.Len(t, resp.Pvzs[0].Receptions[0].Products, 1)
+	assert.Equal(t, product.ID.String(), resp.Pvzs[0].Receptions[0].Products[0].Id)
+	assert.Equal(t, int32(3), resp.NextPage)
+}
+
+func TestServer_ListPVZ_Defaults(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.pvzUseCase.EXPECT().List(gomock.Any(), nil, nil, defaultListPage, defaultListLimit, false, models.ReceptionKind("")).Return(nil, nil)
+
+	resp, err := ts.server.ListPVZ(context.Background(), &pbv1.ListPVZRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Pvzs)
+	assert.Equal(t, int32(0), resp.NextPage)
+}
+
+func TestServer_ListPVZ_InvalidLimit(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.ListPVZ(context.Background(), &pbv1.ListPVZRequest{Limit: maxListLimit + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_CreatePVZ(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityKazan)
+	ts.pvzUseCase.EXPECT().Create(gomock.Any(), domainUsecase.PVZInput{City: models.CityKazan}).Return(pvz, nil)
+
+	resp, err := ts.server.CreatePVZ(context.Background(), &pbv1.CreatePVZRequest{City: string(models.CityKazan)})
+	require.NoError(t, err)
+	assert.Equal(t, pvz.ID.String(), resp.Pvz.Id)
+	assert.Equal(t, string(models.CityKazan), resp.Pvz.City)
+}
+
+func TestServer_CreatePVZ_Error(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.pvzUseCase.EXPECT().Create(gomock.Any(), domainUsecase.PVZInput{City: models.City("Новосибирск")}).Return(nil, errors.ErrInvalidCity)
+
+	_, err := ts.server.CreatePVZ(context.Background(), &pbv1.CreatePVZRequest{City: "Новосибирск"})
+	assert.ErrorIs(t, err, errors.ErrInvalidCity)
+}
+
+func TestServer_CreatePVZ_WithDetails(t *testing.T) {
+	ts := newTestServer(t)
+
+	input := domainUsecase.PVZInput{
+		City:         models.CityMoscow,
+		Address:      "ул. Тверская, 1",
+		Coordinates:  &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137},
+		WorkingHours: "ежедневно 09:00-21:00",
+		Phone:        "+74951234567",
+	}
+	pvz := models.NewPVZ(input.City)
+	pvz.Address = input.Address
+	pvz.Coordinates = input.Coordinates
+	pvz.WorkingHours = input.WorkingHours
+	pvz.Phone = input.Phone
+	ts.pvzUseCase.EXPECT().Create(gomock.Any(), input).Return(pvz, nil)
+
+	resp, err := ts.server.CreatePVZ(context.Background(), &pbv1.CreatePVZRequest{
+		City:         string(input.City),
+		Address:      input.Address,
+		Coordinates:  &pbv1.Coordinates{Latitude: 55.7576, Longitude: 37.6137},
+		WorkingHours: input.WorkingHours,
+		Phone:        input.Phone,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, input.Address, resp.Pvz.Address)
+	assert.Equal(t, 55.7576, resp.Pvz.Coordinates.GetLatitude())
+	assert.Equal(t, input.Phone, resp.Pvz.Phone)
+}
+
+func TestServer_FindNearestPVZ(t *testing.T) {
+	ts := newTestServer(t)
+
+	point := models.Coordinates{Latitude: 55.75, Longitude: 37.61}
+	pvz := models.NewPVZ(models.CityMoscow)
+	ts.pvzUseCase.EXPECT().ListNearest(gomock.Any(), point, float64(defaultNearestRadius), defaultListLimit).
+		Return([]*models.NearbyPVZ{{PVZ: pvz, Distance: 872.5}}, nil)
+
+	resp, err := ts.server.FindNearestPVZ(context.Background(), &pbv1.FindNearestPVZRequest{
+		Point: &pbv1.Coordinates{Latitude: point.Latitude, Longitude: point.Longitude},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Pvzs, 1)
+	assert.Equal(t, pvz.ID.String(), resp.Pvzs[0].Pvz.Id)
+	assert.Equal(t, 872.5, resp.Pvzs[0].Distance)
+}
+
+func TestServer_FindNearestPVZ_MissingPoint(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.FindNearestPVZ(context.Background(), &pbv1.FindNearestPVZRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ChangePVZStatus(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+	ts.pvzUseCase.EXPECT().ChangeStatus(gomock.Any(), pvz.ID, models.PVZStatusSuspended, "ремонт").Return(pvz, nil)
+
+	resp, err := ts.server.ChangePVZStatus(context.Background(), &pbv1.ChangePVZStatusRequest{
+		PvzId:  pvz.ID.String(),
+		Status: pbv1.PVZStatus_PVZ_STATUS_SUSPENDED,
+		Reason: "ремонт",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pbv1.PVZStatus_PVZ_STATUS_SUSPENDED, resp.Pvz.Status)
+	assert.Equal(t, "ремонт", resp.Pvz.StatusReason)
+}
+
+func TestServer_ChangePVZStatus_Unspecified(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.ChangePVZStatus(context.Background(), &pbv1.ChangePVZStatusRequest{PvzId: uuid.NewString()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_SetPVZCapacity(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.Capacity = 300
+	pvz.Occupied = 280
+	ts.pvzUseCase.EXPECT().SetCapacity(gomock.Any(), pvz.ID, 300).Return(pvz, nil)
+
+	resp, err := ts.server.SetPVZCapacity(context.Background(), &pbv1.SetPVZCapacityRequest{PvzId: pvz.ID.String(), Capacity: 300})
+	require.NoError(t, err)
+	assert.Equal(t, int32(300), resp.Pvz.Capacity)
+	assert.Equal(t, int32(280), resp.Pvz.Occupied)
+	assert.True(t, resp.Pvz.NearlyFull)
+
+	_, err = ts.server.SetPVZCapacity(context.Background(), &pbv1.SetPVZCapacityRequest{PvzId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_AssignEmployee(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+	ts.assignUseCase.EXPECT().Assign(gomock.Any(), pvzID, userID).Return(models.NewPVZAssignment(userID, pvzID), nil)
+
+	resp, err := ts.server.AssignEmployee(context.Background(), &pbv1.AssignEmployeeRequest{PvzId: pvzID.String(), UserId: userID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), resp.Assignment.UserId)
+	assert.Equal(t, pvzID.String(), resp.Assignment.PvzId)
+
+	_, err = ts.server.AssignEmployee(context.Background(), &pbv1.AssignEmployeeRequest{PvzId: pvzID.String(), UserId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_UnassignEmployee(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+	ts.assignUseCase.EXPECT().Unassign(gomock.Any(), pvzID, userID).Return(nil)
+
+	_, err := ts.server.UnassignEmployee(context.Background(), &pbv1.UnassignEmployeeRequest{PvzId: pvzID.String(), UserId: userID.String()})
+	require.NoError(t, err)
+}
+
+func TestServer_ListPVZEmployees(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	assignments := []*models.PVZAssignment{
+		models.NewPVZAssignment(uuid.New(), pvzID),
+		models.NewPVZAssignment(uuid.New(), pvzID),
+	}
+	ts.assignUseCase.EXPECT().List(gomock.Any(), pvzID).Return(assignments, nil)
+
+	resp, err := ts.server.ListPVZEmployees(context.Background(), &pbv1.ListPVZEmployeesRequest{PvzId: pvzID.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Assignments, 2)
+	assert.Equal(t, assignments[1].UserID.String(), resp.Assignments[1].UserId)
+}
+
+func TestServer_CreateReception_MaxItems(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	reception.MaxItems = 50
+	ts.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound, MaxItems: 50}).Return(reception, nil)
+
+	resp, err := ts.server.CreateReception(context.Background(), &pbv1.CreateReceptionRequest{PvzId: pvzID.String(), MaxItems: 50})
+	require.NoError(t, err)
+	assert.Equal(t, int32(50), resp.Reception.MaxItems)
+}
+
+func TestServer_CreateReception(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	ts.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(reception, nil)
+
+	resp, err := ts.server.CreateReception(context.Background(), &pbv1.CreateReceptionRequest{PvzId: pvzID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, reception.ID.String(), resp.Reception.Id)
+	assert.Equal(t, pvzID.String(), resp.Reception.PvzId)
+	assert.Equal(t, pbv1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, resp.Reception.Status)
+}
+
+func TestServer_CreateReception_CustomerReturn(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	reception.Kind = models.ReceptionKindCustomerReturn
+	ts.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindCustomerReturn}).Return(reception, nil)
+
+	resp, err := ts.server.CreateReception(context.Background(), &pbv1.CreateReceptionRequest{
+		PvzId: pvzID.String(),
+		Kind:  pbv1.ReceptionKind_RECEPTION_KIND_CUSTOMER_RETURN,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pbv1.ReceptionKind_RECEPTION_KIND_CUSTOMER_RETURN, resp.Reception.Kind)
+
+	_, err = ts.server.CreateReception(context.Background(), &pbv1.CreateReceptionRequest{PvzId: pvzID.String(), Kind: 42})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_CreateReception_InvalidPVZID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.CreateReception(context.Background(), &pbv1.CreateReceptionRequest{PvzId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_CloseLastReception(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	reception.Close()
+	ts.receptionUseCase.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(reception, nil)
+
+	resp, err := ts.server.CloseLastReception(context.Background(), &pbv1.CloseLastReceptionRequest{PvzId: pvzID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, reception.ID.String(), resp.Reception.Id)
+	assert.Equal(t, pbv1.ReceptionStatus_RECEPTION_STATUS_CLOSED, resp.Reception.Status)
+}
+
+func TestServer_ReopenReception(t *testing.T) {
+	ts := newTestServer(t)
+
+	reception := models.NewReception(uuid.New())
+	reception.Close()
+	reception.Reopen("ошибка сотрудника")
+	ts.receptionUseCase.EXPECT().Reopen(gomock.Any(), reception.ID, "ошибка сотрудника").Return(reception, nil)
+
+	resp, err := ts.server.ReopenReception(context.Background(), &pbv1.ReopenReceptionRequest{
+		ReceptionId: reception.ID.String(),
+		Reason:      "ошибка сотрудника",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pbv1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, resp.Reception.Status)
+	assert.Equal(t, "ошибка сотрудника", resp.Reception.ReopenReason)
+	assert.NotNil(t, resp.Reception.ReopenedAt)
+}
+
+func TestServer_ReopenReception_InvalidID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.ReopenReception(context.Background(), &pbv1.ReopenReceptionRequest{ReceptionId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_AddProduct(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	ts.productUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ProductInput{Type: models.ProductTypeShoes}).Return(product, nil)
+
+	resp, err := ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId: pvzID.String(),
+		Type:  string(models.ProductTypeShoes),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, product.ID.String(), resp.Product.Id)
+	assert.Equal(t, product.ReceptionID.String(), resp.Product.ReceptionId)
+	assert.Equal(t, string(models.ProductTypeShoes), resp.Product.Type)
+}
+
+func TestServer_AddProducts(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	cellID := uuid.New()
+	first := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	second := models.NewProduct(models.ProductTypeClothes, first.ReceptionID)
+	second.CellID = &cellID
+
+	ts.productUseCase.EXPECT().CreateBatch(gomock.Any(), pvzID, []domainUsecase.ProductInput{
+		{Type: models.ProductTypeShoes, Barcode: "4600000000001"},
+		{Type: models.ProductTypeClothes, CellID: &cellID},
+	}).Return([]*models.Product{first, second}, nil)
+
+	resp, err := ts.server.AddProducts(context.Background(), &pbv1.AddProductsRequest{
+		PvzId: pvzID.String(),
+		Products: []*pbv1.AddProductsItem{
+			{Type: string(models.ProductTypeShoes), Barcode: "4600000000001"},
+			{Type: string(models.ProductTypeClothes), CellId: cellID.String()},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Products, 2)
+	assert.Equal(t, first.ID.String(), resp.Products[0].Id)
+	assert.Equal(t, second.ID.String(), resp.Products[1].Id)
+
+	// Неверный идентификатор любого товара отклоняет пачку до вызова сервиса
+	_, err = ts.server.AddProducts(context.Background(), &pbv1.AddProductsRequest{
+		PvzId: pvzID.String(),
+		Products: []*pbv1.AddProductsItem{
+			{Type: string(models.ProductTypeShoes)},
+			{Type: string(models.ProductTypeShoes), CellId: "invalid"},
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ts.productUseCase.EXPECT().CreateBatch(gomock.Any(), pvzID, gomock.Any()).Return(nil, errors.ErrInvalidBatchSize)
+
+	_, err = ts.server.AddProducts(context.Background(), &pbv1.AddProductsRequest{PvzId: pvzID.String()})
+	assert.ErrorIs(t, err, errors.ErrInvalidBatchSize)
+}
+
+func TestServer_AddProduct_WithBarcode(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes, OrderID: "ORD-42", Barcode: "4600000000001"}
+	ts.productUseCase.EXPECT().Create(gomock.Any(), pvzID, input).Return(nil, errors.ErrDuplicateBarcode)
+
+	_, err := ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId:   pvzID.String(),
+		Type:    string(input.Type),
+		OrderId: input.OrderID,
+		Barcode: input.Barcode,
+	})
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+}
+
+func TestServer_AddProduct_CustomerReturn(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	originalID := uuid.New()
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes, ReturnReason: "брак", OriginalProductID: &originalID}
+
+	product := models.NewProduct(input.Type, uuid.New())
+	product.ReturnReason = input.ReturnReason
+	product.OriginalProductID = &originalID
+	ts.productUseCase.EXPECT().Create(gomock.Any(), pvzID, input).Return(product, nil)
+
+	resp, err := ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId:             pvzID.String(),
+		Type:              string(input.Type),
+		ReturnReason:      input.ReturnReason,
+		OriginalProductId: originalID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, originalID.String(), resp.Product.OriginalProductId)
+	assert.Equal(t, input.ReturnReason, resp.Product.ReturnReason)
+
+	_, err = ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId:             pvzID.String(),
+		Type:              string(input.Type),
+		OriginalProductId: "invalid",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_AddProduct_StorageCell(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	cellID := uuid.New()
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes, CellID: &cellID}
+
+	product := models.NewProduct(input.Type, uuid.New())
+	product.CellID = &cellID
+	ts.productUseCase.EXPECT().Create(gomock.Any(), pvzID, input).Return(product, nil)
+
+	resp, err := ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId:  pvzID.String(),
+		Type:   string(input.Type),
+		CellId: cellID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cellID.String(), resp.Product.CellId)
+
+	_, err = ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{
+		PvzId:  pvzID.String(),
+		Type:   string(input.Type),
+		CellId: "invalid",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ListCellProducts(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	cellID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.CellID = &cellID
+	ts.cellUseCase.EXPECT().ListProducts(gomock.Any(), pvzID, cellID).Return([]*models.Product{product}, nil)
+
+	resp, err := ts.server.ListCellProducts(context.Background(), &pbv1.ListCellProductsRequest{
+		PvzId:  pvzID.String(),
+		CellId: cellID.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Products, 1)
+	assert.Equal(t, product.ID.String(), resp.Products[0].Id)
+
+	// Ячейка обязательна
+	_, err = ts.server.ListCellProducts(context.Background(), &pbv1.ListCellProductsRequest{PvzId: pvzID.String()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ts.cellUseCase.EXPECT().ListProducts(gomock.Any(), pvzID, cellID).Return(nil, errors.ErrStorageCellNotFound)
+
+	_, err = ts.server.ListCellProducts(context.Background(), &pbv1.ListCellProductsRequest{
+		PvzId:  pvzID.String(),
+		CellId: cellID.String(),
+	})
+	assert.ErrorIs(t, err, errors.ErrStorageCellNotFound)
+}
+
+func TestServer_FindProductByBarcode(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityKazan)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeShoes, reception.ID)
+	product.Barcode = "4600000000001"
+	ts.productUseCase.EXPECT().FindByBarcode(gomock.Any(), product.Barcode).Return(&domainUsecase.ProductLocation{
+		Product:   product,
+		Reception: reception,
+		PVZ:       pvz,
+	}, nil)
+
+	resp, err := ts.server.FindProductByBarcode(context.Background(), &pbv1.FindProductByBarcodeRequest{Barcode: product.Barcode})
+	require.NoError(t, err)
+	assert.Equal(t, product.ID.String(), resp.Product.Id)
+	assert.Equal(t, product.Barcode, resp.Product.Barcode)
+	assert.Equal(t, reception.ID.String(), resp.Reception.Id)
+	assert.Equal(t, pvz.ID.String(), resp.Pvz.Id)
+}
+
+func TestServer_AddProduct_InvalidPVZID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.AddProduct(context.Background(), &pbv1.AddProductRequest{PvzId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_DeleteLastProduct(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	ts.productUseCase.EXPECT().DeleteLastFromReception(gomock.Any(), pvzID).Return(nil)
+
+	_, err := ts.server.DeleteLastProduct(context.Background(), &pbv1.DeleteLastProductRequest{PvzId: pvzID.String()})
+	require.NoError(t, err)
+}
+
+func TestServer_DeleteLastProduct_Error(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	ts.productUseCase.EXPECT().DeleteLastFromReception(gomock.Any(), pvzID).Return(errors.ErrNoProductsToDelete)
+
+	_, err := ts.server.DeleteLastProduct(context.Background(), &pbv1.DeleteLastProductRequest{PvzId: pvzID.String()})
+	assert.ErrorIs(t, err, errors.ErrNoProductsToDelete)
+}
+
+func TestServer_DeleteProduct(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeElectronics, uuid.New())
+	ts.productUseCase.EXPECT().Delete(gomock.Any(), pvzID, product.ID).Return(product, nil)
+
+	resp, err := ts.server.DeleteProduct(context.Background(), &pbv1.DeleteProductRequest{
+		PvzId:     pvzID.String(),
+		ProductId: product.ID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, product.ID.String(), resp.Product.Id)
+}
+
+func TestServer_DeleteProduct_InvalidProductID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.server.DeleteProduct(context.Background(), &pbv1.DeleteProductRequest{
+		PvzId:     uuid.NewString(),
+		ProductId: "invalid",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ChangeProductStatus(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeElectronics, uuid.New())
+	product.SetStatus(models.ProductStatusReadyForPickup)
+	ts.productUseCase.EXPECT().ChangeStatus(gomock.Any(), pvzID, product.ID, models.ProductStatusReadyForPickup).Return(product, nil)
+
+	resp, err := ts.server.ChangeProductStatus(context.Background(), &pbv1.ChangeProductStatusRequest{
+		PvzId:     pvzID.String(),
+		ProductId: product.ID.String(),
+		Status:    pbv1.ProductStatus_PRODUCT_STATUS_READY_FOR_PICKUP,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pbv1.ProductStatus_PRODUCT_STATUS_READY_FOR_PICKUP, resp.Product.Status)
+
+	_, err = ts.server.ChangeProductStatus(context.Background(), &pbv1.ChangeProductStatusRequest{
+		PvzId:     pvzID.String(),
+		ProductId: product.ID.String(),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_IssueProduct(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeElectronics, uuid.New())
+	product.SetStatus(models.ProductStatusIssued)
+	ts.productUseCase.EXPECT().ChangeStatus(gomock.Any(), pvzID, product.ID, models.ProductStatusIssued).Return(product, nil)
+
+	resp, err := ts.server.IssueProduct(context.Background(), &pbv1.IssueProductRequest{
+		PvzId:     pvzID.String(),
+		ProductId: product.ID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pbv1.ProductStatus_PRODUCT_STATUS_ISSUED, resp.Product.Status)
+}
+
+func TestServer_ListShelf(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvzID := uuid.New()
+	products := []*models.Product{
+		models.NewProduct(models.ProductTypeElectronics, uuid.New()),
+		models.NewProduct(models.ProductTypeShoes, uuid.New()),
+	}
+	ts.productUseCase.EXPECT().
+		ListShelf(gomock.Any(), pvzID, []models.ProductStatus{models.ProductStatusAccepted}, 1, 2).
+		Return(products, nil)
+
+	resp, err := ts.server.ListShelf(context.Background(), &pbv1.ListShelfRequest{
+		PvzId:    pvzID.String(),
+		Statuses: []pbv1.ProductStatus{pbv1.ProductStatus_PRODUCT_STATUS_ACCEPTED},
+		Limit:    2,
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.Products, 2)
+	assert.Equal(t, int32(2), resp.NextPage)
+}
+
+// Интерцептор авторизации запрещает методы без правила доступа, поэтому новый метод
+// без записи в methodRoles был бы недоступен
+func TestMethodRoles_CoverAllMethods(t *testing.T) {
+	for _, method := range pbv1.PVZService_ServiceDesc.Methods {
+		fullMethod := "/" + pbv1.PVZService_ServiceDesc.ServiceName + "/" + method.MethodName
+		_, ok := methodRoles[fullMethod]
+		assert.True(t, ok, "method %s has no access rule", fullMethod)
+	}
+	for _, stream := range pbv1.PVZService_ServiceDesc.Streams {
+		fullMethod := "/" + pbv1.PVZService_ServiceDesc.ServiceName + "/" + stream.StreamName
+		_, ok := methodRoles[fullMethod]
+		assert.True(t, ok, "stream %s has no access rule", fullMethod)
+	}
+}
+
+// newTestConn запускает сервер на bufconn и возвращает подключение к нему
+func newTestConn(t *testing.T, ts *testServer) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = ts.server.grpcServer.Serve(lis)
+	}()
+	t.Cleanup(ts.server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestServer_Auth(t *testing.T) {
+	ts := newTestServer(t)
+	client := pbv1.NewPVZServiceClient(newTestConn(t, ts))
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := client.GetPVZList(context.Background(), &pbv1.GetPVZListRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Permission Denied", func(t *testing.T) {
+		employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+		ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "employee_token").Return(employee, nil)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer employee_token")
+		_, err := client.CreatePVZ(ctx, &pbv1.CreatePVZRequest{City: string(models.CityMoscow)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Domain Error", func(t *testing.T) {
+		employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+		pvzID := uuid.New()
+		ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "employee_token").Return(employee, nil)
+		ts.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(nil, errors.ErrPVZNotFound)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer employee_token")
+		_, err := client.CreateReception(ctx, &pbv1.CreateReceptionRequest{PvzId: pvzID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("PVZ Not Assigned", func(t *testing.T) {
+		employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+		pvzID := uuid.New()
+		ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "employee_token").Return(employee, nil)
+		ts.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ uuid.UUID, _ domainUsecase.ReceptionInput) (*models.Reception, error) {
+				// Сценарий проверяет доступ по пользователю, которого интерцептор положил в контекст
+				caller, ok := domainUsecase.CallerFromContext(ctx)
+				require.True(t, ok)
+				assert.Equal(t, employee.ID, caller.ID)
+				return nil, errors.ErrPVZAccessDenied
+			})
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer employee_token")
+		_, err := client.CreateReception(ctx, &pbv1.CreateReceptionRequest{PvzId: pvzID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		moderator := &models.User{ID: uuid.New(), Role: models.ModeratorRole}
+		ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "moderator_token").Return(moderator, nil)
+		ts.pvzUseCase.EXPECT().Create(gomock.Any(), domainUsecase.PVZInput{City: models.CityMoscow}).Return(models.NewPVZ(models.CityMoscow), nil)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer moderator_token")
+		resp, err := client.CreatePVZ(ctx, &pbv1.CreatePVZRequest{City: string(models.CityMoscow)})
+		require.NoError(t, err)
+		assert.Equal(t, string(models.CityMoscow), resp.Pvz.City)
+	})
+}
+
+type testEventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbv1.PVZEvent
+}
+
+func (s *testEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEventStream) Send(event *pbv1.PVZEvent) error {
+	s.sent <- event
+	return nil
+}
+
+func TestServer_WatchPVZEvents(t *testing.T) {
+	ts := newTestServer(t)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	otherPVZ := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+
+	ts.assignUseCase.EXPECT().CheckAccess(gomock.Any(), pvz.ID).Return(nil)
+
+	stream := &testEventStream{ctx: context.Background(), sent: make(chan *pbv1.PVZEvent, 16)}
+	done := make(chan error, 1)
+	go func() {
+		done <- ts.server.WatchPVZEvents(&pbv1.WatchPVZEventsRequest{PvzId: pvz.ID.String()}, stream)
+	}()
+
+	// Публикуем, пока стрим не подпишется на брокер
+	var event *pbv1.PVZEvent
+	require.Eventually(t, func() bool {
+		ts.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, otherPVZ, models.NewReception(otherPVZ.ID)))
+		ts.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, reception))
+		select {
+		case event = <-stream.sent:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, pbv1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED, event.Type)
+	assert.Equal(t, pvz.ID.String(), event.PvzId)
+	assert.Equal(t, string(models.CityMoscow), event.City)
+	assert.Equal(t, reception.ID.String(), event.Reception.Id)
+	assert.Nil(t, event.Product)
+
+	// Остановка сервера завершает открытые стримы
+	ts.server.Stop()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed on server stop")
+	}
+}
+
+func TestServer_WatchPVZEvents_AccessDenied(t *testing.T) {
+	ts := newTestServer(t)
+
+	employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+	ctx := domainUsecase.WithCaller(context.Background(), employee)
+	pvzID := uuid.New()
+
+	// ПВЗ, за которым сотрудник не закреплен
+	ts.assignUseCase.EXPECT().CheckAccess(gomock.Any(), pvzID).Return(errors.ErrPVZAccessDenied)
+
+	stream := &testEventStream{ctx: ctx, sent: make(chan *pbv1.PVZEvent, 1)}
+	err := ts.server.WatchPVZEvents(&pbv1.WatchPVZEventsRequest{PvzId: pvzID.String()}, stream)
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+
+	// Сотруднику недоступны события всех ПВЗ города
+	err = ts.server.WatchPVZEvents(&pbv1.WatchPVZEventsRequest{City: string(models.CityMoscow)}, stream)
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+}
+
+func TestServer_WatchPVZEvents_InvalidPVZID(t *testing.T) {
+	ts := newTestServer(t)
+
+	stream := &testEventStream{ctx: context.Background(), sent: make(chan *pbv1.PVZEvent, 1)}
+	err := ts.server.WatchPVZEvents(&pbv1.WatchPVZEventsRequest{PvzId: "invalid"}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ScanSession(t *testing.T) {
+	ts := newTestServer(t)
+	client := pbv1.NewPVZServiceClient(newTestConn(t, ts))
+
+	employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+	ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "employee_token").Return(employee, nil).AnyTimes()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer employee_token")
+
+	t.Run("Success", func(t *testing.T) {
+		pvz := models.NewPVZ(models.CityMoscow)
+		session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+		first := models.NewProduct(models.ProductTypeShoes, session.Reception.ID)
+		second := models.NewProduct(models.ProductTypeClothes, session.Reception.ID)
+
+		// Приемка ищется один раз на всю сессию
+		ts.productUseCase.EXPECT().StartScanSession(gomock.Any(), pvz.ID).Return(session, nil)
+		ts.productUseCase.EXPECT().AddToSession(gomock.Any(), session, domainUsecase.ProductInput{Type: models.ProductTypeShoes}).Return(first, nil)
+		ts.productUseCase.EXPECT().AddToSession(gomock.Any(), session, domainUsecase.ProductInput{Type: models.ProductTypeClothes}).Return(second, nil)
+		ts.productUseCase.EXPECT().UndoInSession(gomock.Any(), session).Return(second, nil)
+
+		stream, err := client.ScanSession(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Start{Start: &pbv1.StartScanSession{PvzId: pvz.ID.String()}}}))
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, session.Reception.ID.String(), resp.GetStarted().GetId())
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Scan{Scan: &pbv1.ScanProduct{Type: string(models.ProductTypeShoes)}}}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Scan{Scan: &pbv1.ScanProduct{Type: string(models.ProductTypeClothes)}}}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Undo{Undo: &pbv1.UndoScan{}}}))
+		require.NoError(t, stream.CloseSend())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, first.ID.String(), resp.GetScanned().GetId())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, second.ID.String(), resp.GetScanned().GetId())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, second.ID.String(), resp.GetUndone().GetId())
+
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Not Started", func(t *testing.T) {
+		stream, err := client.ScanSession(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Undo{Undo: &pbv1.UndoScan{}}, CorrelationId: "undo-1"}))
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "undo-1", resp.GetCorrelationId())
+		assert.Equal(t, uint32(codes.FailedPrecondition), resp.GetError().GetCode())
+		assert.Equal(t, "CONFLICT", resp.GetError().GetReason())
+	})
+
+	t.Run("Domain Error", func(t *testing.T) {
+		pvz := models.NewPVZ(models.CityMoscow)
+		session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+		product := models.NewProduct(models.ProductTypeShoes, session.Reception.ID)
+
+		// Отклоненные команды подтверждаются ошибкой, сессия продолжается
+		ts.productUseCase.EXPECT().StartScanSession(gomock.Any(), pvz.ID).Return(session, nil)
+		ts.productUseCase.EXPECT().AddToSession(gomock.Any(), session, domainUsecase.ProductInput{Type: models.ProductTypeShoes, Barcode: "4601234567890"}).Return(nil, errors.ErrDuplicateBarcode)
+		ts.productUseCase.EXPECT().AddToSession(gomock.Any(), session, domainUsecase.ProductInput{Type: models.ProductTypeShoes}).Return(product, nil)
+
+		stream, err := client.ScanSession(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Start{Start: &pbv1.StartScanSession{PvzId: "invalid"}}, CorrelationId: "start-1"}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Start{Start: &pbv1.StartScanSession{PvzId: pvz.ID.String()}}, CorrelationId: "start-2"}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Scan{Scan: &pbv1.ScanProduct{Type: string(models.ProductTypeShoes), Barcode: "4601234567890"}}, CorrelationId: "scan-1"}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Scan{Scan: &pbv1.ScanProduct{Type: string(models.ProductTypeShoes), CellId: "invalid"}}, CorrelationId: "scan-2"}))
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Scan{Scan: &pbv1.ScanProduct{Type: string(models.ProductTypeShoes)}}, CorrelationId: "scan-3"}))
+		require.NoError(t, stream.CloseSend())
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "start-1", resp.GetCorrelationId())
+		assert.Equal(t, uint32(codes.InvalidArgument), resp.GetError().GetCode())
+		assert.Equal(t, "INVALID_INPUT", resp.GetError().GetReason())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "start-2", resp.GetCorrelationId())
+		assert.Equal(t, session.Reception.ID.String(), resp.GetStarted().GetId())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "scan-1", resp.GetCorrelationId())
+		assert.Equal(t, uint32(codes.AlreadyExists), resp.GetError().GetCode())
+		assert.Equal(t, "DUPLICATE_BARCODE", resp.GetError().GetReason())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "scan-2", resp.GetCorrelationId())
+		assert.Equal(t, "INVALID_INPUT", resp.GetError().GetReason())
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "scan-3", resp.GetCorrelationId())
+		assert.Equal(t, product.ID.String(), resp.GetScanned().GetId())
+
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Access Revoked", func(t *testing.T) {
+		pvz := models.NewPVZ(models.CityMoscow)
+		session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+
+		// Потеря доступа к ПВЗ завершает сессию
+		ts.productUseCase.EXPECT().StartScanSession(gomock.Any(), pvz.ID).Return(session, nil)
+		ts.productUseCase.EXPECT().UndoInSession(gomock.Any(), session).Return(nil, errors.ErrPVZAccessDenied)
+
+		stream, err := client.ScanSession(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Start{Start: &pbv1.StartScanSession{PvzId: pvz.ID.String()}}}))
+		_, err = stream.Recv()
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pbv1.ScanSessionRequest{Command: &pbv1.ScanSessionRequest_Undo{Undo: &pbv1.UndoScan{}}}))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Permission Denied", func(t *testing.T) {
+		moderator := &models.User{ID: uuid.New(), Role: models.ModeratorRole}
+		ts.userUseCase.EXPECT().ValidateToken(gomock.Any(), "moderator_token").Return(moderator, nil)
+
+		moderatorCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer moderator_token")
+		stream, err := client.ScanSession(moderatorCtx)
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestServer_Health(t *testing.T) {
+	ts := newTestServer(t)
+	client := grpc_health_v1.NewHealthClient(newTestConn(t, ts))
+
+	// Проверка здоровья доступна без токена
+	t.Run("Serving", func(t *testing.T) {
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+
+		resp, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: pbv1.PVZService_ServiceDesc.ServiceName})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("Not Serving", func(t *testing.T) {
+		ts.checker.setErr(errors.ErrShuttingDown)
+		defer ts.checker.setErr(nil)
+
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+	})
+
+	t.Run("Unknown Service", func(t *testing.T) {
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown.Service"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Watch", func(t *testing.T) {
+		stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+
+		// Остановка сервера переводит подписчиков в NOT_SERVING
+		go ts.server.Stop()
+
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+	})
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type CatalogEntry struct {
+	Name      string     `json:"name"`
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+func NewCatalogEntry(entry *models.CatalogEntry, opts Options) CatalogEntry {
+	return CatalogEntry{
+		Name:      entry.Name,
+		Active:    entry.Active,
+		CreatedAt: opts.createdAt(entry.CreatedAt),
+	}
+}
+
+func NewCatalogEntryList(entries []*models.CatalogEntry, opts Options) []CatalogEntry {
+	result := make([]CatalogEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, NewCatalogEntry(entry, opts))
+	}
+	return result
+}
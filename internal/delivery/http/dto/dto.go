@@ -0,0 +1,46 @@
+// Package dto описывает контракт HTTP API и отделяет его от доменных моделей
+package dto
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// includeCreatedAt - значение параметра include, добавляющее в ответ время создания записи
+const includeCreatedAt = "createdAt"
+
+// Options задает необязательные поля ответа
+type Options struct {
+	IncludeCreatedAt bool
+}
+
+// OptionsFromQuery разбирает параметр запроса include со списком полей через запятую
+func OptionsFromQuery(query url.Values) Options {
+	var opts Options
+	for _, value := range query["include"] {
+		for _, field := range strings.Split(value, ",") {
+			if strings.TrimSpace(field) == includeCreatedAt {
+				opts.IncludeCreatedAt = true
+			}
+		}
+	}
+	return opts
+}
+
+func (o Options) createdAt(value time.Time) *time.Time {
+	if !o.IncludeCreatedAt {
+		return nil
+	}
+	return &value
+}
+
+// Token - ответ на успешную авторизацию
+type Token struct {
+	Token string `json:"token"`
+}
+
+// Message - ответ с текстовым сообщением
+type Message struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsFromQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    url.Values
+		expected Options
+	}{
+		{
+			name:     "Empty",
+			query:    url.Values{},
+			expected: Options{},
+		},
+		{
+			name:     "CreatedAt",
+			query:    url.Values{"include": {"createdAt"}},
+			expected: Options{IncludeCreatedAt: true},
+		},
+		{
+			name:     "List",
+			query:    url.Values{"include": {"foo, createdAt"}},
+			expected: Options{IncludeCreatedAt: true},
+		},
+		{
+			name:     "Unknown Field",
+			query:    url.Values{"include": {"created_at"}},
+			expected: Options{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, OptionsFromQuery(tt.query))
+		})
+	}
+}
+
+func TestNewPVZList_JSON(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	pvz := &models.PVZ{ID: uuid.New(), RegistrationDate: now, City: models.CityMoscow, Status: models.PVZStatusActive, CreatedAt: now}
+	reception := &models.Reception{ID: uuid.New(), DateTime: now, PVZID: pvz.ID, Status: models.ReceptionStatusInProgress, CreatedAt: now}
+	product := &models.Product{ID: uuid.New(), DateTime: now, Type: models.ProductTypeElectronics, ReceptionID: reception.ID, Status: models.ProductStatusAccepted, StatusChangedAt: now, CreatedAt: now}
+	list := []*usecase.PVZWithReceptions{{
+		PVZ: pvz,
+		Receptions: []*usecase.ReceptionWithProducts{{
+			Reception: reception,
+			Products:  []*models.Product{product},
+		}},
+	}}
+
+	t.Run("Default", func(t *testing.T) {
+		body, err := json.Marshal(NewPVZList(list, Options{}))
+		require.NoError(t, err)
+
+		var raw []struct {
+			PVZ        map[string]any `json:"pvz"`
+			Receptions []struct {
+				Reception map[string]any   `json:"reception"`
+				Products  []map[string]any `json:"products"`
+			} `json:"receptions"`
+		}
+		require.NoError(t, json.Unmarshal(body, &raw))
+		require.Len(t, raw, 1)
+		assert.ElementsMatch(t, []string{"id", "registrationDate", "city", "status", "capacity", "occupied", "nearlyFull"}, keys(raw[0].PVZ))
+		require.Len(t, raw[0].Receptions, 1)
+		assert.ElementsMatch(t, []string{"id", "dateTime", "pvzId", "status", "kind", "maxItems"}, keys(raw[0].Receptions[0].Reception))
+		require.Len(t, raw[0].Receptions[0].Products, 1)
+		assert.ElementsMatch(t, []string{"id", "dateTime", "type", "receptionId", "status", "statusChangedAt"}, keys(raw[0].Receptions[0].Products[0]))
+	})
+
+	t.Run("Include CreatedAt", func(t *testing.T) {
+		body, err := json.Marshal(NewPVZList(list, Options{IncludeCreatedAt: true}))
+		require.NoError(t, err)
+
+		var result []PVZWithReceptions
+		require.NoError(t, json.Unmarshal(body, &result))
+		require.Len(t, result, 1)
+		require.NotNil(t, result[0].PVZ.CreatedAt)
+		require.Len(t, result[0].Receptions, 1)
+		assert.Equal(t, reception.PVZID, result[0].Receptions[0].Reception.PVZID)
+		require.NotNil(t, result[0].Receptions[0].Reception.CreatedAt)
+		require.Len(t, result[0].Receptions[0].Products, 1)
+		assert.Equal(t, product.ReceptionID, result[0].Receptions[0].Products[0].ReceptionID)
+		require.NotNil(t, result[0].Receptions[0].Products[0].CreatedAt)
+	})
+}
+
+func TestNewReception_TimedOut(t *testing.T) {
+	reception := models.NewReception(uuid.New())
+	reception.Status = models.ReceptionStatusClose
+	reception.ClosedBy = models.SystemActor
+	reception.CloseReason = models.ReceptionCloseReasonTimedOut
+
+	body, err := json.Marshal(NewReception(reception, Options{}))
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(body, &raw))
+	assert.Equal(t, "close", raw["status"])
+	assert.Equal(t, "system", raw["closedBy"])
+	assert.Equal(t, "timed_out", raw["closeReason"])
+}
+
+func TestNewUser_JSON(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Email: "test@example.com", PasswordHash: "hash", Role: models.EmployeeRole}
+
+	body, err := json.Marshal(NewUser(user, Options{}))
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(body, &raw))
+	assert.ElementsMatch(t, []string{"id", "email", "role"}, keys(raw))
+}
+
+func TestToken_JSON(t *testing.T) {
+	body, err := json.Marshal(Token{Token: "token"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"token":"token"}`, string(body))
+}
+
+func keys(m map[string]any) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,10 @@
+package dto
+
+// Error - конверт ошибки, общий для всех ответов HTTP API.
+// Code - стабильный машиночитаемый код, по которому клиенты различают ошибки.
+type Error struct {
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+	RequestID string            `json:"requestId"`
+}
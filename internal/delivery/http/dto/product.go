@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+type Product struct {
+	ID              uuid.UUID            `json:"id"`
+	DateTime        time.Time            `json:"dateTime"`
+	Type            models.ProductType   `json:"type"`
+	ReceptionID     uuid.UUID            `json:"receptionId"`
+	OrderID         string               `json:"orderId,omitempty"`
+	Barcode         string               `json:"barcode,omitempty"`
+	Status          models.ProductStatus `json:"status"`
+	StatusChangedAt time.Time            `json:"statusChangedAt"`
+	// OriginalProductID и ReturnReason заполнены у товаров из приемки возвратов
+	OriginalProductID *uuid.UUID `json:"originalProductId,omitempty"`
+	ReturnReason      string     `json:"returnReason,omitempty"`
+	// CellID - ячейка хранения, в которую разложен товар
+	CellID    *uuid.UUID `json:"cellId,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+func NewProduct(product *models.Product, opts Options) Product {
+	return Product{
+		ID:                product.ID,
+		DateTime:          product.DateTime,
+		Type:              product.Type,
+		ReceptionID:       product.ReceptionID,
+		OrderID:           product.OrderID,
+		Barcode:           product.Barcode,
+		Status:            product.Status,
+		StatusChangedAt:   product.StatusChangedAt,
+		OriginalProductID: product.OriginalProductID,
+		ReturnReason:      product.ReturnReason,
+		CellID:            product.CellID,
+		CreatedAt:         opts.createdAt(product.CreatedAt),
+	}
+}
+
+func NewProductList(products []*models.Product, opts Options) []Product {
+	result := make([]Product, 0, len(products))
+	for _, product := range products {
+		result = append(result, NewProduct(product, opts))
+	}
+	return result
+}
+
+// ProductLocation - товар вместе с приемкой и ПВЗ, куда он поступил
+type ProductLocation struct {
+	Product   Product   `json:"product"`
+	Reception Reception `json:"reception"`
+	PVZ       PVZ       `json:"pvz"`
+}
+
+func NewProductLocation(location *usecase.ProductLocation, opts Options) ProductLocation {
+	return ProductLocation{
+		Product:   NewProduct(location.Product, opts),
+		Reception: NewReception(location.Reception, opts),
+		PVZ:       NewPVZ(location.PVZ, opts),
+	}
+}
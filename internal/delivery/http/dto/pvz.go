@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type PVZ struct {
+	ID               uuid.UUID        `json:"id"`
+	RegistrationDate time.Time        `json:"registrationDate"`
+	City             models.City      `json:"city"`
+	Address          string           `json:"address,omitempty"`
+	Coordinates      *Coordinates     `json:"coordinates,omitempty"`
+	WorkingHours     string           `json:"workingHours,omitempty"`
+	Phone            string           `json:"phone,omitempty"`
+	Status           models.PVZStatus `json:"status"`
+	StatusReason     string           `json:"statusReason,omitempty"`
+	// Capacity равна 0, если вместимость ПВЗ не ограничена
+	Capacity   int        `json:"capacity"`
+	Occupied   int        `json:"occupied"`
+	NearlyFull bool       `json:"nearlyFull"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+}
+
+func NewPVZ(pvz *models.PVZ, opts Options) PVZ {
+	result := PVZ{
+		ID:               pvz.ID,
+		RegistrationDate: pvz.RegistrationDate,
+		City:             pvz.City,
+		Address:          pvz.Address,
+		WorkingHours:     pvz.WorkingHours,
+		Phone:            pvz.Phone,
+		Status:           pvz.Status,
+		StatusReason:     pvz.StatusReason,
+		Capacity:         pvz.Capacity,
+		Occupied:         pvz.Occupied,
+		NearlyFull:       pvz.IsNearlyFull(),
+		CreatedAt:        opts.createdAt(pvz.CreatedAt),
+	}
+	if pvz.Coordinates != nil {
+		result.Coordinates = &Coordinates{
+			Latitude:  pvz.Coordinates.Latitude,
+			Longitude: pvz.Coordinates.Longitude,
+		}
+	}
+	return result
+}
+
+// NearbyPVZ - ПВЗ и расстояние до него в метрах
+type NearbyPVZ struct {
+	PVZ      PVZ     `json:"pvz"`
+	Distance float64 `json:"distance"`
+}
+
+func NewNearbyPVZList(pvzs []*models.NearbyPVZ, opts Options) []NearbyPVZ {
+	result := make([]NearbyPVZ, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		result = append(result, NearbyPVZ{
+			PVZ:      NewPVZ(pvz.PVZ, opts),
+			Distance: pvz.Distance,
+		})
+	}
+	return result
+}
+
+type ReceptionWithProducts struct {
+	Reception Reception `json:"reception"`
+	Products  []Product `json:"products"`
+}
+
+type PVZWithReceptions struct {
+	PVZ        PVZ                     `json:"pvz"`
+	Receptions []ReceptionWithProducts `json:"receptions"`
+}
+
+func NewPVZList(pvzs []*usecase.PVZWithReceptions, opts Options) []PVZWithReceptions {
+	result := make([]PVZWithReceptions, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		receptions := make([]ReceptionWithProducts, 0, len(pvz.Receptions))
+		for _, reception := range pvz.Receptions {
+			products := make([]Product, 0, len(reception.Products))
+			for _, product := range reception.Products {
+				products = append(products, NewProduct(product, opts))
+			}
+			receptions = append(receptions, ReceptionWithProducts{
+				Reception: NewReception(reception.Reception, opts),
+				Products:  products,
+			})
+		}
+		result = append(result, PVZWithReceptions{
+			PVZ:        NewPVZ(pvz.PVZ, opts),
+			Receptions: receptions,
+		})
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type PVZAssignment struct {
+	UserID    uuid.UUID  `json:"userId"`
+	PVZID     uuid.UUID  `json:"pvzId"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+func NewPVZAssignment(assignment *models.PVZAssignment, opts Options) PVZAssignment {
+	return PVZAssignment{
+		UserID:    assignment.UserID,
+		PVZID:     assignment.PVZID,
+		CreatedAt: opts.createdAt(assignment.CreatedAt),
+	}
+}
+
+func NewPVZAssignmentList(assignments []*models.PVZAssignment, opts Options) []PVZAssignment {
+	result := make([]PVZAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		result = append(result, NewPVZAssignment(assignment, opts))
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type Reception struct {
+	ID       uuid.UUID              `json:"id"`
+	DateTime time.Time              `json:"dateTime"`
+	PVZID    uuid.UUID              `json:"pvzId"`
+	Status   models.ReceptionStatus `json:"status"`
+	Kind     models.ReceptionKind   `json:"kind"`
+	// MaxItems равен 0, если квота приемки не задана
+	MaxItems int `json:"maxItems"`
+	// ReopenReason и ReopenedAt заполнены, если модератор открыл приемку повторно
+	ReopenReason string     `json:"reopenReason,omitempty"`
+	ReopenedAt   *time.Time `json:"reopenedAt,omitempty"`
+	// ClosedBy и CloseReason заполнены, если приемку закрыл сервис по таймауту
+	ClosedBy    string                      `json:"closedBy,omitempty"`
+	CloseReason models.ReceptionCloseReason `json:"closeReason,omitempty"`
+	CreatedAt   *time.Time                  `json:"createdAt,omitempty"`
+}
+
+func NewReception(reception *models.Reception, opts Options) Reception {
+	return Reception{
+		ID:           reception.ID,
+		DateTime:     reception.DateTime,
+		PVZID:        reception.PVZID,
+		Status:       reception.Status,
+		Kind:         reception.Kind,
+		MaxItems:     reception.MaxItems,
+		ReopenReason: reception.ReopenReason,
+		ReopenedAt:   reception.ReopenedAt,
+		ClosedBy:     reception.ClosedBy,
+		CloseReason:  reception.CloseReason,
+		CreatedAt:    opts.createdAt(reception.CreatedAt),
+	}
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type StorageCell struct {
+	ID        uuid.UUID  `json:"id"`
+	PVZID     uuid.UUID  `json:"pvzId"`
+	Rack      string     `json:"rack"`
+	Shelf     string     `json:"shelf"`
+	Cell      string     `json:"cell"`
+	Capacity  int        `json:"capacity"`
+	Occupied  int        `json:"occupied"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+func NewStorageCell(cell *models.StorageCell, opts Options) StorageCell {
+	return StorageCell{
+		ID:        cell.ID,
+		PVZID:     cell.PVZID,
+		Rack:      cell.Rack,
+		Shelf:     cell.Shelf,
+		Cell:      cell.Cell,
+		Capacity:  cell.Capacity,
+		Occupied:  cell.Occupied,
+		CreatedAt: opts.createdAt(cell.CreatedAt),
+	}
+}
+
+func NewStorageCellList(cells []*models.StorageCell, opts Options) []StorageCell {
+	result := make([]StorageCell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, NewStorageCell(cell, opts))
+	}
+	return result
+}
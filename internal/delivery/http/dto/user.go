@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type User struct {
+	ID        uuid.UUID       `json:"id"`
+	Email     string          `json:"email"`
+	Role      models.UserRole `json:"role"`
+	CreatedAt *time.Time      `json:"createdAt,omitempty"`
+}
+
+func NewUser(user *models.User, opts Options) User {
+	return User{
+		ID:        user.ID,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: opts.createdAt(user.CreatedAt),
+	}
+}
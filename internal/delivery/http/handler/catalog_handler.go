@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+type CatalogHandler struct {
+	catalogUseCase usecase.CatalogUseCase
+	logger         logger.Logger
+}
+
+func NewCatalogHandler(catalogUseCase usecase.CatalogUseCase, logger logger.Logger) *CatalogHandler {
+	return &CatalogHandler{
+		catalogUseCase: catalogUseCase,
+		logger:         logger,
+	}
+}
+
+func (h *CatalogHandler) List(c *gin.Context) {
+	entries, err := h.catalogUseCase.List(c.Request.Context(), catalogKind(c))
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewCatalogEntryList(entries, responseOptions(c)))
+}
+
+type createCatalogEntryRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func (h *CatalogHandler) Create(c *gin.Context) {
+	var req createCatalogEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	entry, err := h.catalogUseCase.Create(c.Request.Context(), catalogKind(c), req.Name)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewCatalogEntry(entry, responseOptions(c)))
+}
+
+type updateCatalogEntryRequest struct {
+	Active *bool `json:"active" binding:"required"`
+}
+
+// Update вводит значение справочника в оборот или выводит из него
+func (h *CatalogHandler) Update(c *gin.Context) {
+	var req updateCatalogEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	h.setActive(c, *req.Active)
+}
+
+// Delete выводит значение из оборота. Запись остается в справочнике,
+// чтобы на нее по-прежнему ссылались созданные ранее ПВЗ и товары.
+func (h *CatalogHandler) Delete(c *gin.Context) {
+	h.setActive(c, false)
+}
+
+func (h *CatalogHandler) setActive(c *gin.Context, active bool) {
+	entry, err := h.catalogUseCase.SetActive(c.Request.Context(), catalogKind(c), c.Param("name"), active)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewCatalogEntry(entry, responseOptions(c)))
+}
+
+func catalogKind(c *gin.Context) models.CatalogKind {
+	return models.CatalogKind(c.Param("catalog"))
+}
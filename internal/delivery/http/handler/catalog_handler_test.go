@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newCatalogTestRouter(t *testing.T) (*gin.Engine, *mock_usecase.MockCatalogUseCase) {
+	ctrl := gomock.NewController(t)
+
+	mockCatalogUseCase := mock_usecase.NewMockCatalogUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewCatalogHandler(mockCatalogUseCase, mockLogger)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/catalogs/:catalog", handler.List)
+	r.POST("/catalogs/:catalog", handler.Create)
+	r.PATCH("/catalogs/:catalog/:name", handler.Update)
+	r.DELETE("/catalogs/:catalog/:name", handler.Delete)
+
+	return r, mockCatalogUseCase
+}
+
+func TestCatalogHandler_List(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	retired := models.NewCatalogEntry("Тверь")
+	retired.Active = false
+	entries := []*models.CatalogEntry{models.NewCatalogEntry("Казань"), retired}
+	mockCatalogUseCase.EXPECT().List(gomock.Any(), models.CatalogCities).Return(entries, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalogs/cities", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.CatalogEntry
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	assert.Equal(t, "Казань", response[0].Name)
+	assert.True(t, response[0].Active)
+	assert.False(t, response[1].Active)
+}
+
+func TestCatalogHandler_List_UnknownCatalog(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	mockCatalogUseCase.EXPECT().List(gomock.Any(), models.CatalogKind("countries")).Return(nil, errors.ErrCatalogNotFound)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalogs/countries", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"CATALOG_NOT_FOUND"`)
+}
+
+func TestCatalogHandler_Create(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	entry := models.NewCatalogEntry("бытовая техника")
+	mockCatalogUseCase.EXPECT().Create(gomock.Any(), models.CatalogProductTypes, "бытовая техника").Return(entry, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/catalogs/product-types", bytes.NewBufferString(`{"name":"бытовая техника"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"name":"бытовая техника","active":true}`, w.Body.String())
+}
+
+func TestCatalogHandler_Create_AlreadyExists(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	mockCatalogUseCase.EXPECT().Create(gomock.Any(), models.CatalogCities, "Казань").Return(nil, errors.ErrCatalogEntryExists)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/catalogs/cities", bytes.NewBufferString(`{"name":"Казань"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"CATALOG_ENTRY_ALREADY_EXISTS"`)
+}
+
+func TestCatalogHandler_Update(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	entry := models.NewCatalogEntry("Тверь")
+	mockCatalogUseCase.EXPECT().SetActive(gomock.Any(), models.CatalogCities, "Тверь", true).Return(entry, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/catalogs/cities/"+url.PathEscape("Тверь"), bytes.NewBufferString(`{"active":true}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"name":"Тверь","active":true}`, w.Body.String())
+}
+
+func TestCatalogHandler_Update_MissingActive(t *testing.T) {
+	r, _ := newCatalogTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/catalogs/cities/"+url.PathEscape("Тверь"), bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCatalogHandler_Delete(t *testing.T) {
+	r, mockCatalogUseCase := newCatalogTestRouter(t)
+
+	entry := models.NewCatalogEntry("Казань")
+	entry.Active = false
+	mockCatalogUseCase.EXPECT().SetActive(gomock.Any(), models.CatalogCities, "Казань", false).Return(entry, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/catalogs/cities/"+url.PathEscape("Казань"), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"name":"Казань","active":false}`, w.Body.String())
+}
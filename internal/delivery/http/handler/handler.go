@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
 	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
@@ -12,14 +14,33 @@ import (
 	"github.com/smthjapanese/avito_pvz/internal/usecase"
 )
 
+// legacyDeprecation - дата, с которой пути без версии считаются устаревшими (2026-10-16), в формате RFC 9745
+const legacyDeprecation = "@1792108800"
+
+// Ошибки разбора параметров запроса
+var (
+	errInvalidPVZID       = errors.New("invalid pvz id")
+	errInvalidProductID   = errors.New("invalid product id")
+	errInvalidReceptionID = errors.New("invalid reception id")
+	errInvalidCellID      = errors.New("invalid cell id")
+	errInvalidUserID      = errors.New("invalid user id")
+	errInvalidStartDate   = errors.New("invalid start date format")
+	errInvalidEndDate     = errors.New("invalid end date format")
+)
+
 type Handler struct {
 	userHandler      *UserHandler
 	pvzHandler       *PVZHandler
 	receptionHandler *ReceptionHandler
 	productHandler   *ProductHandler
+	catalogHandler   *CatalogHandler
+	cellHandler      *StorageCellHandler
+	assignHandler    *PVZAssignmentHandler
 	authMiddleware   *middleware.AuthMiddleware
 	logger           logger.Logger
 	metrics          metrics.MetricsInterface
+	// idempotency возвращает сохраненный ответ на повтор изменяющего запроса с Idempotency-Key
+	idempotency gin.HandlerFunc
 }
 
 func NewHandler(useCases *usecase.UseCases, logger logger.Logger, metrics metrics.MetricsInterface) *Handler {
@@ -30,39 +51,124 @@ func NewHandler(useCases *usecase.UseCases, logger logger.Logger, metrics metric
 		pvzHandler:       NewPVZHandler(useCases.PVZ, logger, metrics),
 		receptionHandler: NewReceptionHandler(useCases.Reception, logger, metrics),
 		productHandler:   NewProductHandler(useCases.Product, logger, metrics),
+		catalogHandler:   NewCatalogHandler(useCases.Catalog, logger),
+		cellHandler:      NewStorageCellHandler(useCases.Cell, logger),
+		assignHandler:    NewPVZAssignmentHandler(useCases.Assignment, logger),
 		authMiddleware:   authMiddleware,
+		idempotency:      middleware.Idempotency(useCases.Idempotency),
 		logger:           logger,
 		metrics:          metrics,
 	}
 }
 
-func (h *Handler) Init(router *gin.Engine) {
+// Init регистрирует маршруты API. validate проверяет запрос по спецификации OpenAPI:
+// на защищенных маршрутах он идет после аутентификации, чтобы запрос без токена получал 401, а не 400.
+func (h *Handler) Init(router *gin.Engine, validate gin.HandlerFunc) {
 	router.Use(h.metricsMiddleware())
 
-	api := router.Group("/")
+	v1 := router.Group("/api/v1")
 	{
 		// Авторизация и регистрация
-		api.POST("/dummyLogin", h.userHandler.DummyLogin)
-		api.POST("/register", h.userHandler.Register)
-		api.POST("/login", h.userHandler.Login)
+		v1.POST("/dummyLogin", validate, h.userHandler.DummyLogin)
+		v1.POST("/register", validate, h.userHandler.Register)
+		v1.POST("/login", validate, h.userHandler.Login)
 
-		authenticated := api.Group("/", h.authMiddleware.Authenticate())
+		authenticated := v1.Group("/", h.authMiddleware.Authenticate(), validate, h.idempotency)
 		{
-
 			pvz := authenticated.Group("/pvz")
 			{
-				pvz.POST("/", h.authMiddleware.CheckRole(models.ModeratorRole), h.pvzHandler.Create)
-				pvz.GET("/", h.pvzHandler.List)
-
-				pvz.POST("/:pvzId/close_last_reception", h.authMiddleware.CheckRole(models.EmployeeRole), h.receptionHandler.CloseLastReception)
-				pvz.POST("/:pvzId/delete_last_product", h.authMiddleware.CheckRole(models.EmployeeRole), h.productHandler.DeleteLastFromReception)
+				pvz.POST("", h.authMiddleware.CheckRole(models.ModeratorRole), h.pvzHandler.Create)
+				pvz.GET("", h.pvzHandler.List)
+				pvz.GET("/nearest", h.pvzHandler.Nearest)
+				pvz.POST("/:pvzId/status", h.authMiddleware.CheckRole(models.ModeratorRole), h.pvzHandler.ChangeStatus)
+				pvz.POST("/:pvzId/capacity", h.authMiddleware.CheckRole(models.ModeratorRole), h.pvzHandler.SetCapacity)
+				pvz.GET("/:pvzId/shelf", h.productHandler.ListShelf)
+
+				// Раскладку ПВЗ задают модераторы, содержимое ячеек смотрят сотрудники
+				pvz.GET("/:pvzId/cells", h.cellHandler.List)
+				pvz.POST("/:pvzId/cells", h.authMiddleware.CheckRole(models.ModeratorRole), h.cellHandler.Create)
+				pvz.GET("/:pvzId/cells/:cellId/products", h.authMiddleware.CheckRole(models.EmployeeRole), h.cellHandler.ListProducts)
+
+				// Сотрудники работают только с ПВЗ, за которыми их закрепил модератор
+				employees := pvz.Group("/:pvzId/employees", h.authMiddleware.CheckRole(models.ModeratorRole))
+				{
+					employees.GET("", h.assignHandler.List)
+					employees.POST("", h.assignHandler.Assign)
+					employees.DELETE("/:userId", h.assignHandler.Unassign)
+				}
+
+				reception := pvz.Group("/:pvzId/reception", h.authMiddleware.CheckRole(models.EmployeeRole))
+				{
+					reception.POST("", h.receptionHandler.CreateForPVZ)
+					reception.POST("/close", h.receptionHandler.CloseLastReception)
+					reception.POST("/product", h.productHandler.CreateForPVZ)
+					reception.DELETE("/product", h.productHandler.DeleteLastFromReception)
+					reception.DELETE("/product/:productId", h.productHandler.Delete)
+				}
+
+				// Выдача товаров получателям
+				products := pvz.Group("/:pvzId/products/:productId", h.authMiddleware.CheckRole(models.EmployeeRole))
+				{
+					products.POST("/status", h.productHandler.ChangeStatus)
+					products.POST("/issue", h.productHandler.Issue)
+				}
 			}
 
-			authenticated.POST("/receptions", h.authMiddleware.CheckRole(models.EmployeeRole), h.receptionHandler.Create)
+			authenticated.POST("/products/batch", h.authMiddleware.CheckRole(models.EmployeeRole), h.productHandler.CreateBatch)
+			authenticated.GET("/products/barcode/:barcode", h.productHandler.FindByBarcode)
+			authenticated.POST("/receptions/:receptionId/reopen", h.authMiddleware.CheckRole(models.ModeratorRole), h.receptionHandler.Reopen)
 
-			authenticated.POST("/products", h.authMiddleware.CheckRole(models.EmployeeRole), h.productHandler.Create)
+			// Справочники городов и категорий товаров читают все, изменяют модераторы
+			catalogs := authenticated.Group("/catalogs/:catalog")
+			{
+				catalogs.GET("", h.catalogHandler.List)
+				catalogs.POST("", h.authMiddleware.CheckRole(models.ModeratorRole), h.catalogHandler.Create)
+				catalogs.PATCH("/:name", h.authMiddleware.CheckRole(models.ModeratorRole), h.catalogHandler.Update)
+				catalogs.DELETE("/:name", h.authMiddleware.CheckRole(models.ModeratorRole), h.catalogHandler.Delete)
+			}
 		}
 	}
+
+	h.initLegacy(router, validate)
+}
+
+// initLegacy регистрирует пути без версии, которыми пользуются старые клиенты.
+// Каждый из них помечен как устаревший и указывает на замену в /api/v1.
+func (h *Handler) initLegacy(router *gin.Engine, validate gin.HandlerFunc) {
+	authenticate := h.authMiddleware.Authenticate()
+	moderator := h.authMiddleware.CheckRole(models.ModeratorRole)
+	employee := h.authMiddleware.CheckRole(models.EmployeeRole)
+
+	// Авторизация и регистрация
+	router.POST("/dummyLogin", h.deprecated("/api/v1/dummyLogin"), validate, h.userHandler.DummyLogin)
+	router.POST("/register", h.deprecated("/api/v1/register"), validate, h.userHandler.Register)
+	router.POST("/login", h.deprecated("/api/v1/login"), validate, h.userHandler.Login)
+
+	// Отметка об устаревании идет до авторизации, чтобы учитывать и отклоненные запросы
+	router.POST("/pvz/", h.deprecated("/api/v1/pvz"), authenticate, moderator, validate, h.idempotency, h.pvzHandler.Create)
+	router.GET("/pvz/", h.deprecated("/api/v1/pvz"), authenticate, validate, h.pvzHandler.List)
+	router.POST("/pvz/:pvzId/close_last_reception", h.deprecated("/api/v1/pvz/{pvzId}/reception/close"), authenticate, employee, validate, h.idempotency, h.receptionHandler.CloseLastReception)
+	router.POST("/pvz/:pvzId/delete_last_product", h.deprecated("/api/v1/pvz/{pvzId}/reception/product"), authenticate, employee, validate, h.idempotency, h.productHandler.DeleteLastFromReception)
+	router.POST("/receptions", h.deprecated("/api/v1/pvz/{pvzId}/reception"), authenticate, employee, validate, h.idempotency, h.receptionHandler.Create)
+	router.POST("/products", h.deprecated("/api/v1/pvz/{pvzId}/reception/product"), authenticate, employee, validate, h.idempotency, h.productHandler.Create)
+}
+
+// deprecated помечает ответ заголовками Deprecation (RFC 9745) и Link на замену
+// и учитывает обращение в метриках, чтобы понять, когда путь можно удалить
+func (h *Handler) deprecated(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", legacyDeprecation)
+		c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+
+		h.metrics.IncDeprecatedRequestCount(c.Request.Method, c.FullPath())
+
+		c.Next()
+	}
+}
+
+// responseOptions возвращает состав ответа, запрошенный параметром include
+func responseOptions(c *gin.Context) dto.Options {
+	return dto.OptionsFromQuery(c.Request.URL.Query())
 }
 
 func (h *Handler) metricsMiddleware() gin.HandlerFunc {
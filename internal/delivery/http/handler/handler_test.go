@@ -3,9 +3,12 @@ package handler
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
@@ -14,6 +17,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// skipValidation заменяет проверку запросов по спецификации, ее покрывают тесты пакета openapi
+func skipValidation(*gin.Context) {}
+
 func TestNewHandler(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -39,6 +45,7 @@ func TestNewHandler(t *testing.T) {
 	assert.NotNil(t, handler.receptionHandler)
 	assert.NotNil(t, handler.productHandler)
 	assert.NotNil(t, handler.userHandler)
+	assert.NotNil(t, handler.catalogHandler)
 }
 
 func TestInit(t *testing.T) {
@@ -62,7 +69,7 @@ func TestInit(t *testing.T) {
 	handler := NewHandler(useCases, mockLogger, mockMetrics)
 
 	router := gin.New()
-	handler.Init(router)
+	handler.Init(router, skipValidation)
 
 	// Проверяем, что все маршруты зарегистрированы
 	routes := router.Routes()
@@ -70,15 +77,29 @@ func TestInit(t *testing.T) {
 
 	// Проверяем наличие основных маршрутов
 	expectedRoutes := map[string]bool{
-		"POST /register":                        false,
-		"POST /login":                           false,
-		"POST /dummyLogin":                      false,
-		"POST /pvz/":                            false,
-		"GET /pvz/":                             false,
-		"POST /receptions":                      false,
-		"POST /pvz/:pvzId/close_last_reception": false,
-		"POST /products":                        false,
-		"POST /pvz/:pvzId/delete_last_product":  false,
+		"POST /api/v1/register":                       false,
+		"POST /api/v1/login":                          false,
+		"POST /api/v1/dummyLogin":                     false,
+		"POST /api/v1/pvz":                            false,
+		"GET /api/v1/pvz":                             false,
+		"GET /api/v1/pvz/nearest":                     false,
+		"POST /api/v1/pvz/:pvzId/reception":           false,
+		"POST /api/v1/pvz/:pvzId/reception/close":     false,
+		"POST /api/v1/pvz/:pvzId/reception/product":   false,
+		"DELETE /api/v1/pvz/:pvzId/reception/product": false,
+		"GET /api/v1/catalogs/:catalog":               false,
+		"POST /api/v1/catalogs/:catalog":              false,
+		"PATCH /api/v1/catalogs/:catalog/:name":       false,
+		"DELETE /api/v1/catalogs/:catalog/:name":      false,
+		"POST /register":                              false,
+		"POST /login":                                 false,
+		"POST /dummyLogin":                            false,
+		"POST /pvz/":                                  false,
+		"GET /pvz/":                                   false,
+		"POST /receptions":                            false,
+		"POST /pvz/:pvzId/close_last_reception":       false,
+		"POST /products":                              false,
+		"POST /pvz/:pvzId/delete_last_product":        false,
 	}
 
 	for _, route := range routes {
@@ -163,3 +184,80 @@ func TestMetricsMiddlewareError(t *testing.T) {
 	// Проверяем, что запрос обработан с ошибкой
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+type deprecatedRequest struct {
+	method   string
+	endpoint string
+}
+
+type recordingMetrics struct {
+	metrics.MockMetrics
+	deprecated []deprecatedRequest
+}
+
+func (m *recordingMetrics) IncDeprecatedRequestCount(method, endpoint string) {
+	m.deprecated = append(m.deprecated, deprecatedRequest{method: method, endpoint: endpoint})
+}
+
+func TestDeprecatedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockUserUseCase := mock_usecase.NewMockUserUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	recorder := &recordingMetrics{}
+
+	useCases := &usecase.UseCases{
+		PVZ:       mockPVZUseCase,
+		Reception: mockReceptionUseCase,
+		Product:   mockProductUseCase,
+		User:      mockUserUseCase,
+	}
+
+	router := gin.New()
+	NewHandler(useCases, mockLogger, recorder).Init(router, skipValidation)
+
+	t.Run("Legacy Route", func(t *testing.T) {
+		mockUserUseCase.EXPECT().DummyLogin(gomock.Any(), models.EmployeeRole, gomock.Any()).Return("token", nil)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/dummyLogin", strings.NewReader(`{"role":"employee"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, legacyDeprecation, w.Header().Get("Deprecation"))
+		assert.Equal(t, `</api/v1/dummyLogin>; rel="successor-version"`, w.Header().Get("Link"))
+		assert.Equal(t, []deprecatedRequest{{method: http.MethodPost, endpoint: "/dummyLogin"}}, recorder.deprecated)
+	})
+
+	t.Run("Legacy Route Without Token", func(t *testing.T) {
+		recorder.deprecated = nil
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/pvz/"+uuid.New().String()+"/close_last_reception", nil)
+		router.ServeHTTP(w, req)
+
+		// Обращение учитывается, даже если запрос отклонен авторизацией
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, legacyDeprecation, w.Header().Get("Deprecation"))
+		assert.Equal(t, []deprecatedRequest{{method: http.MethodPost, endpoint: "/pvz/:pvzId/close_last_reception"}}, recorder.deprecated)
+	})
+
+	t.Run("Versioned Route", func(t *testing.T) {
+		recorder.deprecated = nil
+		mockUserUseCase.EXPECT().DummyLogin(gomock.Any(), models.EmployeeRole, gomock.Any()).Return("token", nil)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/dummyLogin", strings.NewReader(`{"role":"employee"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Deprecation"))
+		assert.Empty(t, recorder.deprecated)
+	})
+}
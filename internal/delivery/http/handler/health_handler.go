@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/health"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+// HealthHandler обслуживает пробы живости и готовности для оркестратора
+type HealthHandler struct {
+	checker health.ReadinessChecker
+	logger  logger.Logger
+}
+
+func NewHealthHandler(checker health.ReadinessChecker, logger logger.Logger) *HealthHandler {
+	return &HealthHandler{
+		checker: checker,
+		logger:  logger,
+	}
+}
+
+func (h *HealthHandler) Init(router gin.IRoutes) {
+	router.GET("/healthz", h.Liveness)
+	router.GET("/readyz", h.Readiness)
+}
+
+// Liveness отвечает, пока процесс способен обрабатывать запросы
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readiness отвечает 503, если база данных недоступна или приложение останавливается
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	if err := h.checker.Ready(c.Request.Context()); err != nil {
+		h.logger.Warn("readiness check failed", zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "ready"})
+}
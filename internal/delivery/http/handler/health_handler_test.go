@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type testReadinessChecker struct {
+	err error
+}
+
+func (c *testReadinessChecker) Ready(_ context.Context) error {
+	return c.err
+}
+
+func TestHealthHandler(t *testing.T) {
+	mockLogger, _ := logger.NewLogger("debug")
+	checker := &testReadinessChecker{}
+	handler := NewHealthHandler(checker, mockLogger)
+
+	router := gin.New()
+	handler.Init(router)
+
+	testCases := []struct {
+		name           string
+		path           string
+		checkErr       error
+		expectedStatus int
+	}{
+		{name: "Liveness", path: "/healthz", expectedStatus: http.StatusOK},
+		{name: "Liveness Ignores Readiness", path: "/healthz", checkErr: errors.ErrDBConnection, expectedStatus: http.StatusOK},
+		{name: "Ready", path: "/readyz", expectedStatus: http.StatusOK},
+		{name: "Database Unavailable", path: "/readyz", checkErr: errors.ErrDBConnection, expectedStatus: http.StatusServiceUnavailable},
+		{name: "Shutting Down", path: "/readyz", checkErr: errors.ErrShuttingDown, expectedStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker.err = tc.checkErr
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+		})
+	}
+}
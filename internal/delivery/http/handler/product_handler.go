@@ -2,14 +2,14 @@ package handler
 
 import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
-	"go.uber.org/zap"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
-	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 )
 
@@ -28,67 +28,236 @@ func NewProductHandler(productUseCase usecase.ProductUseCase, logger logger.Logg
 }
 
 type createProductRequest struct {
-	Type  models.ProductType `json:"type" binding:"required"`
-	PVZID uuid.UUID          `json:"pvzId" binding:"required"`
+	Type    models.ProductType `json:"type" binding:"required"`
+	PVZID   uuid.UUID          `json:"pvzId" binding:"required"`
+	OrderID string             `json:"orderId"`
+	Barcode string             `json:"barcode"`
+	// ReturnReason и OriginalProductID нужны для товаров приемки возвратов
+	ReturnReason      string     `json:"returnReason"`
+	OriginalProductID *uuid.UUID `json:"originalProductId"`
+	// CellID - ячейка для товара, без нее выбирается первая свободная
+	CellID *uuid.UUID `json:"cellId"`
 }
 
 func (h *ProductHandler) Create(c *gin.Context) {
 	var req createProductRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
-	product, err := h.productUseCase.Create(c.Request.Context(), req.Type, req.PVZID)
+	h.create(c, req.PVZID, usecase.ProductInput{
+		Type:              req.Type,
+		OrderID:           req.OrderID,
+		Barcode:           req.Barcode,
+		ReturnReason:      req.ReturnReason,
+		OriginalProductID: req.OriginalProductID,
+		CellID:            req.CellID,
+	})
+}
+
+type createPVZProductRequest struct {
+	Type              models.ProductType `json:"type" binding:"required"`
+	OrderID           string             `json:"orderId"`
+	Barcode           string             `json:"barcode"`
+	ReturnReason      string             `json:"returnReason"`
+	OriginalProductID *uuid.UUID         `json:"originalProductId"`
+	CellID            *uuid.UUID         `json:"cellId"`
+}
+
+// CreateForPVZ добавляет товар в открытую приемку ПВЗ из пути запроса
+func (h *ProductHandler) CreateForPVZ(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req createPVZProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	h.create(c, pvzID, usecase.ProductInput{
+		Type:              req.Type,
+		OrderID:           req.OrderID,
+		Barcode:           req.Barcode,
+		ReturnReason:      req.ReturnReason,
+		OriginalProductID: req.OriginalProductID,
+		CellID:            req.CellID,
+	})
+}
+
+type createProductBatchRequest struct {
+	PVZID    uuid.UUID                 `json:"pvzId" binding:"required"`
+	Products []createPVZProductRequest `json:"products" binding:"required,dive"`
+}
+
+// CreateBatch добавляет пачку товаров в открытую приемку ПВЗ: сохраняются либо все товары, либо ни одного
+func (h *ProductHandler) CreateBatch(c *gin.Context) {
+	var req createProductBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	inputs := make([]usecase.ProductInput, 0, len(req.Products))
+	for _, item := range req.Products {
+		inputs = append(inputs, usecase.ProductInput{
+			Type:              item.Type,
+			OrderID:           item.OrderID,
+			Barcode:           item.Barcode,
+			ReturnReason:      item.ReturnReason,
+			OriginalProductID: item.OriginalProductID,
+			CellID:            item.CellID,
+		})
+	}
+
+	products, err := h.productUseCase.CreateBatch(c.Request.Context(), req.PVZID, inputs)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	for range products {
+		h.metrics.IncProductAdded()
+	}
+
+	c.JSON(http.StatusCreated, dto.NewProductList(products, responseOptions(c)))
+}
+
+func (h *ProductHandler) create(c *gin.Context, pvzID uuid.UUID, input usecase.ProductInput) {
+	product, err := h.productUseCase.Create(c.Request.Context(), pvzID, input)
 	if err != nil {
-		if err == errors.ErrInvalidProductType {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid product type"})
-			return
-		}
-		if errors.IsNotFound(err) {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "pvz not found"})
-			return
-		}
-		if err == errors.ErrOpenReceptionNotFound {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "no open reception found"})
-			return
-		}
-		h.logger.Error("failed to create product", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
 		return
 	}
 
 	h.metrics.IncProductAdded()
 
-	c.JSON(http.StatusCreated, product)
+	c.JSON(http.StatusCreated, dto.NewProduct(product, responseOptions(c)))
 }
 
 func (h *ProductHandler) DeleteLastFromReception(c *gin.Context) {
 	pvzIDStr := c.Param("pvzId")
 	pvzID, err := uuid.Parse(pvzIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid pvz id"})
+		middleware.BadRequest(c, errInvalidPVZID)
 		return
 	}
 
 	err = h.productUseCase.DeleteLastFromReception(c.Request.Context(), pvzID)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "pvz not found"})
-			return
-		}
-		if err == errors.ErrOpenReceptionNotFound {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "no open reception found"})
-			return
-		}
-		if err == errors.ErrNoProductsToDelete {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "no products to delete"})
-			return
-		}
-		h.logger.Error("failed to delete product", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{"message": "product deleted"})
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Message{Message: "product deleted"})
+}
+
+// Delete удаляет товар по идентификатору из открытой приемки ПВЗ
+func (h *ProductHandler) Delete(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+	productID, err := uuid.Parse(c.Param("productId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidProductID)
+		return
+	}
+
+	product, err := h.productUseCase.Delete(c.Request.Context(), pvzID, productID)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewProduct(product, responseOptions(c)))
+}
+
+// FindByBarcode возвращает последний принятый товар со штрихкодом вместе с его приемкой и ПВЗ
+func (h *ProductHandler) FindByBarcode(c *gin.Context) {
+	location, err := h.productUseCase.FindByBarcode(c.Request.Context(), c.Param("barcode"))
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewProductLocation(location, responseOptions(c)))
+}
+
+type changeProductStatusRequest struct {
+	Status models.ProductStatus `json:"status" binding:"required"`
+}
+
+// ChangeStatus переводит товар ПВЗ в новое состояние
+func (h *ProductHandler) ChangeStatus(c *gin.Context) {
+	var req changeProductStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	h.changeStatus(c, req.Status)
+}
+
+// Issue отмечает товар выданным получателю
+func (h *ProductHandler) Issue(c *gin.Context) {
+	h.changeStatus(c, models.ProductStatusIssued)
+}
+
+func (h *ProductHandler) changeStatus(c *gin.Context, status models.ProductStatus) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+	productID, err := uuid.Parse(c.Param("productId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidProductID)
+		return
+	}
+
+	product, err := h.productUseCase.ChangeStatus(c.Request.Context(), pvzID, productID, status)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	h.metrics.IncProductStatusChanged(string(product.Status))
+
+	c.JSON(http.StatusOK, dto.NewProduct(product, responseOptions(c)))
+}
+
+type listShelfRequest struct {
+	// Status ограничивает выборку состояниями товаров на полке
+	Status []models.ProductStatus `form:"status"`
+	Page   int                    `form:"page,default=1" binding:"min=1"`
+	Limit  int                    `form:"limit,default=10" binding:"min=1,max=30"`
+}
+
+// ListShelf возвращает товары, которые сейчас находятся в ПВЗ
+func (h *ProductHandler) ListShelf(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req listShelfRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	products, err := h.productUseCase.ListShelf(c.Request.Context(), pvzID, req.Status, req.Page, req.Limit)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewProductList(products, responseOptions(c)))
 }
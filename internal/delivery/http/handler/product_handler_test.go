@@ -10,7 +10,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
@@ -44,7 +46,7 @@ func TestProductHandler_Create(t *testing.T) {
 		ReceptionID: receptionID,
 		CreatedAt:   time.Now(),
 	}
-	mockProductUseCase.EXPECT().Create(gomock.Any(), req.Type, req.PVZID).Return(product, nil)
+	mockProductUseCase.EXPECT().Create(gomock.Any(), req.PVZID, usecase.ProductInput{Type: req.Type}).Return(product, nil)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -57,7 +59,7 @@ func TestProductHandler_Create(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 
-	var response models.Product
+	var response dto.Product
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, product.ID, response.ID)
@@ -65,6 +67,51 @@ func TestProductHandler_Create(t *testing.T) {
 	assert.Equal(t, product.ReceptionID, response.ReceptionID)
 }
 
+func TestProductHandler_Create_CustomerReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewProductHandler(mockProductUseCase, mockLogger, mockMetrics)
+
+	pvzID := uuid.New()
+	originalID := uuid.New()
+	req := createProductRequest{
+		Type:              models.ProductTypeShoes,
+		PVZID:             pvzID,
+		ReturnReason:      "брак",
+		OriginalProductID: &originalID,
+	}
+	reqBody, _ := json.Marshal(req)
+
+	product := models.NewProduct(req.Type, uuid.New())
+	product.ReturnReason = req.ReturnReason
+	product.OriginalProductID = &originalID
+	mockProductUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ProductInput{
+		Type:              req.Type,
+		ReturnReason:      req.ReturnReason,
+		OriginalProductID: &originalID,
+	}).Return(product, nil)
+
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.POST("/products", handler.Create)
+
+	c.Request, _ = http.NewRequest(http.MethodPost, "/products", bytes.NewBuffer(reqBody))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, &originalID, response.OriginalProductID)
+	assert.Equal(t, req.ReturnReason, response.ReturnReason)
+}
+
 func TestProductHandler_Create_InvalidProductType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -81,7 +128,7 @@ func TestProductHandler_Create_InvalidProductType(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockProductUseCase.EXPECT().Create(gomock.Any(), models.ProductType(req.Type), req.PVZID).Return(nil, errors.ErrInvalidProductType)
+	mockProductUseCase.EXPECT().Create(gomock.Any(), req.PVZID, usecase.ProductInput{Type: req.Type}).Return(nil, errors.ErrInvalidProductType)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -92,8 +139,8 @@ func TestProductHandler_Create_InvalidProductType(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "invalid product type")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"INVALID_PRODUCT_TYPE"`)
 }
 
 func TestProductHandler_Create_NoOpenReception(t *testing.T) {
@@ -111,7 +158,7 @@ func TestProductHandler_Create_NoOpenReception(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockProductUseCase.EXPECT().Create(gomock.Any(), req.Type, req.PVZID).Return(nil, errors.ErrPVZNotFound)
+	mockProductUseCase.EXPECT().Create(gomock.Any(), req.PVZID, usecase.ProductInput{Type: req.Type}).Return(nil, errors.ErrPVZNotFound)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -121,8 +168,8 @@ func TestProductHandler_Create_NoOpenReception(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestProductHandler_DeleteLastFromReception(t *testing.T) {
@@ -202,8 +249,8 @@ func TestProductHandler_DeleteLastFromReception_NoProductsToDelete(t *testing.T)
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "no products to delete")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"NO_PRODUCTS_TO_DELETE"`)
 }
 
 func TestProductHandler_DeleteLastFromReception_NoOpenReception(t *testing.T) {
@@ -230,8 +277,8 @@ func TestProductHandler_DeleteLastFromReception_NoOpenReception(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"OPEN_RECEPTION_NOT_FOUND"`)
 }
 
 func TestProductHandler_DeleteLastFromReception_PVZNotFound(t *testing.T) {
@@ -258,8 +305,8 @@ func TestProductHandler_DeleteLastFromReception_PVZNotFound(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestProductHandler_Create_PVZNotFound(t *testing.T) {
@@ -278,7 +325,7 @@ func TestProductHandler_Create_PVZNotFound(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockProductUseCase.EXPECT().Create(gomock.Any(), req.Type, req.PVZID).Return(nil, errors.ErrPVZNotFound)
+	mockProductUseCase.EXPECT().Create(gomock.Any(), req.PVZID, usecase.ProductInput{Type: req.Type}).Return(nil, errors.ErrPVZNotFound)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -289,8 +336,8 @@ func TestProductHandler_Create_PVZNotFound(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestProductHandler_Create_ValidationError(t *testing.T) {
@@ -333,7 +380,7 @@ func TestProductHandler_Create_InternalError(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockProductUseCase.EXPECT().Create(gomock.Any(), req.Type, req.PVZID).Return(nil, errors.ErrInternal)
+	mockProductUseCase.EXPECT().Create(gomock.Any(), req.PVZID, usecase.ProductInput{Type: req.Type}).Return(nil, errors.ErrInternal)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -375,3 +422,321 @@ func TestProductHandler_DeleteLastFromReception_InternalError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	assert.Contains(t, w.Body.String(), "internal server error")
 }
+
+func TestProductHandler_CreateForPVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewProductHandler(mockProductUseCase, mockLogger, mockMetrics)
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	mockProductUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ProductInput{Type: models.ProductTypeShoes}).Return(product, nil)
+
+	reqBody, _ := json.Marshal(createPVZProductRequest{Type: models.ProductTypeShoes})
+
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/reception/product", handler.CreateForPVZ)
+
+	c.Request, _ = http.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/reception/product", bytes.NewBuffer(reqBody))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.Product
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, product.ID, response.ID)
+	assert.Equal(t, models.ProductTypeShoes, response.Type)
+}
+
+func TestProductHandler_CreateForPVZ_WithBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewProductHandler(mockProductUseCase, mockLogger, mockMetrics)
+
+	pvzID := uuid.New()
+	input := usecase.ProductInput{Type: models.ProductTypeShoes, OrderID: "ORD-42", Barcode: "4600000000001"}
+	mockProductUseCase.EXPECT().Create(gomock.Any(), pvzID, input).Return(nil, errors.ErrDuplicateBarcode)
+
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/reception/product", handler.CreateForPVZ)
+
+	c.Request, _ = http.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/reception/product",
+		bytes.NewBufferString(`{"type":"обувь","orderId":"ORD-42","barcode":"4600000000001"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"DUPLICATE_BARCODE"`)
+}
+
+func TestProductHandler_FindByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewProductHandler(mockProductUseCase, mockLogger, mockMetrics)
+
+	pvz := models.NewPVZ(models.CityKazan)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeShoes, reception.ID)
+	product.OrderID = "ORD-42"
+	product.Barcode = "4600000000001"
+
+	mockProductUseCase.EXPECT().FindByBarcode(gomock.Any(), product.Barcode).Return(&usecase.ProductLocation{
+		Product:   product,
+		Reception: reception,
+		PVZ:       pvz,
+	}, nil)
+	mockProductUseCase.EXPECT().FindByBarcode(gomock.Any(), "unknown").Return(nil, errors.ErrProductNotFound)
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.GET("/products/barcode/:barcode", handler.FindByBarcode)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/barcode/"+product.Barcode, nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.ProductLocation
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, product.ID, response.Product.ID)
+	assert.Equal(t, product.OrderID, response.Product.OrderID)
+	assert.Equal(t, reception.ID, response.Reception.ID)
+	assert.Equal(t, pvz.ID, response.PVZ.ID)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/barcode/unknown", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PRODUCT_NOT_FOUND"`)
+}
+
+func TestProductHandler_CreateForPVZ_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewProductHandler(mockProductUseCase, mockLogger, mockMetrics)
+
+	testCases := []struct {
+		name string
+		path string
+		body string
+	}{
+		{name: "Invalid PVZ ID", path: "/pvz/invalid-uuid/reception/product", body: `{"type":"обувь"}`},
+		{name: "Missing Type", path: "/pvz/" + uuid.New().String() + "/reception/product", body: `{}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, r := gin.CreateTestContext(w)
+			r.POST("/pvz/:pvzId/reception/product", handler.CreateForPVZ)
+
+			c.Request, _ = http.NewRequest(http.MethodPost, tc.path, bytes.NewBufferString(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(w, c.Request)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestProductHandler_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewProductHandler(mockProductUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	closedProductID := uuid.New()
+
+	mockProductUseCase.EXPECT().Delete(gomock.Any(), pvzID, product.ID).Return(product, nil)
+	mockProductUseCase.EXPECT().Delete(gomock.Any(), pvzID, closedProductID).Return(nil, errors.ErrReceptionAlreadyClosed)
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.DELETE("/pvz/:pvzId/reception/product/:productId", handler.Delete)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String()+"/reception/product/"+product.ID.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.Product
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, product.ID, response.ID)
+
+	// Товар из закрытой приемки не удаляется
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String()+"/reception/product/"+closedProductID.String(), nil))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"RECEPTION_ALREADY_CLOSED"`)
+}
+
+func TestProductHandler_Delete_InvalidProductID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewProductHandler(mock_usecase.NewMockProductUseCase(ctrl), mockLogger, metrics.NewMockMetrics())
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.DELETE("/pvz/:pvzId/reception/product/:productId", handler.Delete)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+uuid.NewString()+"/reception/product/invalid", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid product id")
+}
+
+func TestProductHandler_ChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewProductHandler(mockProductUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.SetStatus(models.ProductStatusReadyForPickup)
+
+	mockProductUseCase.EXPECT().ChangeStatus(gomock.Any(), pvzID, product.ID, models.ProductStatusReadyForPickup).Return(product, nil)
+	mockProductUseCase.EXPECT().ChangeStatus(gomock.Any(), pvzID, product.ID, models.ProductStatusIssued).Return(nil, errors.ErrProductStatusTransition)
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.POST("/pvz/:pvzId/products/:productId/status", handler.ChangeStatus)
+	r.POST("/pvz/:pvzId/products/:productId/issue", handler.Issue)
+
+	w := httptest.NewRecorder()
+	body := bytes.NewBufferString(`{"status":"ready_for_pickup"}`)
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/products/"+product.ID.String()+"/status", body))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.Product
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, models.ProductStatusReadyForPickup, response.Status)
+
+	// Выдача недопустима из текущего состояния
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/products/"+product.ID.String()+"/issue", nil))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PRODUCT_STATUS_TRANSITION_NOT_ALLOWED"`)
+}
+
+func TestProductHandler_ListShelf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewProductHandler(mockProductUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.SetStatus(models.ProductStatusReadyForPickup)
+
+	statuses := []models.ProductStatus{models.ProductStatusReadyForPickup}
+	mockProductUseCase.EXPECT().ListShelf(gomock.Any(), pvzID, statuses, 2, 5).Return([]*models.Product{product}, nil)
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.GET("/pvz/:pvzId/shelf", handler.ListShelf)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/shelf?status=ready_for_pickup&page=2&limit=5", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.Product
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 1)
+	assert.Equal(t, product.ID, response[0].ID)
+}
+
+func TestProductHandler_CreateBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductUseCase := mock_usecase.NewMockProductUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewProductHandler(mockProductUseCase, mockLogger, metrics.NewMockMetrics())
+
+	r := gin.New()
+	r.POST("/products/batch", handler.CreateBatch)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/products/batch", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	first := models.NewProduct(models.ProductTypeShoes, receptionID)
+	first.Barcode = "4600000000001"
+	second := models.NewProduct(models.ProductTypeClothes, receptionID)
+	second.OrderID = "ORD-1"
+
+	mockProductUseCase.EXPECT().CreateBatch(gomock.Any(), pvzID, []usecase.ProductInput{
+		{Type: models.ProductTypeShoes, Barcode: "4600000000001"},
+		{Type: models.ProductTypeClothes, OrderID: "ORD-1"},
+	}).Return([]*models.Product{first, second}, nil)
+
+	w := send(`{"pvzId":"` + pvzID.String() + `","products":[{"type":"обувь","barcode":"4600000000001"},{"type":"одежда","orderId":"ORD-1"}]}`)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var response []dto.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, first.ID, response[0].ID)
+	assert.Equal(t, second.ID, response[1].ID)
+
+	// Товар без категории не проходит разбор запроса
+	w = send(`{"pvzId":"` + pvzID.String() + `","products":[{"type":"обувь"},{"barcode":"4600000000001"}]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Ошибка одного товара отклоняет всю пачку
+	mockProductUseCase.EXPECT().CreateBatch(gomock.Any(), pvzID, gomock.Any()).
+		Return(nil, errors.WithDetails(errors.Wrap(errors.ErrInvalidProductType, "products[1]"), map[string]string{"index": "1"}))
+
+	w = send(`{"pvzId":"` + pvzID.String() + `","products":[{"type":"обувь"},{"type":"мебель"}]}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var errResponse dto.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+	assert.Equal(t, "INVALID_PRODUCT_TYPE", errResponse.Code)
+	assert.Contains(t, errResponse.Message, "products[1]")
+	assert.Equal(t, map[string]string{"index": "1"}, errResponse.Details)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+type PVZAssignmentHandler struct {
+	assignmentUseCase usecase.PVZAssignmentUseCase
+	logger            logger.Logger
+}
+
+func NewPVZAssignmentHandler(assignmentUseCase usecase.PVZAssignmentUseCase, logger logger.Logger) *PVZAssignmentHandler {
+	return &PVZAssignmentHandler{
+		assignmentUseCase: assignmentUseCase,
+		logger:            logger,
+	}
+}
+
+type assignEmployeeRequest struct {
+	UserID uuid.UUID `json:"userId" binding:"required"`
+}
+
+// Assign закрепляет сотрудника за ПВЗ
+func (h *PVZAssignmentHandler) Assign(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req assignEmployeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	assignment, err := h.assignmentUseCase.Assign(c.Request.Context(), pvzID, req.UserID)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewPVZAssignment(assignment, responseOptions(c)))
+}
+
+// Unassign открепляет сотрудника от ПВЗ
+func (h *PVZAssignmentHandler) Unassign(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidUserID)
+		return
+	}
+
+	if err := h.assignmentUseCase.Unassign(c.Request.Context(), pvzID, userID); err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Message{Message: "employee unassigned"})
+}
+
+// List возвращает сотрудников, закрепленных за ПВЗ
+func (h *PVZAssignmentHandler) List(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	assignments, err := h.assignmentUseCase.List(c.Request.Context(), pvzID)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPVZAssignmentList(assignments, responseOptions(c)))
+}
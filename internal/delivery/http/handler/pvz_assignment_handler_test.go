@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newPVZAssignmentTestRouter(t *testing.T) (*gin.Engine, *mock_usecase.MockPVZAssignmentUseCase) {
+	ctrl := gomock.NewController(t)
+
+	mockAssignmentUseCase := mock_usecase.NewMockPVZAssignmentUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZAssignmentHandler(mockAssignmentUseCase, mockLogger)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/pvz/:pvzId/employees", handler.List)
+	r.POST("/pvz/:pvzId/employees", handler.Assign)
+	r.DELETE("/pvz/:pvzId/employees/:userId", handler.Unassign)
+
+	return r, mockAssignmentUseCase
+}
+
+func TestPVZAssignmentHandler_Assign(t *testing.T) {
+	r, mockAssignmentUseCase := newPVZAssignmentTestRouter(t)
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+	assignment := models.NewPVZAssignment(userID, pvzID)
+	mockAssignmentUseCase.EXPECT().Assign(gomock.Any(), pvzID, userID).Return(assignment, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/employees", bytes.NewBufferString(`{"userId":"`+userID.String()+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.PVZAssignment
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, userID, response.UserID)
+	assert.Equal(t, pvzID, response.PVZID)
+}
+
+func TestPVZAssignmentHandler_Assign_Errors(t *testing.T) {
+	pvzID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+		reason       string
+	}{
+		{"Already assigned", errors.ErrAssignmentExists, http.StatusConflict, "ASSIGNMENT_ALREADY_EXISTS"},
+		{"Not an employee", errors.ErrAssigneeNotEmployee, http.StatusUnprocessableEntity, "ASSIGNEE_NOT_EMPLOYEE"},
+		{"User not found", errors.ErrUserNotFound, http.StatusNotFound, "USER_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockAssignmentUseCase := newPVZAssignmentTestRouter(t)
+			mockAssignmentUseCase.EXPECT().Assign(gomock.Any(), pvzID, userID).Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/employees", bytes.NewBufferString(`{"userId":"`+userID.String()+`"}`))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), `"code":"`+tt.reason+`"`)
+		})
+	}
+}
+
+func TestPVZAssignmentHandler_Unassign(t *testing.T) {
+	r, mockAssignmentUseCase := newPVZAssignmentTestRouter(t)
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+	mockAssignmentUseCase.EXPECT().Unassign(gomock.Any(), pvzID, userID).Return(nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String()+"/employees/"+userID.String(), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockAssignmentUseCase.EXPECT().Unassign(gomock.Any(), pvzID, userID).Return(errors.ErrAssignmentNotFound)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String()+"/employees/"+userID.String(), nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String()+"/employees/not-a-uuid", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestPVZAssignmentHandler_List(t *testing.T) {
+	r, mockAssignmentUseCase := newPVZAssignmentTestRouter(t)
+
+	pvzID := uuid.New()
+	assignments := []*models.PVZAssignment{
+		models.NewPVZAssignment(uuid.New(), pvzID),
+		models.NewPVZAssignment(uuid.New(), pvzID),
+	}
+	mockAssignmentUseCase.EXPECT().List(gomock.Any(), pvzID).Return(assignments, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/employees", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.PVZAssignment
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	assert.Equal(t, assignments[0].UserID, response[0].UserID)
+}
@@ -2,14 +2,15 @@ package handler
 
 import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
-	"go.uber.org/zap"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
-	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 )
 
@@ -27,31 +28,51 @@ func NewPVZHandler(pvzUseCase usecase.PVZUseCase, logger logger.Logger, metrics
 	}
 }
 
+type coordinatesRequest struct {
+	Latitude  *float64 `json:"latitude" binding:"required"`
+	Longitude *float64 `json:"longitude" binding:"required"`
+}
+
 type createPVZRequest struct {
-	City models.City `json:"city" binding:"required"`
+	City         models.City         `json:"city" binding:"required"`
+	Address      string              `json:"address"`
+	Coordinates  *coordinatesRequest `json:"coordinates"`
+	WorkingHours string              `json:"workingHours"`
+	Phone        string              `json:"phone"`
+	// Capacity - вместимость ПВЗ в товарах, 0 - без ограничения
+	Capacity int `json:"capacity"`
 }
 
 func (h *PVZHandler) Create(c *gin.Context) {
 	var req createPVZRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
-	pvz, err := h.pvzUseCase.Create(c.Request.Context(), req.City)
-	if err != nil {
-		if err == errors.ErrInvalidCity {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid city"})
-			return
+	input := usecase.PVZInput{
+		City:         req.City,
+		Address:      req.Address,
+		WorkingHours: req.WorkingHours,
+		Phone:        req.Phone,
+		Capacity:     req.Capacity,
+	}
+	if req.Coordinates != nil {
+		input.Coordinates = &models.Coordinates{
+			Latitude:  *req.Coordinates.Latitude,
+			Longitude: *req.Coordinates.Longitude,
 		}
-		h.logger.Error("failed to create pvz", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+	}
+
+	pvz, err := h.pvzUseCase.Create(c.Request.Context(), input)
+	if err != nil {
+		middleware.Error(c, err)
 		return
 	}
 
 	h.metrics.IncPVZCreated()
 
-	c.JSON(http.StatusCreated, pvz)
+	c.JSON(http.StatusCreated, dto.NewPVZ(pvz, responseOptions(c)))
 }
 
 type listPVZRequest struct {
@@ -59,12 +80,16 @@ type listPVZRequest struct {
 	EndDate   string `form:"endDate"`
 	Page      int    `form:"page,default=1" binding:"min=1"`
 	Limit     int    `form:"limit,default=10" binding:"min=1,max=30"`
+	// IncludeArchived добавляет в выборку архивные ПВЗ
+	IncludeArchived bool `form:"includeArchived"`
+	// ReceptionKind оставляет в ответе только приемки этого вида
+	ReceptionKind models.ReceptionKind `form:"receptionKind"`
 }
 
 func (h *PVZHandler) List(c *gin.Context) {
 	var req listPVZRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
@@ -72,7 +97,7 @@ func (h *PVZHandler) List(c *gin.Context) {
 	if req.StartDate != "" {
 		parsedStartDate, err := time.Parse(time.RFC3339, req.StartDate)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid start date format"})
+			middleware.BadRequest(c, errInvalidStartDate)
 			return
 		}
 		startDate = &parsedStartDate
@@ -81,18 +106,98 @@ func (h *PVZHandler) List(c *gin.Context) {
 	if req.EndDate != "" {
 		parsedEndDate, err := time.Parse(time.RFC3339, req.EndDate)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid end date format"})
+			middleware.BadRequest(c, errInvalidEndDate)
 			return
 		}
 		endDate = &parsedEndDate
 	}
 
-	pvzs, err := h.pvzUseCase.List(c.Request.Context(), startDate, endDate, req.Page, req.Limit)
+	pvzs, err := h.pvzUseCase.List(c.Request.Context(), startDate, endDate, req.Page, req.Limit, req.IncludeArchived, req.ReceptionKind)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPVZList(pvzs, responseOptions(c)))
+}
+
+type nearestPVZRequest struct {
+	Latitude  *float64 `form:"lat" binding:"required"`
+	Longitude *float64 `form:"lon" binding:"required"`
+	// Radius - радиус поиска в метрах
+	Radius float64 `form:"radius,default=5000" binding:"gt=0"`
+	Limit  int     `form:"limit,default=10" binding:"min=1,max=30"`
+}
+
+// Nearest возвращает ПВЗ в радиусе от точки, ближайшие первыми
+func (h *PVZHandler) Nearest(c *gin.Context) {
+	var req nearestPVZRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	point := models.Coordinates{Latitude: *req.Latitude, Longitude: *req.Longitude}
+	pvzs, err := h.pvzUseCase.ListNearest(c.Request.Context(), point, req.Radius, req.Limit)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewNearbyPVZList(pvzs, responseOptions(c)))
+}
+
+type changePVZStatusRequest struct {
+	Status models.PVZStatus `json:"status" binding:"required"`
+	Reason string           `json:"reason"`
+}
+
+// ChangeStatus приостанавливает, возобновляет или архивирует ПВЗ
+func (h *PVZHandler) ChangeStatus(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req changePVZStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	pvz, err := h.pvzUseCase.ChangeStatus(c.Request.Context(), pvzID, req.Status, req.Reason)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPVZ(pvz, responseOptions(c)))
+}
+
+type setPVZCapacityRequest struct {
+	Capacity *int `json:"capacity" binding:"required"`
+}
+
+// SetCapacity меняет вместимость ПВЗ. Уже принятые товары не выселяются.
+func (h *PVZHandler) SetCapacity(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req setPVZCapacityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	pvz, err := h.pvzUseCase.SetCapacity(c.Request.Context(), pvzID, *req.Capacity)
 	if err != nil {
-		h.logger.Error("failed to get pvz list", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, pvzs)
+	c.JSON(http.StatusOK, dto.NewPVZ(pvz, responseOptions(c)))
 }
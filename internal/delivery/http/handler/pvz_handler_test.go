@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
@@ -43,7 +44,7 @@ func TestPVZHandler_Create(t *testing.T) {
 		City:             req.City,
 		CreatedAt:        time.Now(),
 	}
-	mockPVZUseCase.EXPECT().Create(gomock.Any(), req.City).Return(pvz, nil)
+	mockPVZUseCase.EXPECT().Create(gomock.Any(), usecase.PVZInput{City: req.City}).Return(pvz, nil)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -56,7 +57,7 @@ func TestPVZHandler_Create(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 
-	var response models.PVZ
+	var response dto.PVZ
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, pvz.ID, response.ID)
@@ -77,7 +78,7 @@ func TestPVZHandler_Create_InvalidCity(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockPVZUseCase.EXPECT().Create(gomock.Any(), models.City(req.City)).Return(nil, errors.ErrInvalidCity)
+	mockPVZUseCase.EXPECT().Create(gomock.Any(), usecase.PVZInput{City: req.City}).Return(nil, errors.ErrInvalidCity)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -88,8 +89,8 @@ func TestPVZHandler_Create_InvalidCity(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "invalid city")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"INVALID_CITY"`)
 }
 
 func TestPVZHandler_List(t *testing.T) {
@@ -142,8 +143,8 @@ func TestPVZHandler_List(t *testing.T) {
 	}
 
 	mockPVZUseCase.EXPECT().
-		List(gomock.Any(), gomock.Any(), gomock.Any(), page, limit).
-		DoAndReturn(func(_ interface{}, startDateParam, endDateParam *time.Time, pageParam, limitParam int) ([]*usecase.PVZWithReceptions, error) {
+		List(gomock.Any(), gomock.Any(), gomock.Any(), page, limit, false, models.ReceptionKind("")).
+		DoAndReturn(func(_ interface{}, startDateParam, endDateParam *time.Time, pageParam, limitParam int, _ bool, _ models.ReceptionKind) ([]*usecase.PVZWithReceptions, error) {
 			// Проверяем, что параметры соответствуют ожидаемым
 			assert.NotNil(t, startDateParam)
 			assert.NotNil(t, endDateParam)
@@ -171,7 +172,7 @@ func TestPVZHandler_List(t *testing.T) {
 
 	// Если ответ успешный, проверяем его содержимое
 	if w.Code == http.StatusOK {
-		var response []*usecase.PVZWithReceptions
+		var response []dto.PVZWithReceptions
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
 		assert.Len(t, response, 1)
@@ -207,3 +208,237 @@ func TestPVZHandler_List_InvalidDateFormat(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 	assert.Contains(t, w.Body.String(), "invalid start date format")
 }
+
+func TestPVZHandler_Create_WithDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	input := usecase.PVZInput{
+		City:         models.CityMoscow,
+		Address:      "ул. Тверская, 1",
+		Coordinates:  &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137},
+		WorkingHours: "ежедневно 09:00-21:00",
+		Phone:        "+74951234567",
+	}
+	pvz := models.NewPVZ(input.City)
+	pvz.Address = input.Address
+	pvz.Coordinates = input.Coordinates
+	pvz.WorkingHours = input.WorkingHours
+	pvz.Phone = input.Phone
+	mockPVZUseCase.EXPECT().Create(gomock.Any(), input).Return(pvz, nil)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz", handler.Create)
+
+	body := `{"city":"Москва","address":"ул. Тверская, 1","coordinates":{"latitude":55.7576,"longitude":37.6137},` +
+		`"workingHours":"ежедневно 09:00-21:00","phone":"+74951234567"}`
+	req, _ := http.NewRequest(http.MethodPost, "/pvz", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.PVZ
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, input.Address, response.Address)
+	assert.Equal(t, &dto.Coordinates{Latitude: 55.7576, Longitude: 37.6137}, response.Coordinates)
+	assert.Equal(t, input.WorkingHours, response.WorkingHours)
+	assert.Equal(t, input.Phone, response.Phone)
+}
+
+func TestPVZHandler_Create_PartialCoordinates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mock_usecase.NewMockPVZUseCase(ctrl), mockLogger, metrics.NewMockMetrics())
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz", handler.Create)
+
+	req, _ := http.NewRequest(http.MethodPost, "/pvz", bytes.NewBufferString(`{"city":"Москва","coordinates":{"latitude":55.7576}}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"longitude":"required"`)
+}
+
+func TestPVZHandler_Nearest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	point := models.Coordinates{Latitude: 55.75, Longitude: 37.61}
+	pvz := models.NewPVZ(models.CityMoscow)
+	mockPVZUseCase.EXPECT().ListNearest(gomock.Any(), point, 1500.0, 10).
+		Return([]*models.NearbyPVZ{{PVZ: pvz, Distance: 872.5}}, nil)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.GET("/pvz/nearest", handler.Nearest)
+
+	req, _ := http.NewRequest(http.MethodGet, "/pvz/nearest?lat=55.75&lon=37.61&radius=1500", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.NearbyPVZ
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 1)
+	assert.Equal(t, pvz.ID, response[0].PVZ.ID)
+	assert.Equal(t, 872.5, response[0].Distance)
+}
+
+func TestPVZHandler_Nearest_MissingCoordinates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mock_usecase.NewMockPVZUseCase(ctrl), mockLogger, metrics.NewMockMetrics())
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.GET("/pvz/nearest", handler.Nearest)
+
+	req, _ := http.NewRequest(http.MethodGet, "/pvz/nearest?lat=55.75", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"lon":"required"`)
+}
+
+func TestPVZHandler_List_IncludeArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusArchived, "закрыт")
+	mockPVZUseCase.EXPECT().List(gomock.Any(), nil, nil, 1, 10, true, models.ReceptionKind("")).
+		Return([]*usecase.PVZWithReceptions{{PVZ: pvz}}, nil)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.GET("/pvz", handler.List)
+
+	req, _ := http.NewRequest(http.MethodGet, "/pvz?includeArchived=true", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"archived","statusReason":"закрыт"`)
+}
+
+func TestPVZHandler_ChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+	mockPVZUseCase.EXPECT().ChangeStatus(gomock.Any(), pvz.ID, models.PVZStatusSuspended, "ремонт").Return(pvz, nil)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/status", handler.ChangeStatus)
+
+	req, _ := http.NewRequest(http.MethodPost, "/pvz/"+pvz.ID.String()+"/status", bytes.NewBufferString(`{"status":"suspended","reason":"ремонт"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.PVZ
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, models.PVZStatusSuspended, response.Status)
+	assert.Equal(t, "ремонт", response.StatusReason)
+}
+
+func TestPVZHandler_ChangeStatus_NotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+	mockPVZUseCase.EXPECT().ChangeStatus(gomock.Any(), pvzID, models.PVZStatusActive, "").Return(nil, errors.ErrPVZStatusTransition)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/status", handler.ChangeStatus)
+
+	req, _ := http.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/status", bytes.NewBufferString(`{"status":"active"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_STATUS_TRANSITION_NOT_ALLOWED"`)
+}
+
+func TestPVZHandler_SetCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZUseCase := mock_usecase.NewMockPVZUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewPVZHandler(mockPVZUseCase, mockLogger, metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.Capacity = 300
+	pvz.Occupied = 270
+	mockPVZUseCase.EXPECT().SetCapacity(gomock.Any(), pvz.ID, 300).Return(pvz, nil)
+	mockPVZUseCase.EXPECT().SetCapacity(gomock.Any(), pvz.ID, -1).Return(nil, errors.ErrInvalidPVZCapacity)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/capacity", handler.SetCapacity)
+
+	req, _ := http.NewRequest(http.MethodPost, "/pvz/"+pvz.ID.String()+"/capacity", bytes.NewBufferString(`{"capacity":300}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.PVZ
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, 300, response.Capacity)
+	assert.Equal(t, 270, response.Occupied)
+	assert.True(t, response.NearlyFull)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/pvz/"+pvz.ID.String()+"/capacity", bytes.NewBufferString(`{"capacity":-1}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"INVALID_PVZ_CAPACITY"`)
+
+	// Без вместимости запрос не принимается
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/pvz/"+pvz.ID.String()+"/capacity", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -10,7 +10,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
@@ -42,7 +44,7 @@ func TestReceptionHandler_Create(t *testing.T) {
 		Status:    models.ReceptionStatusInProgress,
 		CreatedAt: time.Now(),
 	}
-	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID).Return(reception, nil)
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(reception, nil)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -55,7 +57,7 @@ func TestReceptionHandler_Create(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 
-	var response models.Reception
+	var response dto.Reception
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, reception.ID, response.ID)
@@ -78,7 +80,7 @@ func TestReceptionHandler_Create_PVZNotFound(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(nil, errors.ErrPVZNotFound)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -89,8 +91,8 @@ func TestReceptionHandler_Create_PVZNotFound(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestReceptionHandler_Create_OpenReceptionExists(t *testing.T) {
@@ -108,7 +110,7 @@ func TestReceptionHandler_Create_OpenReceptionExists(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID).Return(nil, errors.ErrOpenReceptionExists)
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(nil, errors.ErrOpenReceptionExists)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -119,8 +121,8 @@ func TestReceptionHandler_Create_OpenReceptionExists(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "open reception already exists")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"OPEN_RECEPTION_EXISTS"`)
 }
 
 func TestReceptionHandler_CloseLastReception(t *testing.T) {
@@ -156,7 +158,7 @@ func TestReceptionHandler_CloseLastReception(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	var response models.Reception
+	var response dto.Reception
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, reception.ID, response.ID)
@@ -209,8 +211,8 @@ func TestReceptionHandler_CloseLastReception_NoOpenReception(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestReceptionHandler_Create_InvalidPVZ(t *testing.T) {
@@ -228,7 +230,7 @@ func TestReceptionHandler_Create_InvalidPVZ(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(req)
 
-	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(nil, errors.ErrPVZNotFound)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -239,8 +241,8 @@ func TestReceptionHandler_Create_InvalidPVZ(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
 }
 
 func TestReceptionHandler_Close(t *testing.T) {
@@ -276,7 +278,7 @@ func TestReceptionHandler_Close(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	var response models.Reception
+	var response dto.Reception
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, reception.ID, response.ID)
@@ -330,6 +332,146 @@ func TestReceptionHandler_Close_AlreadyClosed(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
+}
+
+func TestReceptionHandler_CreateForPVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewReceptionHandler(mockReceptionUseCase, mockLogger, mockMetrics)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(reception, nil)
+
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/reception", handler.CreateForPVZ)
+
+	c.Request, _ = http.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/reception", nil)
+
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.Reception
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, reception.ID, response.ID)
+	assert.Equal(t, pvzID, response.PVZID)
+}
+
+func TestReceptionHandler_CreateForPVZ_CustomerReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewReceptionHandler(mockReceptionUseCase, mockLogger, mockMetrics)
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	reception.Kind = models.ReceptionKindCustomerReturn
+	mockReceptionUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.ReceptionInput{Kind: models.ReceptionKindCustomerReturn}).Return(reception, nil)
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/reception", handler.CreateForPVZ)
+
+	req, _ := http.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/reception", bytes.NewBufferString(`{"kind":"customer_return"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.Reception
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, models.ReceptionKindCustomerReturn, response.Kind)
+}
+
+func TestReceptionHandler_CreateForPVZ_InvalidPVZID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	mockMetrics := metrics.NewMockMetrics()
+	handler := NewReceptionHandler(mockReceptionUseCase, mockLogger, mockMetrics)
+
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.POST("/pvz/:pvzId/reception", handler.CreateForPVZ)
+
+	c.Request, _ = http.NewRequest(http.MethodPost, "/pvz/invalid-uuid/reception", nil)
+
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestReceptionHandler_Reopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionUseCase := mock_usecase.NewMockReceptionUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewReceptionHandler(mockReceptionUseCase, mockLogger, metrics.NewMockMetrics())
+
+	reception := models.NewReception(uuid.New())
+	reception.Close()
+	reception.Reopen("машина еще разгружается")
+	newerReceptionID := uuid.New()
+
+	mockReceptionUseCase.EXPECT().Reopen(gomock.Any(), reception.ID, "машина еще разгружается").Return(reception, nil)
+	mockReceptionUseCase.EXPECT().Reopen(gomock.Any(), newerReceptionID, "ошибка").Return(nil, errors.ErrNewerReceptionExists)
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.POST("/receptions/:receptionId/reopen", handler.Reopen)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/receptions/"+reception.ID.String()+"/reopen", bytes.NewBufferString(`{"reason":"машина еще разгружается"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.Reception
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, models.ReceptionStatusInProgress, response.Status)
+	assert.Equal(t, "машина еще разгружается", response.ReopenReason)
+	assert.NotNil(t, response.ReopenedAt)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/receptions/"+newerReceptionID.String()+"/reopen", bytes.NewBufferString(`{"reason":"ошибка"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"NEWER_RECEPTION_EXISTS"`)
+}
+
+func TestReceptionHandler_Reopen_MissingReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewReceptionHandler(mock_usecase.NewMockReceptionUseCase(ctrl), mockLogger, metrics.NewMockMetrics())
+
+	_, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.POST("/receptions/:receptionId/reopen", handler.Reopen)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/receptions/"+uuid.NewString()+"/reopen", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "pvz not found")
 }
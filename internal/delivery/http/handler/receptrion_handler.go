@@ -2,13 +2,14 @@ package handler
 
 import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
-	"go.uber.org/zap"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
-	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 )
 
@@ -28,57 +29,102 @@ func NewReceptionHandler(receptionUseCase usecase.ReceptionUseCase, logger logge
 
 type createReceptionRequest struct {
 	PVZID uuid.UUID `json:"pvzId" binding:"required"`
+	// Kind - вид приемки, по умолчанию обычная поставка
+	Kind models.ReceptionKind `json:"kind"`
+	// MaxItems - квота товаров в приемке, 0 - без ограничения
+	MaxItems int `json:"maxItems"`
 }
 
 func (h *ReceptionHandler) Create(c *gin.Context) {
 	var req createReceptionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
-	reception, err := h.receptionUseCase.Create(c.Request.Context(), req.PVZID)
+	h.create(c, req.PVZID, usecase.ReceptionInput{Kind: req.Kind, MaxItems: req.MaxItems})
+}
+
+type createPVZReceptionRequest struct {
+	Kind     models.ReceptionKind `json:"kind"`
+	MaxItems int                  `json:"maxItems"`
+}
+
+// CreateForPVZ создает приемку в ПВЗ из пути запроса. Тело запроса необязательно.
+func (h *ReceptionHandler) CreateForPVZ(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
 	if err != nil {
-		if errors.IsNotFound(err) {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "pvz not found"})
-			return
-		}
-		if err == errors.ErrOpenReceptionExists {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "open reception already exists"})
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req createPVZReceptionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.BadRequest(c, err)
 			return
 		}
-		h.logger.Error("failed to create reception", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+	}
+
+	h.create(c, pvzID, usecase.ReceptionInput{Kind: req.Kind, MaxItems: req.MaxItems})
+}
+
+func (h *ReceptionHandler) create(c *gin.Context, pvzID uuid.UUID, input usecase.ReceptionInput) {
+	if input.Kind == "" {
+		input.Kind = models.ReceptionKindInbound
+	}
+
+	reception, err := h.receptionUseCase.Create(c.Request.Context(), pvzID, input)
+	if err != nil {
+		middleware.Error(c, err)
 		return
 	}
 
 	h.metrics.IncReceptionCreated()
 
-	c.JSON(http.StatusCreated, reception)
+	c.JSON(http.StatusCreated, dto.NewReception(reception, responseOptions(c)))
 }
 
 func (h *ReceptionHandler) CloseLastReception(c *gin.Context) {
 	pvzIDStr := c.Param("pvzId")
 	pvzID, err := uuid.Parse(pvzIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid pvz id"})
+		middleware.BadRequest(c, errInvalidPVZID)
 		return
 	}
 
 	reception, err := h.receptionUseCase.CloseLastReception(c.Request.Context(), pvzID)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "pvz not found"})
-			return
-		}
-		if err == errors.ErrOpenReceptionNotFound {
-			c.JSON(http.StatusBadRequest, gin.H{"message": "no open reception found"})
-			return
-		}
-		h.logger.Error("failed to close reception", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewReception(reception, responseOptions(c)))
+}
+
+type reopenReceptionRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// Reopen снова открывает закрытую приемку с указанием причины
+func (h *ReceptionHandler) Reopen(c *gin.Context) {
+	receptionID, err := uuid.Parse(c.Param("receptionId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidReceptionID)
+		return
+	}
+
+	var req reopenReceptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	reception, err := h.receptionUseCase.Reopen(c.Request.Context(), receptionID, req.Reason)
+	if err != nil {
+		middleware.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, reception)
+	c.JSON(http.StatusOK, dto.NewReception(reception, responseOptions(c)))
 }
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+type StorageCellHandler struct {
+	cellUseCase usecase.StorageCellUseCase
+	logger      logger.Logger
+}
+
+func NewStorageCellHandler(cellUseCase usecase.StorageCellUseCase, logger logger.Logger) *StorageCellHandler {
+	return &StorageCellHandler{
+		cellUseCase: cellUseCase,
+		logger:      logger,
+	}
+}
+
+type createStorageCellRequest struct {
+	Rack     string `json:"rack" binding:"required"`
+	Shelf    string `json:"shelf" binding:"required"`
+	Cell     string `json:"cell" binding:"required"`
+	Capacity int    `json:"capacity" binding:"required"`
+}
+
+// Create добавляет ячейку в раскладку ПВЗ
+func (h *StorageCellHandler) Create(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	var req createStorageCellRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.BadRequest(c, err)
+		return
+	}
+
+	cell, err := h.cellUseCase.Create(c.Request.Context(), pvzID, usecase.StorageCellInput{
+		Rack:     req.Rack,
+		Shelf:    req.Shelf,
+		Cell:     req.Cell,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewStorageCell(cell, responseOptions(c)))
+}
+
+// List возвращает раскладку ПВЗ с заполненностью ячеек
+func (h *StorageCellHandler) List(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+
+	cells, err := h.cellUseCase.List(c.Request.Context(), pvzID)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewStorageCellList(cells, responseOptions(c)))
+}
+
+// ListProducts возвращает товары, которые сейчас лежат в ячейке
+func (h *StorageCellHandler) ListProducts(c *gin.Context) {
+	pvzID, err := uuid.Parse(c.Param("pvzId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidPVZID)
+		return
+	}
+	cellID, err := uuid.Parse(c.Param("cellId"))
+	if err != nil {
+		middleware.BadRequest(c, errInvalidCellID)
+		return
+	}
+
+	products, err := h.cellUseCase.ListProducts(c.Request.Context(), pvzID, cellID)
+	if err != nil {
+		middleware.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewProductList(products, responseOptions(c)))
+}
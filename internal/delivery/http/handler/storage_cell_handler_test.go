@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newStorageCellTestRouter(t *testing.T) (*gin.Engine, *mock_usecase.MockStorageCellUseCase) {
+	ctrl := gomock.NewController(t)
+
+	mockCellUseCase := mock_usecase.NewMockStorageCellUseCase(ctrl)
+	mockLogger, _ := logger.NewLogger("debug")
+	handler := NewStorageCellHandler(mockCellUseCase, mockLogger)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/pvz/:pvzId/cells", handler.List)
+	r.POST("/pvz/:pvzId/cells", handler.Create)
+	r.GET("/pvz/:pvzId/cells/:cellId/products", handler.ListProducts)
+
+	return r, mockCellUseCase
+}
+
+func TestStorageCellHandler_Create(t *testing.T) {
+	r, mockCellUseCase := newStorageCellTestRouter(t)
+
+	pvzID := uuid.New()
+	cell := models.NewStorageCell(pvzID, "A", "2", "15", 4)
+	mockCellUseCase.EXPECT().Create(gomock.Any(), pvzID, usecase.StorageCellInput{Rack: "A", Shelf: "2", Cell: "15", Capacity: 4}).Return(cell, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/cells", bytes.NewBufferString(`{"rack":"A","shelf":"2","cell":"15","capacity":4}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response dto.StorageCell
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, cell.ID, response.ID)
+	assert.Equal(t, 4, response.Capacity)
+	assert.Equal(t, 0, response.Occupied)
+}
+
+func TestStorageCellHandler_Create_Exists(t *testing.T) {
+	r, mockCellUseCase := newStorageCellTestRouter(t)
+
+	pvzID := uuid.New()
+	mockCellUseCase.EXPECT().Create(gomock.Any(), pvzID, gomock.Any()).Return(nil, errors.ErrStorageCellExists)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/cells", bytes.NewBufferString(`{"rack":"A","shelf":"2","cell":"15","capacity":4}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"STORAGE_CELL_ALREADY_EXISTS"`)
+}
+
+func TestStorageCellHandler_List(t *testing.T) {
+	r, mockCellUseCase := newStorageCellTestRouter(t)
+
+	pvzID := uuid.New()
+	full := models.NewStorageCell(pvzID, "A", "1", "1", 1)
+	full.Occupied = 1
+	cells := []*models.StorageCell{full, models.NewStorageCell(pvzID, "A", "1", "2", 3)}
+	mockCellUseCase.EXPECT().List(gomock.Any(), pvzID).Return(cells, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/cells", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.StorageCell
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	assert.Equal(t, 1, response[0].Occupied)
+	assert.Equal(t, "2", response[1].Cell)
+}
+
+func TestStorageCellHandler_ListProducts(t *testing.T) {
+	r, mockCellUseCase := newStorageCellTestRouter(t)
+
+	pvzID := uuid.New()
+	cellID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.CellID = &cellID
+	mockCellUseCase.EXPECT().ListProducts(gomock.Any(), pvzID, cellID).Return([]*models.Product{product}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/cells/"+cellID.String()+"/products", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []dto.Product
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 1)
+	assert.Equal(t, &cellID, response[0].CellID)
+
+	// Некорректный идентификатор ячейки
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/cells/abc/products", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -1,13 +1,14 @@
 package handler
 
 import (
-	"go.uber.org/zap"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
-	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
 )
 
@@ -36,63 +37,54 @@ type loginRequest struct {
 
 type dummyLoginRequest struct {
 	Role models.UserRole `json:"role" binding:"required,oneof=employee moderator"`
+	// PVZIDs ограничивает ПВЗ, с которыми работает сотрудник с тестовым токеном
+	PVZIDs []uuid.UUID `json:"pvzIds"`
 }
 
 func (h *UserHandler) Register(c *gin.Context) {
 	var req registerRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
 	user, err := h.userUseCase.Register(c.Request.Context(), req.Email, req.Password, req.Role)
 	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
-			return
-		}
-		h.logger.Error("failed to register user", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, user)
+	c.JSON(http.StatusCreated, dto.NewUser(user, responseOptions(c)))
 }
 
 func (h *UserHandler) Login(c *gin.Context) {
 	var req loginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
 	token, err := h.userUseCase.Login(c.Request.Context(), req.Email, req.Password)
 	if err != nil {
-		if err == errors.ErrInvalidCredentials {
-			c.JSON(http.StatusUnauthorized, gin.H{"message": "invalid credentials"})
-			return
-		}
-		h.logger.Error("failed to login user", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, token)
+	c.JSON(http.StatusOK, dto.Token{Token: token})
 }
 
 func (h *UserHandler) DummyLogin(c *gin.Context) {
 	var req dummyLoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		middleware.BadRequest(c, err)
 		return
 	}
 
-	token, err := h.userUseCase.DummyLogin(c.Request.Context(), req.Role)
+	token, err := h.userUseCase.DummyLogin(c.Request.Context(), req.Role, req.PVZIDs)
 	if err != nil {
-		h.logger.Error("failed to generate dummy token", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		middleware.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, token)
+	c.JSON(http.StatusOK, dto.Token{Token: token})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
@@ -51,7 +52,7 @@ func TestUserHandler_Register(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 
-	var response models.User
+	var response dto.User
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, user.Email, response.Email)
@@ -85,7 +86,10 @@ func TestUserHandler_Login(t *testing.T) {
 	r.ServeHTTP(w, c.Request)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Contains(t, w.Body.String(), token)
+	var response dto.Token
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, token, response.Token)
 }
 
 func TestUserHandler_DummyLogin(t *testing.T) {
@@ -102,7 +106,7 @@ func TestUserHandler_DummyLogin(t *testing.T) {
 	reqBody, _ := json.Marshal(req)
 
 	token := "dummy-jwt-token"
-	mockUserUseCase.EXPECT().DummyLogin(gomock.Any(), req.Role).Return(token, nil)
+	mockUserUseCase.EXPECT().DummyLogin(gomock.Any(), req.Role, req.PVZIDs).Return(token, nil)
 
 	w := httptest.NewRecorder()
 	c, r := gin.CreateTestContext(w)
@@ -114,7 +118,10 @@ func TestUserHandler_DummyLogin(t *testing.T) {
 	r.ServeHTTP(w, c.Request)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Contains(t, w.Body.String(), token)
+	var response dto.Token
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, token, response.Token)
 }
 
 func TestUserHandler_Register_ValidationError(t *testing.T) {
@@ -171,8 +178,8 @@ func TestUserHandler_Register_UserAlreadyExists(t *testing.T) {
 
 	r.ServeHTTP(w, c.Request)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "already exists")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"USER_ALREADY_EXISTS"`)
 }
 
 func TestUserHandler_Login_InvalidCredentials(t *testing.T) {
@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -29,24 +28,26 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		header := c.GetHeader(authorizationHeader)
 		if header == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "empty auth header"})
+			Error(c, errors.Wrap(errors.ErrUnauthorized, "empty auth header"))
 			return
 		}
 
 		headerParts := strings.Split(header, " ")
 		if len(headerParts) != 2 || headerParts[0] != "Bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid auth header"})
+			Error(c, errors.Wrap(errors.ErrUnauthorized, "invalid auth header"))
 			return
 		}
 
 		token := headerParts[1]
 		user, err := m.userUseCase.ValidateToken(c.Request.Context(), token)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid token"})
+			Error(c, errors.Wrap(errors.ErrUnauthorized, "invalid token"))
 			return
 		}
 
 		c.Set(userCtx, user)
+		// Сценарии проверяют доступ к ПВЗ по пользователю из контекста запроса
+		c.Request = c.Request.WithContext(usecase.WithCaller(c.Request.Context(), user))
 		c.Next()
 	}
 }
@@ -55,13 +56,13 @@ func (m *AuthMiddleware) CheckRole(roles ...models.UserRole) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userValue, exists := c.Get(userCtx)
 		if !exists {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "user not found in context"})
+			Error(c, errors.Wrap(errors.ErrUnauthorized, "user not found in context"))
 			return
 		}
 
 		user, ok := userValue.(*models.User)
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "user is of invalid type"})
+			Error(c, errors.Wrap(errors.ErrInternal, "user is of invalid type"))
 			return
 		}
 
@@ -72,7 +73,7 @@ func (m *AuthMiddleware) CheckRole(roles ...models.UserRole) gin.HandlerFunc {
 			}
 		}
 
-		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "access denied"})
+		Error(c, errors.Wrap(errors.ErrForbidden, "access denied"))
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,6 +54,11 @@ func TestAuthMiddleware(t *testing.T) {
 		userFromCtx, exists := c.Get(userCtx)
 		assert.True(t, exists)
 		assert.Equal(t, user, userFromCtx)
+
+		// Пользователь доступен и сценариям через контекст запроса
+		caller, ok := usecase.CallerFromContext(c.Request.Context())
+		assert.True(t, ok)
+		assert.Equal(t, user, caller)
 	})
 
 	t.Run("Authenticate - Empty Header", func(t *testing.T) {
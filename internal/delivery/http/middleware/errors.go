@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+// codeBadRequest - код ошибки для запросов, которые не удалось разобрать
+const codeBadRequest = "BAD_REQUEST"
+
+// Error прерывает обработку запроса и отвечает конвертом доменной ошибки.
+// В details попадают сведения из errors.WithDetails, например позиция товара в пачке.
+// Текст внутренних ошибок клиенту не раскрывается, сама ошибка сохраняется в контексте для ErrorLogger.
+func Error(c *gin.Context, err error) {
+	status := StatusCode(err)
+
+	message := err.Error()
+	details := errors.Details(err)
+	if status == http.StatusInternalServerError {
+		message = "internal server error"
+		details = nil
+		_ = c.Error(err)
+	}
+
+	c.AbortWithStatusJSON(status, dto.Error{
+		Code:      errors.Reason(err),
+		Message:   message,
+		Details:   details,
+		RequestID: GetRequestID(c),
+	})
+}
+
+// BadRequest прерывает обработку запроса, который не удалось разобрать, с кодом 400.
+// Для ошибок валидации тела в details перечисляются поля и нарушенные правила.
+func BadRequest(c *gin.Context, err error) {
+	c.AbortWithStatusJSON(http.StatusBadRequest, dto.Error{
+		Code:      codeBadRequest,
+		Message:   err.Error(),
+		Details:   bindingDetails(err),
+		RequestID: GetRequestID(c),
+	})
+}
+
+// StatusCode возвращает HTTP статус, соответствующий доменной ошибке
+func StatusCode(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.IsUnauthorized(err), errors.Is(err, errors.ErrInvalidCredentials):
+		return http.StatusUnauthorized
+	case errors.IsForbidden(err):
+		return http.StatusForbidden
+	// Отсутствие открытой приемки - конфликт с состоянием ПВЗ, а не отсутствие ресурса
+	case errors.Is(err, errors.ErrOpenReceptionNotFound):
+		return http.StatusConflict
+	case errors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.IsAlreadyExists(err), errors.IsConflict(err):
+		return http.StatusConflict
+	case errors.IsInvalidInput(err):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, errors.ErrShuttingDown):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ErrorLogger записывает в лог внутренние ошибки, сохраненные обработчиками через Error
+func ErrorLogger(logger logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		for _, err := range c.Errors {
+			logger.Error("http request failed",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.FullPath()),
+				zap.String("request_id", GetRequestID(c)),
+				zap.Error(err.Err),
+			)
+		}
+	}
+}
+
+// Валидатор gin кеширует описание структур, поэтому имена полей из JSON регистрируются до первого запроса
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+// bindingDetails описывает ошибки разбора тела запроса по полям в терминах JSON контракта
+func bindingDetails(err error) map[string]string {
+	var validationErrs validator.ValidationErrors
+	if stderrors.As(err, &validationErrs) {
+		details := make(map[string]string, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			details[fieldErr.Field()] = fieldErr.Tag()
+		}
+		return details
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if stderrors.As(err, &typeErr) && typeErr.Field != "" {
+		return map[string]string{typeErr.Field: "type"}
+	}
+
+	return nil
+}
+
+// jsonFieldName возвращает имя поля из тега json, а для параметров запроса - из тега form
+func jsonFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
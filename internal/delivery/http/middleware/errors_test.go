@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/dto"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"PVZ Not Found", errors.ErrPVZNotFound, http.StatusNotFound},
+		{"Open Reception Not Found", errors.ErrOpenReceptionNotFound, http.StatusConflict},
+		{"Open Reception Exists", errors.ErrOpenReceptionExists, http.StatusConflict},
+		{"No Products To Delete", errors.ErrNoProductsToDelete, http.StatusConflict},
+		{"User Already Exists", errors.ErrUserAlreadyExists, http.StatusConflict},
+		{"Duplicate Barcode", errors.ErrDuplicateBarcode, http.StatusConflict},
+		{"Invalid Barcode", errors.ErrInvalidBarcode, http.StatusUnprocessableEntity},
+		{"Invalid City", errors.ErrInvalidCity, http.StatusUnprocessableEntity},
+		{"Invalid Coordinates", errors.ErrInvalidCoordinates, http.StatusUnprocessableEntity},
+		{"Invalid Product Type", errors.ErrInvalidProductType, http.StatusUnprocessableEntity},
+		{"Invalid Credentials", errors.ErrInvalidCredentials, http.StatusUnauthorized},
+		{"Forbidden", errors.ErrForbidden, http.StatusForbidden},
+		{"Deadline", context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"Unknown", stderrors.New("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, StatusCode(tt.err))
+		})
+	}
+}
+
+func newErrorTestRouter(handler gin.HandlerFunc) (*gin.Engine, *observer.ObservedLogs) {
+	gin.SetMode(gin.TestMode)
+	core, logs := observer.New(zap.ErrorLevel)
+
+	router := gin.New()
+	router.Use(RequestID(), ErrorLogger(zap.New(core)))
+	router.POST("/test", handler)
+	return router, logs
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) dto.Error {
+	var response dto.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+func TestError(t *testing.T) {
+	t.Run("Domain Error", func(t *testing.T) {
+		router, logs := newErrorTestRouter(func(c *gin.Context) {
+			Error(c, errors.ErrPVZNotFound)
+		})
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set(requestIDHeader, "req-1")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "req-1", w.Header().Get(requestIDHeader))
+		response := decodeError(t, w)
+		assert.Equal(t, "PVZ_NOT_FOUND", response.Code)
+		assert.Equal(t, errors.ErrPVZNotFound.Error(), response.Message)
+		assert.Equal(t, "req-1", response.RequestID)
+		assert.Zero(t, logs.Len())
+	})
+
+	t.Run("Details", func(t *testing.T) {
+		router, _ := newErrorTestRouter(func(c *gin.Context) {
+			quota := errors.WithDetails(errors.ErrReceptionQuotaExceeded, map[string]string{"maxItems": "10", "itemsCount": "10", "requested": "1"})
+			Error(c, errors.WithDetails(errors.Wrap(quota, "products[3]"), map[string]string{"index": "3"}))
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", nil))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		response := decodeError(t, w)
+		assert.Equal(t, "RECEPTION_QUOTA_EXCEEDED", response.Code)
+		assert.Equal(t, map[string]string{"index": "3", "maxItems": "10", "itemsCount": "10", "requested": "1"}, response.Details)
+	})
+
+	t.Run("Internal Error", func(t *testing.T) {
+		router, logs := newErrorTestRouter(func(c *gin.Context) {
+			Error(c, stderrors.New("pq: connection refused"))
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		response := decodeError(t, w)
+		assert.Equal(t, "INTERNAL", response.Code)
+		assert.Equal(t, "internal server error", response.Message)
+		assert.NotEmpty(t, response.RequestID)
+		assert.Equal(t, response.RequestID, w.Header().Get(requestIDHeader))
+		assert.Equal(t, 1, logs.FilterField(zap.String("request_id", response.RequestID)).Len())
+	})
+}
+
+func TestBadRequest(t *testing.T) {
+	type request struct {
+		Type  string `json:"type" binding:"required"`
+		Count int    `json:"count"`
+	}
+
+	router, _ := newErrorTestRouter(func(c *gin.Context) {
+		var req request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			BadRequest(c, err)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name    string
+		body    string
+		details map[string]string
+	}{
+		{"Missing Field", `{}`, map[string]string{"type": "required"}},
+		{"Wrong Type", `{"type":"a","count":"x"}`, map[string]string{"count": "type"}},
+		{"Malformed JSON", `{`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(tt.body)))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			response := decodeError(t, w)
+			assert.Equal(t, codeBadRequest, response.Code)
+			assert.NotEmpty(t, response.Message)
+			assert.Equal(t, tt.details, response.Details)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader помечает ответ, который вернули из сохраненного, не выполняя запрос
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency выполняет изменяющий запрос с заголовком Idempotency-Key один раз.
+// Повтор с тем же ключом и телом получает сохраненный ответ, с другим телом - 422.
+// Ответы с ошибкой не сохраняются, чтобы запрос можно было повторить.
+// Ключи действуют в пределах пользователя, поэтому middleware ставится после Authenticate.
+func Idempotency(idempotencyUseCase usecase.IdempotencyUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotencyKeyHeader)
+		if key == "" || !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		user, err := GetUser(c)
+		if err != nil {
+			Error(c, err)
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			BadRequest(c, err)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		requestHash := models.HashIdempotentRequest(c.Request.Method, c.Request.URL.RequestURI(), body)
+		record, err := idempotencyUseCase.Begin(ctx, user.ID, key, requestHash)
+		if err != nil {
+			Error(c, err)
+			return
+		}
+
+		if record.IsCompleted() {
+			c.Header(idempotentReplayedHeader, "true")
+			c.Data(record.StatusCode, gin.MIMEJSON, record.Response)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// Клиент мог отключиться, не дождавшись ответа, но результат все равно нужно сохранить для повтора.
+		// Ошибка сохранения не меняет уже отправленный ответ.
+		ctx = context.WithoutCancel(ctx)
+		status := recorder.Status()
+		if status >= http.StatusOK && status < http.StatusMultipleChoices {
+			_ = idempotencyUseCase.Complete(ctx, record, status, recorder.body.Bytes())
+			return
+		}
+		_ = idempotencyUseCase.Release(ctx, record)
+	}
+}
+
+func isMutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// responseRecorder копирует тело ответа, чтобы сохранить его для повторов
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIdempotencyUseCase := mock_usecase.NewMockIdempotencyUseCase(ctrl)
+	user := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+
+	calls := 0
+	failing := false
+	router := gin.New()
+	router.POST("/products", func(c *gin.Context) {
+		c.Set(userCtx, user)
+	}, Idempotency(mockIdempotencyUseCase), func(c *gin.Context) {
+		calls++
+		if failing {
+			Error(c, errors.ErrPVZFull)
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"id": "product-1"})
+	})
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewBufferString(body))
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	body := `{"type":"обувь"}`
+	requestHash := models.HashIdempotentRequest(http.MethodPost, "/products", []byte(body))
+
+	t.Run("First request is executed and stored", func(t *testing.T) {
+		record := models.NewIdempotencyRecord(user.ID, "scan-42", requestHash, time.Minute)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", requestHash).Return(record, nil)
+		mockIdempotencyUseCase.EXPECT().Complete(gomock.Any(), record, http.StatusCreated, []byte(`{"id":"product-1"}`)).Return(nil)
+
+		w := send("scan-42", body)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("Replay returns stored response", func(t *testing.T) {
+		record := models.NewIdempotencyRecord(user.ID, "scan-42", requestHash, time.Minute)
+		record.Complete(http.StatusCreated, []byte(`{"id":"product-1"}`), time.Hour)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", requestHash).Return(record, nil)
+
+		w := send("scan-42", body)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"id":"product-1"}`, w.Body.String())
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Key reused with another payload", func(t *testing.T) {
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-42", gomock.Any()).Return(nil, errors.ErrIdempotencyKeyReused)
+
+		w := send("scan-42", `{"type":"одежда"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "IDEMPOTENCY_KEY_REUSED")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Failed request releases key", func(t *testing.T) {
+		failing = true
+		defer func() { failing = false }()
+
+		record := models.NewIdempotencyRecord(user.ID, "scan-43", requestHash, time.Minute)
+		mockIdempotencyUseCase.EXPECT().Begin(gomock.Any(), user.ID, "scan-43", requestHash).Return(record, nil)
+		mockIdempotencyUseCase.EXPECT().Release(gomock.Any(), record).Return(nil)
+
+		w := send("scan-43", body)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Request without key", func(t *testing.T) {
+		w := send("", body)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 3, calls)
+	})
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	requestIDCtx    = "requestId"
+)
+
+// RequestID присваивает запросу идентификатор из заголовка X-Request-ID или генерирует новый
+// и возвращает его в одноименном заголовке ответа
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(requestIDCtx, requestID)
+		c.Header(requestIDHeader, requestID)
+		c.Next()
+	}
+}
+
+// GetRequestID возвращает идентификатор текущего запроса
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDCtx)
+}
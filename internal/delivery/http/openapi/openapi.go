@@ -0,0 +1,151 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/logger"
+)
+
+//go:embed openapi.yaml
+var specYAML []byte
+
+// Load разбирает встроенную спецификацию HTTP API и проверяет ее корректность
+func Load() (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+
+	spec, err := loader.LoadFromData(specYAML)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse openapi spec: %w", err)
+	}
+
+	if err := spec.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid openapi spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+// Validator проверяет запросы и ответы HTTP API по спецификации OpenAPI
+type Validator struct {
+	spec   *openapi3.T
+	router routers.Router
+	logger logger.Logger
+}
+
+func NewValidator(logger logger.Logger) (*Validator, error) {
+	spec, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openapi router: %w", err)
+	}
+
+	return &Validator{
+		spec:   spec,
+		router: router,
+		logger: logger,
+	}, nil
+}
+
+// SpecHandler отдает спецификацию в формате JSON
+func (v *Validator) SpecHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, v.spec)
+	}
+}
+
+// Middleware отклоняет запросы, не соответствующие спецификации, с кодом 400.
+// Ответы, не соответствующие спецификации, не изменяются, а записываются в лог как ошибка.
+// Авторизация проверяется отдельным middleware, поэтому схемы безопасности здесь не проверяются.
+func (v *Validator) Middleware() gin.HandlerFunc {
+	options := &openapi3filter.Options{
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(c *gin.Context) {
+		route, pathParams, err := v.findRoute(c.Request)
+		if err != nil {
+			// Маршруты вне спецификации (например, /openapi.json) не проверяются
+			c.Next()
+			return
+		}
+
+		requestInput := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), requestInput); err != nil {
+			middleware.BadRequest(c, err)
+			return
+		}
+
+		writer := &bodyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		responseInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: requestInput,
+			Status:                 writer.Status(),
+			Header:                 writer.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(writer.buf.Bytes())),
+			Options:                &openapi3filter.Options{IncludeResponseStatus: true},
+		}
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), responseInput); err != nil {
+			v.logger.Error("response does not match openapi spec",
+				zap.String("method", c.Request.Method),
+				zap.String("path", route.Path),
+				zap.Int("status", writer.Status()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// findRoute ищет операцию спецификации для запроса.
+// Завершающий слеш игнорируется, так как gin регистрирует группы вида /pvz/.
+func (v *Validator) findRoute(req *http.Request) (*routers.Route, map[string]string, error) {
+	if path := req.URL.Path; len(path) > 1 && strings.HasSuffix(path, "/") {
+		trimmed := req.Clone(req.Context())
+		trimmed.URL.Path = strings.TrimSuffix(path, "/")
+		trimmed.URL.RawPath = ""
+		return v.router.FindRoute(trimmed)
+	}
+
+	return v.router.FindRoute(req)
+}
+
+// bodyWriter сохраняет копию тела ответа для проверки по спецификации
+type bodyWriter struct {
+	gin.ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *bodyWriter) Write(data []byte) (int, error) {
+	w.buf.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyWriter) WriteString(s string) (int, error) {
+	w.buf.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,280 @@
+package openapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/handler"
+	"github.com/smthjapanese/avito_pvz/internal/delivery/http/middleware"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	mock_usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase/mock"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
+	"github.com/smthjapanese/avito_pvz/internal/usecase"
+)
+
+type testAPI struct {
+	router           *gin.Engine
+	pvzUseCase       *mock_usecase.MockPVZUseCase
+	receptionUseCase *mock_usecase.MockReceptionUseCase
+	productUseCase   *mock_usecase.MockProductUseCase
+	catalogUseCase   *mock_usecase.MockCatalogUseCase
+	userUseCase      *mock_usecase.MockUserUseCase
+	logs             *observer.ObservedLogs
+}
+
+// newTestAPI собирает роутер так же, как приложение: валидатор после аутентификации, перед обработчиками
+func newTestAPI(t *testing.T) *testAPI {
+	ctrl := gomock.NewController(t)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	log := zap.New(core)
+
+	api := &testAPI{
+		pvzUseCase:       mock_usecase.NewMockPVZUseCase(ctrl),
+		receptionUseCase: mock_usecase.NewMockReceptionUseCase(ctrl),
+		productUseCase:   mock_usecase.NewMockProductUseCase(ctrl),
+		catalogUseCase:   mock_usecase.NewMockCatalogUseCase(ctrl),
+		userUseCase:      mock_usecase.NewMockUserUseCase(ctrl),
+		logs:             logs,
+	}
+
+	validator, err := NewValidator(log)
+	require.NoError(t, err)
+
+	api.router = gin.New()
+	api.router.GET("/openapi.json", validator.SpecHandler())
+	api.router.Use(middleware.RequestID())
+
+	useCases := &usecase.UseCases{
+		PVZ:       api.pvzUseCase,
+		Reception: api.receptionUseCase,
+		Product:   api.productUseCase,
+		Catalog:   api.catalogUseCase,
+		User:      api.userUseCase,
+	}
+	handler.NewHandler(useCases, log, metrics.NewMockMetrics()).Init(api.router, validator.Middleware())
+
+	return api
+}
+
+func (api *testAPI) do(method, path, token string, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	api.router.ServeHTTP(w, req)
+	return w
+}
+
+func (api *testAPI) specErrors() int {
+	return api.logs.FilterMessage("response does not match openapi spec").Len()
+}
+
+func TestLoad(t *testing.T) {
+	spec, err := Load()
+	require.NoError(t, err)
+	assert.NotEmpty(t, spec.Paths.Map())
+}
+
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+// Каждый маршрут из Handler.Init описан в спецификации, и в спецификации нет лишних маршрутов
+func TestSpec_CoversAllRoutes(t *testing.T) {
+	api := newTestAPI(t)
+	spec, err := Load()
+	require.NoError(t, err)
+
+	registered := make(map[string]bool)
+	for _, route := range api.router.Routes() {
+		if route.Path == "/openapi.json" {
+			continue
+		}
+
+		path := ginParam.ReplaceAllString(route.Path, "{$1}")
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		registered[route.Method+" "+path] = true
+
+		pathItem := spec.Paths.Value(path)
+		require.NotNil(t, pathItem, "path %s is missing in openapi spec", path)
+		assert.NotNil(t, pathItem.GetOperation(route.Method), "operation %s %s is missing in openapi spec", route.Method, path)
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method := range pathItem.Operations() {
+			assert.True(t, registered[method+" "+path], "operation %s %s is not registered in router", method, path)
+		}
+	}
+}
+
+func TestValidator_SpecHandler(t *testing.T) {
+	api := newTestAPI(t)
+
+	w := api.do(http.MethodGet, "/openapi.json", "", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"openapi":"3.0.3"`)
+}
+
+func TestValidator_Middleware(t *testing.T) {
+	api := newTestAPI(t)
+
+	moderator := &models.User{ID: uuid.New(), Role: models.ModeratorRole}
+	employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole}
+	api.userUseCase.EXPECT().ValidateToken(gomock.Any(), "moderator_token").Return(moderator, nil).AnyTimes()
+	api.userUseCase.EXPECT().ValidateToken(gomock.Any(), "employee_token").Return(employee, nil).AnyTimes()
+
+	t.Run("Valid Request", func(t *testing.T) {
+		pvz := models.NewPVZ(models.CityKazan)
+		api.pvzUseCase.EXPECT().Create(gomock.Any(), domainUsecase.PVZInput{City: models.CityKazan}).Return(pvz, nil)
+
+		w := api.do(http.MethodPost, "/pvz/", "moderator_token", `{"city":"Казань"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Zero(t, api.specErrors())
+	})
+
+	t.Run("City Outside Catalog", func(t *testing.T) {
+		// Города не перечислены в спецификации, их проверяет справочник
+		api.pvzUseCase.EXPECT().Create(gomock.Any(), domainUsecase.PVZInput{City: models.City("Париж")}).Return(nil, errors.ErrInvalidCity)
+
+		w := api.do(http.MethodPost, "/pvz/", "moderator_token", `{"city":"Париж"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"INVALID_CITY"`)
+		assert.Zero(t, api.specErrors())
+	})
+
+	t.Run("Invalid Enum", func(t *testing.T) {
+		w := api.do(http.MethodGet, "/api/v1/catalogs/countries", "employee_token", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Catalog", func(t *testing.T) {
+		api.catalogUseCase.EXPECT().List(gomock.Any(), models.CatalogProductTypes).
+			Return([]*models.CatalogEntry{models.NewCatalogEntry(string(models.ProductTypeShoes))}, nil)
+
+		w := api.do(http.MethodGet, "/api/v1/catalogs/product-types", "employee_token", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Zero(t, api.specErrors())
+
+		w = api.do(http.MethodPost, "/api/v1/catalogs/product-types", "employee_token", `{"name":"мебель"}`)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Zero(t, api.specErrors())
+	})
+
+	t.Run("Nearest", func(t *testing.T) {
+		pvz := models.NewPVZ(models.CityMoscow)
+		pvz.Address = "ул. Тверская, 1"
+		pvz.Coordinates = &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137}
+		point := models.Coordinates{Latitude: 55.75, Longitude: 37.61}
+		api.pvzUseCase.EXPECT().ListNearest(gomock.Any(), point, 5000.0, 10).
+			Return([]*models.NearbyPVZ{{PVZ: pvz, Distance: 872.5}}, nil)
+
+		w := api.do(http.MethodGet, "/api/v1/pvz/nearest?lat=55.75&lon=37.61", "employee_token", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Zero(t, api.specErrors())
+
+		w = api.do(http.MethodGet, "/api/v1/pvz/nearest?lat=91&lon=37.61", "employee_token", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Missing Required Field", func(t *testing.T) {
+		w := api.do(http.MethodPost, "/products", "employee_token", `{"type":"обувь"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Invalid Path Parameter", func(t *testing.T) {
+		w := api.do(http.MethodPost, "/pvz/invalid-uuid/close_last_reception", "employee_token", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Invalid Query Parameter", func(t *testing.T) {
+		w := api.do(http.MethodGet, "/pvz/?limit=100", "employee_token", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Versioned Route", func(t *testing.T) {
+		pvzID := uuid.New()
+		product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+		api.productUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ProductInput{Type: models.ProductTypeShoes}).Return(product, nil)
+
+		w := api.do(http.MethodPost, "/api/v1/pvz/"+pvzID.String()+"/reception/product", "employee_token", `{"type":"обувь"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Zero(t, api.specErrors())
+
+		w = api.do(http.MethodPost, "/api/v1/pvz/"+pvzID.String()+"/reception/product", "employee_token", `{}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		// Токен проверяется раньше тела запроса
+		w := api.do(http.MethodPost, "/receptions", "", `{"pvzId":"`+uuid.New().String()+`"}`)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = api.do(http.MethodPost, "/api/v1/pvz/invalid-uuid/reception/product", "", `{}`)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Domain Error Response", func(t *testing.T) {
+		pvzID := uuid.New()
+		api.receptionUseCase.EXPECT().Create(gomock.Any(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound}).Return(nil, errors.ErrPVZNotFound)
+
+		w := api.do(http.MethodPost, "/api/v1/pvz/"+pvzID.String()+"/reception", "employee_token", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"PVZ_NOT_FOUND"`)
+		assert.Contains(t, w.Body.String(), `"requestId":"`+w.Header().Get("X-Request-ID")+`"`)
+		assert.Zero(t, api.specErrors())
+	})
+
+	t.Run("Public Route", func(t *testing.T) {
+		api.userUseCase.EXPECT().DummyLogin(gomock.Any(), models.EmployeeRole, gomock.Any()).Return("token", nil)
+
+		w := api.do(http.MethodPost, "/dummyLogin", "", `{"role":"employee"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Zero(t, api.specErrors())
+	})
+}
+
+func TestValidator_InvalidResponse(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	validator, err := NewValidator(zap.New(core))
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(validator.Middleware())
+	router.POST("/dummyLogin", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"unexpected": true})
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/dummyLogin", bytes.NewBufferString(`{"role":"employee"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Ответ не изменяется, расхождение попадает в лог
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, logs.FilterMessage("response does not match openapi spec").Len())
+}
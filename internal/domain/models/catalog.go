@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// CatalogKind - вид справочника
+type CatalogKind string
+
+const (
+	CatalogCities       CatalogKind = "cities"
+	CatalogProductTypes CatalogKind = "product-types"
+)
+
+// CatalogEntry - значение справочника.
+// Выведенное из оборота значение (Active = false) нельзя выбрать для новых записей,
+// но оно остается в справочнике, чтобы исторические данные оставались корректными.
+type CatalogEntry struct {
+	Name      string    `json:"name"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewCatalogEntry(name string) *CatalogEntry {
+	now := time.Now()
+	return &CatalogEntry{
+		Name:      name,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func IsValidCatalogKind(kind CatalogKind) bool {
+	return kind == CatalogCities || kind == CatalogProductTypes
+}
+
+// maxCatalogNameLength ограничивает длину значения справочника, как в схеме БД
+const maxCatalogNameLength = 64
+
+// IsValidCatalogName проверяет название города или категории
+func IsValidCatalogName(name string) bool {
+	if name == "" || utf8.RuneCountInString(name) > maxCatalogNameLength {
+		return false
+	}
+	for _, r := range name {
+		if r < ' ' || r == 0x7f {
+			return false
+		}
+	}
+	return name[0] != ' ' && name[len(name)-1] != ' '
+}
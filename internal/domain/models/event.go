@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PVZEventType string
+
+const (
+	PVZEventReceptionOpened      PVZEventType = "reception_opened"
+	PVZEventProductAdded         PVZEventType = "product_added"
+	PVZEventProductDeleted       PVZEventType = "product_deleted"
+	PVZEventReceptionClosed      PVZEventType = "reception_closed"
+	PVZEventReceptionReopened    PVZEventType = "reception_reopened"
+	PVZEventProductStatusChanged PVZEventType = "product_status_changed"
+	// PVZEventNearlyFull - ПВЗ заполнился до порога NearlyFullPercent
+	PVZEventNearlyFull PVZEventType = "pvz_nearly_full"
+)
+
+// PVZEvent описывает изменение приемки, товаров или заполненности ПВЗ
+type PVZEvent struct {
+	Type       PVZEventType `json:"type"`
+	PVZID      uuid.UUID    `json:"pvz_id"`
+	City       City         `json:"city"`
+	PVZ        *PVZ         `json:"pvz,omitempty"`
+	Reception  *Reception   `json:"reception,omitempty"`
+	Product    *Product     `json:"product,omitempty"`
+	OccurredAt time.Time    `json:"occurred_at"`
+}
+
+// NewReceptionEvent создает событие о приемке. Событие хранит копию приемки:
+// репозиторий дописывает счетчики в исходную структуру уже после публикации.
+func NewReceptionEvent(eventType PVZEventType, pvz *PVZ, reception *Reception) *PVZEvent {
+	snapshot := *reception
+	return &PVZEvent{
+		Type:       eventType,
+		PVZID:      pvz.ID,
+		City:       pvz.City,
+		Reception:  &snapshot,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewProductEvent создает событие о товаре и, как и NewReceptionEvent, хранит его копию.
+func NewProductEvent(eventType PVZEventType, pvz *PVZ, product *Product) *PVZEvent {
+	snapshot := *product
+	return &PVZEvent{
+		Type:       eventType,
+		PVZID:      pvz.ID,
+		City:       pvz.City,
+		Product:    &snapshot,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewPVZEvent создает событие о самом ПВЗ, например о его заполнении.
+// Событие хранит копию ПВЗ, чтобы подписчики не видели последующих изменений.
+func NewPVZEvent(eventType PVZEventType, pvz *PVZ) *PVZEvent {
+	snapshot := *pvz
+	return &PVZEvent{
+		Type:       eventType,
+		PVZID:      pvz.ID,
+		City:       pvz.City,
+		PVZ:        &snapshot,
+		OccurredAt: time.Now(),
+	}
+}
+
+// PVZEventFilter отбирает события по ПВЗ и городу, пустые поля не участвуют в отборе
+type PVZEventFilter struct {
+	PVZID uuid.UUID
+	City  City
+}
+
+func (f PVZEventFilter) Match(event *PVZEvent) bool {
+	if f.PVZID != uuid.Nil && f.PVZID != event.PVZID {
+		return false
+	}
+	if f.City != "" && f.City != event.City {
+		return false
+	}
+	return true
+}
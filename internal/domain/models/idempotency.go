@@ -0,0 +1,78 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxIdempotencyKeyLength ограничивает длину ключа, как в схеме БД
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyRecord - запрос с ключом идемпотентности и ответ на него.
+// Пока запрос выполняется, CompletedAt пуст, а повтор с тем же ключом не выполняется.
+// Запись выполняющегося запроса живет недолго, чтобы ключ не остался занятым, если реплика упала.
+type IdempotencyRecord struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Key         string    `json:"key"`
+	RequestHash string    `json:"request_hash"`
+	// StatusCode - HTTP код ответа, для ответов gRPC не заполняется
+	StatusCode  int        `json:"status_code"`
+	Response    []byte     `json:"response"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+}
+
+// NewIdempotencyRecord резервирует ключ за запросом на время lockTimeout
+func NewIdempotencyRecord(userID uuid.UUID, key, requestHash string, lockTimeout time.Duration) *IdempotencyRecord {
+	now := time.Now()
+	return &IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(lockTimeout),
+	}
+}
+
+// Complete запоминает ответ на выполненный запрос, ответ хранится ttl
+func (r *IdempotencyRecord) Complete(statusCode int, response []byte, ttl time.Duration) {
+	now := time.Now()
+	r.StatusCode = statusCode
+	r.Response = response
+	r.CompletedAt = &now
+	r.ExpiresAt = now.Add(ttl)
+}
+
+// IsCompleted сообщает, что ответ на запрос сохранен и его можно вернуть повтору
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.CompletedAt != nil
+}
+
+// HashIdempotentRequest вычисляет отпечаток запроса: метод, путь и тело.
+// Повтор с тем же ключом должен совпасть с исходным запросом по отпечатку.
+func HashIdempotentRequest(method, target string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(target))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// IsValidIdempotencyKey проверяет ключ из заголовка: непустая строка из видимых ASCII символов
+func IsValidIdempotencyKey(key string) bool {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] <= ' ' || key[i] >= 0x7f {
+			return false
+		}
+	}
+	return true
+}
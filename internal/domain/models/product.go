@@ -8,33 +8,123 @@ import (
 
 type ProductType string
 
+// Категории, которыми заполнен справочник при создании. Актуальный список хранится в БД.
 const (
 	ProductTypeElectronics ProductType = "электроника"
 	ProductTypeClothes     ProductType = "одежда"
 	ProductTypeShoes       ProductType = "обувь"
 )
 
+// ProductStatus - состояние товара в ПВЗ
+type ProductStatus string
+
+const (
+	// ProductStatusAccepted - товар принят в ПВЗ
+	ProductStatusAccepted ProductStatus = "accepted"
+	// ProductStatusReadyForPickup - товар разложен и ждет получателя
+	ProductStatusReadyForPickup ProductStatus = "ready_for_pickup"
+	// ProductStatusIssued - товар выдан получателю
+	ProductStatusIssued ProductStatus = "issued"
+	// ProductStatusRefused - получатель отказался от товара
+	ProductStatusRefused ProductStatus = "refused"
+)
+
+// productTransitions - допустимые переходы между состояниями. Выданный и отказной товар покидают ПВЗ.
+var productTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusAccepted:       {ProductStatusReadyForPickup},
+	ProductStatusReadyForPickup: {ProductStatusIssued, ProductStatusRefused},
+}
+
+// ShelfProductStatuses - состояния товаров, которые физически находятся в ПВЗ
+var ShelfProductStatuses = []ProductStatus{ProductStatusAccepted, ProductStatusReadyForPickup}
+
+func IsValidProductStatus(status ProductStatus) bool {
+	return status == ProductStatusAccepted || status == ProductStatusReadyForPickup ||
+		status == ProductStatusIssued || status == ProductStatusRefused
+}
+
+// IsShelfProductStatus сообщает, находится ли товар в этом состоянии в ПВЗ
+func IsShelfProductStatus(status ProductStatus) bool {
+	for _, shelf := range ShelfProductStatuses {
+		if shelf == status {
+			return true
+		}
+	}
+	return false
+}
+
 type Product struct {
 	ID          uuid.UUID   `json:"id"`
 	DateTime    time.Time   `json:"date_time"`
 	Type        ProductType `json:"type"`
 	ReceptionID uuid.UUID   `json:"reception_id"`
-	CreatedAt   time.Time   `json:"created_at"`
+	// OrderID - внешний номер заказа или отправления, к которому относится товар
+	OrderID         string        `json:"order_id"`
+	Barcode         string        `json:"barcode"`
+	Status          ProductStatus `json:"status"`
+	StatusChangedAt time.Time     `json:"status_changed_at"`
+	// OriginalProductID и ReturnReason заполняются у товаров, возвращенных получателем
+	OriginalProductID *uuid.UUID `json:"original_product_id"`
+	ReturnReason      string     `json:"return_reason"`
+	// CellID - ячейка хранения, куда положили товар. Пусто, если у ПВЗ нет схемы хранения.
+	CellID    *uuid.UUID `json:"cell_id"`
+	CreatedAt time.Time  `json:"created_at"`
 }
 
 func NewProduct(productType ProductType, receptionID uuid.UUID) *Product {
 	now := time.Now()
 	return &Product{
-		ID:          uuid.New(),
-		DateTime:    now,
-		Type:        productType,
-		ReceptionID: receptionID,
-		CreatedAt:   now,
+		ID:              uuid.New(),
+		DateTime:        now,
+		Type:            productType,
+		ReceptionID:     receptionID,
+		Status:          ProductStatusAccepted,
+		StatusChangedAt: now,
+		CreatedAt:       now,
+	}
+}
+
+// CanTransitionTo проверяет, можно ли перевести товар в состояние status
+func (p *Product) CanTransitionTo(status ProductStatus) bool {
+	for _, allowed := range productTransitions[p.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStatus переводит товар в новое состояние без проверки перехода
+func (p *Product) SetStatus(status ProductStatus) {
+	p.Status = status
+	p.StatusChangedAt = time.Now()
+}
+
+// MaxProductBatchSize ограничивает число товаров, добавляемых одним запросом
+const MaxProductBatchSize = 100
+
+// IsValidProductBatchSize проверяет размер пачки товаров, пустая пачка не допускается
+func IsValidProductBatchSize(size int) bool {
+	return size > 0 && size <= MaxProductBatchSize
+}
+
+// maxProductIdentifierLength ограничивает длину номера заказа и штрихкода, как в схеме БД
+const maxProductIdentifierLength = 64
+
+// IsValidProductIdentifier проверяет номер заказа или штрихкод. Пустое значение допустимо.
+func IsValidProductIdentifier(value string) bool {
+	if len(value) > maxProductIdentifierLength {
+		return false
+	}
+	for _, r := range value {
+		if r <= ' ' || r == 0x7f {
+			return false
+		}
 	}
+	return true
 }
 
-func IsValidProductType(productType ProductType) bool {
-	return productType == ProductTypeElectronics ||
-		productType == ProductTypeClothes ||
-		productType == ProductTypeShoes
+// IsValidReturnReason проверяет причину возврата товара получателем, она обязательна
+func IsValidReturnReason(reason string) bool {
+	return reason != "" && IsValidPVZText(reason)
 }
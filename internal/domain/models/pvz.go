@@ -2,23 +2,70 @@ package models
 
 import (
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
 type City string
 
+// Города, которыми заполнен справочник при создании. Актуальный список хранится в БД.
 const (
 	CityMoscow          City = "Москва"
 	CitySaintPetersburg City = "Санкт-Петербург"
 	CityKazan           City = "Казань"
 )
 
+// PVZStatus - статус ПВЗ
+type PVZStatus string
+
+const (
+	PVZStatusActive    PVZStatus = "active"
+	PVZStatusSuspended PVZStatus = "suspended"
+	PVZStatusArchived  PVZStatus = "archived"
+)
+
+// pvzTransitions - допустимые переходы между статусами. Из архива ПВЗ не возвращается.
+var pvzTransitions = map[PVZStatus][]PVZStatus{
+	PVZStatusActive:    {PVZStatusSuspended, PVZStatusArchived},
+	PVZStatusSuspended: {PVZStatusActive, PVZStatusArchived},
+}
+
+func IsValidPVZStatus(status PVZStatus) bool {
+	return status == PVZStatusActive || status == PVZStatusSuspended || status == PVZStatusArchived
+}
+
+// EarthRadiusMeters - средний радиус Земли, используется при расчете расстояний
+const EarthRadiusMeters = 6371000
+
+// Coordinates - широта и долгота точки в градусах
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (c Coordinates) IsValid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
 type PVZ struct {
 	ID               uuid.UUID `json:"id"`
 	RegistrationDate time.Time `json:"registration_date"`
 	City             City      `json:"city"`
-	CreatedAt        time.Time `json:"created_at"`
+	Address          string    `json:"address"`
+	// Coordinates не заданы у ПВЗ, созданных до появления адресов
+	Coordinates  *Coordinates `json:"coordinates"`
+	WorkingHours string       `json:"working_hours"`
+	Phone        string       `json:"phone"`
+	Status       PVZStatus    `json:"status"`
+	// StatusReason - причина приостановки или архивации, указанная модератором
+	StatusReason    string    `json:"status_reason"`
+	StatusChangedAt time.Time `json:"status_changed_at"`
+	// Capacity - сколько товаров помещается в ПВЗ, 0 - без ограничения.
+	// Occupied - сколько товаров сейчас находится в ПВЗ.
+	Capacity  int       `json:"capacity"`
+	Occupied  int       `json:"occupied"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 func NewPVZ(city City) *PVZ {
@@ -27,10 +74,103 @@ func NewPVZ(city City) *PVZ {
 		ID:               uuid.New(),
 		RegistrationDate: now,
 		City:             city,
+		Status:           PVZStatusActive,
+		StatusChangedAt:  now,
 		CreatedAt:        now,
 	}
 }
 
-func IsValidCity(city City) bool {
-	return city == CityMoscow || city == CitySaintPetersburg || city == CityKazan
+// IsActive сообщает, принимает ли ПВЗ новые приемки и товары
+func (p *PVZ) IsActive() bool {
+	return p.Status == PVZStatusActive
+}
+
+// CanTransitionTo проверяет, можно ли перевести ПВЗ в статус status
+func (p *PVZ) CanTransitionTo(status PVZStatus) bool {
+	for _, allowed := range pvzTransitions[p.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStatus переводит ПВЗ в новый статус без проверки перехода
+func (p *PVZ) SetStatus(status PVZStatus, reason string) {
+	p.Status = status
+	p.StatusReason = reason
+	p.StatusChangedAt = time.Now()
+}
+
+// IsFull сообщает, что в ПВЗ с ограниченной вместимостью не осталось места
+func (p *PVZ) IsFull() bool {
+	return p.Capacity > 0 && p.Occupied >= p.Capacity
+}
+
+// IsNearlyFull сообщает, что ПВЗ заполнен до порога NearlyFullPercent
+func (p *PVZ) IsNearlyFull() bool {
+	return isNearlyFull(p.Occupied, p.Capacity)
+}
+
+// ReachedNearlyFull сообщает, что последние added принятых товаров довели ПВЗ до порога.
+// Сигнал о заполнении отправляется один раз, а не на каждый следующий товар.
+func (p *PVZ) ReachedNearlyFull(added int) bool {
+	return p.IsNearlyFull() && !isNearlyFull(p.Occupied-added, p.Capacity)
+}
+
+func isNearlyFull(occupied, capacity int) bool {
+	return capacity > 0 && occupied*100 >= capacity*NearlyFullPercent
+}
+
+// NearlyFullPercent - заполненность в процентах, с которой логистика перестает везти товары в ПВЗ
+const NearlyFullPercent = 90
+
+// MaxPVZCapacity ограничивает вместимость ПВЗ сверху
+const MaxPVZCapacity = 100000
+
+// IsValidPVZCapacity проверяет вместимость ПВЗ, 0 означает отсутствие ограничения
+func IsValidPVZCapacity(capacity int) bool {
+	return capacity >= 0 && capacity <= MaxPVZCapacity
+}
+
+// NearbyPVZ - ПВЗ и расстояние до него в метрах
+type NearbyPVZ struct {
+	PVZ      *PVZ
+	Distance float64
+}
+
+// maxPVZTextLength ограничивает длину адреса и режима работы, как в схеме БД
+const maxPVZTextLength = 255
+
+// IsValidPVZText проверяет адрес или режим работы ПВЗ. Пустое значение допустимо.
+func IsValidPVZText(value string) bool {
+	if utf8.RuneCountInString(value) > maxPVZTextLength {
+		return false
+	}
+	for _, r := range value {
+		if r < ' ' || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidPhone проверяет телефон в формате E.164: необязательный "+" и от 10 до 15 цифр.
+// Пустое значение допустимо.
+func IsValidPhone(phone string) bool {
+	if phone == "" {
+		return true
+	}
+	if phone[0] == '+' {
+		phone = phone[1:]
+	}
+	if len(phone) < 10 || len(phone) > 15 {
+		return false
+	}
+	for _, r := range phone {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PVZAssignment закрепляет сотрудника за ПВЗ.
+// Сотрудник работает с приемками и товарами только тех ПВЗ, за которыми закреплен.
+type PVZAssignment struct {
+	UserID    uuid.UUID `json:"user_id"`
+	PVZID     uuid.UUID `json:"pvz_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewPVZAssignment(userID, pvzID uuid.UUID) *PVZAssignment {
+	return &PVZAssignment{
+		UserID:    userID,
+		PVZID:     pvzID,
+		CreatedAt: time.Now(),
+	}
+}
@@ -13,12 +13,46 @@ const (
 	ReceptionStatusClose      ReceptionStatus = "close"
 )
 
+// ReceptionKind - откуда поступают товары приемки
+type ReceptionKind string
+
+const (
+	// ReceptionKindInbound - поставка от перевозчика
+	ReceptionKindInbound ReceptionKind = "inbound"
+	// ReceptionKindCustomerReturn - возвраты от получателей
+	ReceptionKindCustomerReturn ReceptionKind = "customer_return"
+)
+
+func IsValidReceptionKind(kind ReceptionKind) bool {
+	return kind == ReceptionKindInbound || kind == ReceptionKindCustomerReturn
+}
+
+// ReceptionCloseReason - почему приемка закрыта не сотрудником
+type ReceptionCloseReason string
+
+// ReceptionCloseReasonTimedOut - в приемку долго не добавляли товары
+const ReceptionCloseReasonTimedOut ReceptionCloseReason = "timed_out"
+
+// SystemActor закрывает приемки от имени сервиса, а не сотрудника
+const SystemActor = "system"
+
 type Reception struct {
-	ID        uuid.UUID       `json:"id"`
-	DateTime  time.Time       `json:"date_time"`
-	PVZID     uuid.UUID       `json:"pvz_id"`
-	Status    ReceptionStatus `json:"status"`
-	CreatedAt time.Time       `json:"created_at"`
+	ID       uuid.UUID       `json:"id"`
+	DateTime time.Time       `json:"date_time"`
+	PVZID    uuid.UUID       `json:"pvz_id"`
+	Status   ReceptionStatus `json:"status"`
+	Kind     ReceptionKind   `json:"kind"`
+	// ReopenReason и ReopenedAt заполняются, если модератор открыл приемку повторно
+	ReopenReason string     `json:"reopen_reason"`
+	ReopenedAt   *time.Time `json:"reopened_at"`
+	// MaxItems - сколько товаров можно принять в приемку, 0 - без ограничения
+	MaxItems int `json:"max_items"`
+	// ItemsCount - сколько товаров сейчас в приемке
+	ItemsCount int `json:"items_count"`
+	// ClosedBy и CloseReason заполняются, если приемку закрыл сервис
+	ClosedBy    string               `json:"closed_by"`
+	CloseReason ReceptionCloseReason `json:"close_reason"`
+	CreatedAt   time.Time            `json:"created_at"`
 }
 
 func NewReception(pvzID uuid.UUID) *Reception {
@@ -28,14 +62,50 @@ func NewReception(pvzID uuid.UUID) *Reception {
 		DateTime:  now,
 		PVZID:     pvzID,
 		Status:    ReceptionStatusInProgress,
+		Kind:      ReceptionKindInbound,
 		CreatedAt: now,
 	}
 }
 
 func (r *Reception) Close() {
 	r.Status = ReceptionStatusClose
+	r.ClosedBy = ""
+	r.CloseReason = ""
+}
+
+// IsTimedOut сообщает, что приемку закрыл сервис из-за отсутствия товаров
+func (r *Reception) IsTimedOut() bool {
+	return r.CloseReason == ReceptionCloseReasonTimedOut
+}
+
+// Reopen снова открывает закрытую приемку и запоминает причину
+func (r *Reception) Reopen(reason string) {
+	now := time.Now()
+	r.Status = ReceptionStatusInProgress
+	r.ClosedBy = ""
+	r.CloseReason = ""
+	r.ReopenReason = reason
+	r.ReopenedAt = &now
 }
 
 func (r *Reception) IsInProgress() bool {
 	return r.Status == ReceptionStatusInProgress
 }
+
+// IsCustomerReturn сообщает, принимает ли приемка возвраты от получателей
+func (r *Reception) IsCustomerReturn() bool {
+	return r.Kind == ReceptionKindCustomerReturn
+}
+
+// MaxReceptionItems ограничивает квоту приемки сверху
+const MaxReceptionItems = 10000
+
+// IsValidReceptionMaxItems проверяет квоту приемки, 0 означает отсутствие ограничения
+func IsValidReceptionMaxItems(maxItems int) bool {
+	return maxItems >= 0 && maxItems <= MaxReceptionItems
+}
+
+// IsValidReopenReason проверяет причину повторного открытия приемки, она обязательна
+func IsValidReopenReason(reason string) bool {
+	return reason != "" && IsValidPVZText(reason)
+}
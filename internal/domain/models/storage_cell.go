@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// StorageCell - ячейка хранения ПВЗ: стеллаж, полка на нем и номер ячейки на полке.
+// Occupied - число товаров, которые сейчас лежат в ячейке.
+type StorageCell struct {
+	ID        uuid.UUID `json:"id"`
+	PVZID     uuid.UUID `json:"pvz_id"`
+	Rack      string    `json:"rack"`
+	Shelf     string    `json:"shelf"`
+	Cell      string    `json:"cell"`
+	Capacity  int       `json:"capacity"`
+	Occupied  int       `json:"occupied"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewStorageCell(pvzID uuid.UUID, rack, shelf, cell string, capacity int) *StorageCell {
+	return &StorageCell{
+		ID:        uuid.New(),
+		PVZID:     pvzID,
+		Rack:      rack,
+		Shelf:     shelf,
+		Cell:      cell,
+		Capacity:  capacity,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsFull сообщает, что в ячейку больше нельзя положить товар
+func (c *StorageCell) IsFull() bool {
+	return c.Occupied >= c.Capacity
+}
+
+const (
+	// maxStorageCellPartLength ограничивает длину обозначений стеллажа, полки и ячейки, как в схеме БД
+	maxStorageCellPartLength = 16
+	// MaxStorageCellCapacity - наибольшая вместимость одной ячейки
+	MaxStorageCellCapacity = 1000
+)
+
+// IsValidStorageCellPart проверяет обозначение стеллажа, полки или ячейки: непустое, без пробелов
+func IsValidStorageCellPart(value string) bool {
+	if value == "" || utf8.RuneCountInString(value) > maxStorageCellPartLength {
+		return false
+	}
+	for _, r := range value {
+		if r <= ' ' || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidStorageCellCapacity проверяет вместимость ячейки в товарах
+func IsValidStorageCellCapacity(capacity int) bool {
+	return capacity > 0 && capacity <= MaxStorageCellCapacity
+}
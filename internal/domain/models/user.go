@@ -15,12 +15,20 @@ const (
 	ModeratorRole UserRole = "moderator"
 )
 
+// DummyEmailPrefix начинает адреса тестовых токенов и недоступен при регистрации
+const DummyEmailPrefix = "dummy_"
+
 type User struct {
 	ID           uuid.UUID `json:"id"`
 	Email        string    `json:"email"`
 	PasswordHash string    `json:"-"` // не включаем в JSON
 	Role         UserRole  `json:"role"`
 	CreatedAt    time.Time `json:"created_at"`
+	// Dummy отмечает пользователя тестового токена, которого нет в БД
+	Dummy bool `json:"-"`
+	// PVZScope - ПВЗ из тестового токена. Назначений у такого пользователя нет,
+	// поэтому доступ к ПВЗ проверяется по этому списку, пустой список не дает доступа ни к одному ПВЗ
+	PVZScope []uuid.UUID `json:"-"`
 }
 
 func NewUser(email string, passwordHash string, role UserRole) *User {
@@ -32,3 +40,13 @@ func NewUser(email string, passwordHash string, role UserRole) *User {
 		CreatedAt:    time.Now(),
 	}
 }
+
+// InPVZScope сообщает, что ПВЗ входит в список тестового токена
+func (u *User) InPVZScope(pvzID uuid.UUID) bool {
+	for _, id := range u.PVZScope {
+		if id == pvzID {
+			return true
+		}
+	}
+	return false
+}
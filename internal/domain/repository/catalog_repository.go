@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// CatalogRepository представляет интерфейс для работы со справочниками городов и категорий товаров
+type CatalogRepository interface {
+	List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error)
+	Create(ctx context.Context, kind models.CatalogKind, entry *models.CatalogEntry) error
+	SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error)
+}
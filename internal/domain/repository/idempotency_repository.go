@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// IdempotencyRepository представляет интерфейс для работы с хранилищем ключей идемпотентности
+type IdempotencyRepository interface {
+	// Reserve сохраняет запрос, если ключ свободен или его запись истекла.
+	// false означает, что ключ занят действующей записью. Время резервирования,
+	// сохраненное в БД, возвращается в record.CreatedAt.
+	Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error)
+	Get(ctx context.Context, userID uuid.UUID, key string) (*models.IdempotencyRecord, error)
+	// Complete сохраняет ответ на зарезервированный запрос. Complete и Delete меняют запись,
+	// только пока ключ занят этой же резервацией (совпадает CreatedAt).
+	Complete(ctx context.Context, record *models.IdempotencyRecord) error
+	// Delete освобождает ключ незавершенного запроса
+	Delete(ctx context.Context, record *models.IdempotencyRecord) error
+	// DeleteExpired удаляет записи, истекшие к before, и возвращает их число
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -10,8 +10,18 @@ import (
 // ProductRepository представляет интерфейс для работы с хранилищем товаров
 type ProductRepository interface {
 	Create(ctx context.Context, product *models.Product) error
+	// CreateBatch сохраняет все товары одним запросом или не сохраняет ни одного
+	CreateBatch(ctx context.Context, products []*models.Product) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Product, error)
 	ListByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]*models.Product, error)
 	GetLastByReceptionID(ctx context.Context, receptionID uuid.UUID) (*models.Product, error)
+	GetByReceptionIDAndBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*models.Product, error)
+	GetLastByBarcode(ctx context.Context, barcode string) (*models.Product, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// UpdateStatus меняет состояние товара, если в БД оно все еще равно from
+	UpdateStatus(ctx context.Context, product *models.Product, from models.ProductStatus) error
+	// ListShelf возвращает товары ПВЗ в указанных состояниях
+	ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error)
+	// ListByCellID возвращает товары ячейки хранения в указанных состояниях
+	ListByCellID(ctx context.Context, cellID uuid.UUID, statuses []models.ProductStatus) ([]*models.Product, error)
 }
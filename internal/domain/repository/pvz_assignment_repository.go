@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// PVZAssignmentRepository представляет интерфейс для работы с назначениями сотрудников в ПВЗ
+type PVZAssignmentRepository interface {
+	Create(ctx context.Context, assignment *models.PVZAssignment) error
+	Delete(ctx context.Context, userID, pvzID uuid.UUID) error
+	// Exists сообщает, что сотрудник закреплен за ПВЗ
+	Exists(ctx context.Context, userID, pvzID uuid.UUID) (bool, error)
+	// ListByPVZID возвращает назначения ПВЗ в порядке их создания
+	ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error)
+}
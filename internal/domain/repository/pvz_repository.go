@@ -12,6 +12,14 @@ import (
 type PVZRepository interface {
 	Create(ctx context.Context, pvz *models.PVZ) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ, error)
-	List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*models.PVZ, error)
-	GetAll(ctx context.Context) ([]*models.PVZ, error)
+	// List и GetAll возвращают архивные ПВЗ, только если includeArchived
+	List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool) ([]*models.PVZ, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error)
+	ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error)
+	UpdateStatus(ctx context.Context, pvz *models.PVZ, from models.PVZStatus) error
+	UpdateCapacity(ctx context.Context, pvz *models.PVZ) error
+	// Occupy занимает место под count товаров и возвращает ErrPVZFull, если все они не помещаются.
+	// Release освобождает место count товаров, покинувших ПВЗ.
+	Occupy(ctx context.Context, pvz *models.PVZ, count int) error
+	Release(ctx context.Context, id uuid.UUID, count int) error
 }
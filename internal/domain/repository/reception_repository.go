@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
@@ -9,10 +10,19 @@ import (
 
 // ReceptionRepository представляет интерфейс для работы с хранилищем приемок
 type ReceptionRepository interface {
+	// Create и Update возвращают ErrOpenReceptionExists, если в ПВЗ уже есть другая открытая приемка
 	Create(ctx context.Context, reception *models.Reception) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Reception, error)
 	GetLastByPVZID(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error)
 	GetLastOpenByPVZID(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error)
 	Update(ctx context.Context, reception *models.Reception) error
-	ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.Reception, error)
+	// AddItems учитывает в открытой приемке count новых товаров и возвращает ErrReceptionQuotaExceeded,
+	// если с ними приемка превысит квоту. RemoveItems вычитает count удаленных товаров.
+	// Обе возвращают ErrReceptionAlreadyClosed, если приемка уже закрыта.
+	AddItems(ctx context.Context, reception *models.Reception, count int) error
+	RemoveItems(ctx context.Context, id uuid.UUID, count int) error
+	// ListByPVZID возвращает приемки ПВЗ указанного вида, пустой kind означает любой вид
+	ListByPVZID(ctx context.Context, pvzID uuid.UUID, kind models.ReceptionKind) ([]*models.Reception, error)
+	// CloseStale закрывает не больше limit открытых приемок без активности после idleSince и возвращает их
+	CloseStale(ctx context.Context, idleSince time.Time, limit uint64) ([]*models.Reception, error)
 }
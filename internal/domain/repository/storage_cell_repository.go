@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// StorageCellRepository представляет интерфейс для работы со схемой хранения ПВЗ
+type StorageCellRepository interface {
+	Create(ctx context.Context, cell *models.StorageCell) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.StorageCell, error)
+	// ListByPVZID возвращает ячейки ПВЗ по порядку стеллажей, полок и ячеек
+	ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error)
+	// Occupy занимает место в ячейке, если она еще не заполнена
+	Occupy(ctx context.Context, id uuid.UUID) error
+	// OccupyFree занимает место в первой незаполненной ячейке ПВЗ и возвращает ее
+	OccupyFree(ctx context.Context, pvzID uuid.UUID) (*models.StorageCell, error)
+	// Release освобождает место в ячейке, когда товар покидает ее
+	Release(ctx context.Context, id uuid.UUID) error
+}
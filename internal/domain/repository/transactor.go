@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// Transactor выполняет изменения нескольких хранилищ атомарно.
+// Хранилища, вызванные с контекстом fn, работают в одной транзакции:
+// если fn вернула ошибку, ни одно из изменений не сохраняется.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+type callerCtxKey struct{}
+
+type systemCallerCtxKey struct{}
+
+// WithCaller сохраняет в контексте пользователя, от имени которого выполняется запрос.
+// Транспорт кладет его после аутентификации, сценарии проверяют по нему доступ к ПВЗ.
+func WithCaller(ctx context.Context, user *models.User) context.Context {
+	return context.WithValue(ctx, callerCtxKey{}, user)
+}
+
+// CallerFromContext возвращает пользователя запроса.
+// У внутренних вызовов, например фоновых задач, пользователя нет.
+func CallerFromContext(ctx context.Context) (*models.User, bool) {
+	user, ok := ctx.Value(callerCtxKey{}).(*models.User)
+	return user, ok && user != nil
+}
+
+// WithSystemCaller помечает контекст доверенного внутреннего вызова сервиса, например фоновой задачи.
+// Без этой метки вызов без пользователя считается неаутентифицированным.
+func WithSystemCaller(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemCallerCtxKey{}, true)
+}
+
+// IsSystemCaller сообщает, что вызов выполняет сам сервис, а не пользователь
+func IsSystemCaller(ctx context.Context) bool {
+	system, _ := ctx.Value(systemCallerCtxKey{}).(bool)
+	return system
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// CatalogUseCase интерфейс бизнес-логики справочников городов и категорий товаров
+type CatalogUseCase interface {
+	List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error)
+	Create(ctx context.Context, kind models.CatalogKind, name string) (*models.CatalogEntry, error)
+	SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error)
+	// IsActive сообщает, можно ли использовать значение в новых записях
+	IsActive(ctx context.Context, kind models.CatalogKind, name string) (bool, error)
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// IdempotencyUseCase запоминает ответы на запросы с ключом идемпотентности,
+// чтобы повтор запроса получил исходный ответ, а действие не выполнилось второй раз
+type IdempotencyUseCase interface {
+	// Begin резервирует ключ пользователя за запросом и возвращает новую запись.
+	// Если запрос с этим ключом уже выполнен, возвращает запись с сохраненным ответом.
+	// Ключ, использованный с другим запросом, отклоняется.
+	Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*models.IdempotencyRecord, error)
+	// Complete сохраняет ответ на зарезервированный запрос
+	Complete(ctx context.Context, record *models.IdempotencyRecord, statusCode int, response []byte) error
+	// Release освобождает ключ запроса, завершившегося ошибкой, чтобы запрос можно было повторить
+	// Ключ, который после истечения резервации занял другой запрос, не освобождается.
+	Release(ctx context.Context, record *models.IdempotencyRecord) error
+	// DeleteExpired удаляет истекшие ключи и возвращает их число
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/usecase/catalog_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/usecase/catalog_usecase.go -destination=internal/domain/usecase/mock/mock_catalog_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogUseCase is a mock of CatalogUseCase interface.
+type MockCatalogUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogUseCaseMockRecorder is the mock recorder for MockCatalogUseCase.
+type MockCatalogUseCaseMockRecorder struct {
+	mock *MockCatalogUseCase
+}
+
+// NewMockCatalogUseCase creates a new mock instance.
+func NewMockCatalogUseCase(ctrl *gomock.Controller) *MockCatalogUseCase {
+	mock := &MockCatalogUseCase{ctrl: ctrl}
+	mock.recorder = &MockCatalogUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogUseCase) EXPECT() *MockCatalogUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCatalogUseCase) Create(ctx context.Context, kind models.CatalogKind, name string) (*models.CatalogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, kind, name)
+	ret0, _ := ret[0].(*models.CatalogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCatalogUseCaseMockRecorder) Create(ctx, kind, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCatalogUseCase)(nil).Create), ctx, kind, name)
+}
+
+// IsActive mocks base method.
+func (m *MockCatalogUseCase) IsActive(ctx context.Context, kind models.CatalogKind, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, kind, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockCatalogUseCaseMockRecorder) IsActive(ctx, kind, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockCatalogUseCase)(nil).IsActive), ctx, kind, name)
+}
+
+// List mocks base method.
+func (m *MockCatalogUseCase) List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, kind)
+	ret0, _ := ret[0].([]*models.CatalogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCatalogUseCaseMockRecorder) List(ctx, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCatalogUseCase)(nil).List), ctx, kind)
+}
+
+// SetActive mocks base method.
+func (m *MockCatalogUseCase) SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, kind, name, active)
+	ret0, _ := ret[0].(*models.CatalogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockCatalogUseCaseMockRecorder) SetActive(ctx, kind, name, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockCatalogUseCase)(nil).SetActive), ctx, kind, name, active)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/usecase/idempotency_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/usecase/idempotency_usecase.go -destination=internal/domain/usecase/mock/mock_idempotency_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyUseCase is a mock of IdempotencyUseCase interface.
+type MockIdempotencyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyUseCaseMockRecorder is the mock recorder for MockIdempotencyUseCase.
+type MockIdempotencyUseCaseMockRecorder struct {
+	mock *MockIdempotencyUseCase
+}
+
+// NewMockIdempotencyUseCase creates a new mock instance.
+func NewMockIdempotencyUseCase(ctrl *gomock.Controller) *MockIdempotencyUseCase {
+	mock := &MockIdempotencyUseCase{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUseCase) EXPECT() *MockIdempotencyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyUseCase) Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*models.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, userID, key, requestHash)
+	ret0, _ := ret[0].(*models.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyUseCaseMockRecorder) Begin(ctx, userID, key, requestHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyUseCase)(nil).Begin), ctx, userID, key, requestHash)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUseCase) Complete(ctx context.Context, record *models.IdempotencyRecord, statusCode int, response []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, record, statusCode, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUseCaseMockRecorder) Complete(ctx, record, statusCode, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUseCase)(nil).Complete), ctx, record, statusCode, response)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIdempotencyUseCase) DeleteExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIdempotencyUseCaseMockRecorder) DeleteExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIdempotencyUseCase)(nil).DeleteExpired), ctx)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyUseCase) Release(ctx context.Context, record *models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyUseCaseMockRecorder) Release(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyUseCase)(nil).Release), ctx, record)
+}
@@ -15,6 +15,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,19 +43,79 @@ func (m *MockProductUseCase) EXPECT() *MockProductUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddToSession mocks base method.
+func (m *MockProductUseCase) AddToSession(ctx context.Context, session *usecase.ScanSession, input usecase.ProductInput) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToSession", ctx, session, input)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToSession indicates an expected call of AddToSession.
+func (mr *MockProductUseCaseMockRecorder) AddToSession(ctx, session, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToSession", reflect.TypeOf((*MockProductUseCase)(nil).AddToSession), ctx, session, input)
+}
+
+// ChangeStatus mocks base method.
+func (m *MockProductUseCase) ChangeStatus(ctx context.Context, pvzID, productID uuid.UUID, status models.ProductStatus) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, pvzID, productID, status)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockProductUseCaseMockRecorder) ChangeStatus(ctx, pvzID, productID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockProductUseCase)(nil).ChangeStatus), ctx, pvzID, productID, status)
+}
+
 // Create mocks base method.
-func (m *MockProductUseCase) Create(ctx context.Context, productType models.ProductType, pvzID uuid.UUID) (*models.Product, error) {
+func (m *MockProductUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.ProductInput) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, productType, pvzID)
+	ret := m.ctrl.Call(m, "Create", ctx, pvzID, input)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockProductUseCaseMockRecorder) Create(ctx, productType, pvzID any) *gomock.Call {
+func (mr *MockProductUseCaseMockRecorder) Create(ctx, pvzID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductUseCase)(nil).Create), ctx, pvzID, input)
+}
+
+// CreateBatch mocks base method.
+func (m *MockProductUseCase) CreateBatch(ctx context.Context, pvzID uuid.UUID, inputs []usecase.ProductInput) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, pvzID, inputs)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockProductUseCaseMockRecorder) CreateBatch(ctx, pvzID, inputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockProductUseCase)(nil).CreateBatch), ctx, pvzID, inputs)
+}
+
+// Delete mocks base method.
+func (m *MockProductUseCase) Delete(ctx context.Context, pvzID, productID uuid.UUID) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, pvzID, productID)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProductUseCaseMockRecorder) Delete(ctx, pvzID, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductUseCase)(nil).Create), ctx, productType, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProductUseCase)(nil).Delete), ctx, pvzID, productID)
 }
 
 // DeleteLastFromReception mocks base method.
@@ -70,3 +131,63 @@ func (mr *MockProductUseCaseMockRecorder) DeleteLastFromReception(ctx, pvzID any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastFromReception", reflect.TypeOf((*MockProductUseCase)(nil).DeleteLastFromReception), ctx, pvzID)
 }
+
+// FindByBarcode mocks base method.
+func (m *MockProductUseCase) FindByBarcode(ctx context.Context, barcode string) (*usecase.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*usecase.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBarcode indicates an expected call of FindByBarcode.
+func (mr *MockProductUseCaseMockRecorder) FindByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBarcode", reflect.TypeOf((*MockProductUseCase)(nil).FindByBarcode), ctx, barcode)
+}
+
+// ListShelf mocks base method.
+func (m *MockProductUseCase) ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShelf", ctx, pvzID, statuses, page, limit)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShelf indicates an expected call of ListShelf.
+func (mr *MockProductUseCaseMockRecorder) ListShelf(ctx, pvzID, statuses, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShelf", reflect.TypeOf((*MockProductUseCase)(nil).ListShelf), ctx, pvzID, statuses, page, limit)
+}
+
+// StartScanSession mocks base method.
+func (m *MockProductUseCase) StartScanSession(ctx context.Context, pvzID uuid.UUID) (*usecase.ScanSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartScanSession", ctx, pvzID)
+	ret0, _ := ret[0].(*usecase.ScanSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartScanSession indicates an expected call of StartScanSession.
+func (mr *MockProductUseCaseMockRecorder) StartScanSession(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartScanSession", reflect.TypeOf((*MockProductUseCase)(nil).StartScanSession), ctx, pvzID)
+}
+
+// UndoInSession mocks base method.
+func (m *MockProductUseCase) UndoInSession(ctx context.Context, session *usecase.ScanSession) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoInSession", ctx, session)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoInSession indicates an expected call of UndoInSession.
+func (mr *MockProductUseCaseMockRecorder) UndoInSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoInSession", reflect.TypeOf((*MockProductUseCase)(nil).UndoInSession), ctx, session)
+}
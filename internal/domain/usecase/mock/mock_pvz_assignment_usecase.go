@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/usecase/pvz_assignment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/usecase/pvz_assignment_usecase.go -destination=internal/domain/usecase/mock/mock_pvz_assignment_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPVZAssignmentUseCase is a mock of PVZAssignmentUseCase interface.
+type MockPVZAssignmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPVZAssignmentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPVZAssignmentUseCaseMockRecorder is the mock recorder for MockPVZAssignmentUseCase.
+type MockPVZAssignmentUseCaseMockRecorder struct {
+	mock *MockPVZAssignmentUseCase
+}
+
+// NewMockPVZAssignmentUseCase creates a new mock instance.
+func NewMockPVZAssignmentUseCase(ctrl *gomock.Controller) *MockPVZAssignmentUseCase {
+	mock := &MockPVZAssignmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockPVZAssignmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPVZAssignmentUseCase) EXPECT() *MockPVZAssignmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockPVZAssignmentUseCase) Assign(ctx context.Context, pvzID, userID uuid.UUID) (*models.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, pvzID, userID)
+	ret0, _ := ret[0].(*models.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockPVZAssignmentUseCaseMockRecorder) Assign(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockPVZAssignmentUseCase)(nil).Assign), ctx, pvzID, userID)
+}
+
+// CheckAccess mocks base method.
+func (m *MockPVZAssignmentUseCase) CheckAccess(ctx context.Context, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockPVZAssignmentUseCaseMockRecorder) CheckAccess(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockPVZAssignmentUseCase)(nil).CheckAccess), ctx, pvzID)
+}
+
+// List mocks base method.
+func (m *MockPVZAssignmentUseCase) List(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pvzID)
+	ret0, _ := ret[0].([]*models.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPVZAssignmentUseCaseMockRecorder) List(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPVZAssignmentUseCase)(nil).List), ctx, pvzID)
+}
+
+// Unassign mocks base method.
+func (m *MockPVZAssignmentUseCase) Unassign(ctx context.Context, pvzID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockPVZAssignmentUseCaseMockRecorder) Unassign(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockPVZAssignmentUseCase)(nil).Unassign), ctx, pvzID, userID)
+}
@@ -44,34 +44,49 @@ func (m *MockPVZUseCase) EXPECT() *MockPVZUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ChangeStatus mocks base method.
+func (m *MockPVZUseCase) ChangeStatus(ctx context.Context, id uuid.UUID, status models.PVZStatus, reason string) (*models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, id, status, reason)
+	ret0, _ := ret[0].(*models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockPVZUseCaseMockRecorder) ChangeStatus(ctx, id, status, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockPVZUseCase)(nil).ChangeStatus), ctx, id, status, reason)
+}
+
 // Create mocks base method.
-func (m *MockPVZUseCase) Create(ctx context.Context, city models.City) (*models.PVZ, error) {
+func (m *MockPVZUseCase) Create(ctx context.Context, input usecase.PVZInput) (*models.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, city)
+	ret := m.ctrl.Call(m, "Create", ctx, input)
 	ret0, _ := ret[0].(*models.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPVZUseCaseMockRecorder) Create(ctx, city any) *gomock.Call {
+func (mr *MockPVZUseCaseMockRecorder) Create(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPVZUseCase)(nil).Create), ctx, city)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPVZUseCase)(nil).Create), ctx, input)
 }
 
 // GetAll mocks base method.
-func (m *MockPVZUseCase) GetAll(ctx context.Context) ([]*models.PVZ, error) {
+func (m *MockPVZUseCase) GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*models.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockPVZUseCaseMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockPVZUseCaseMockRecorder) GetAll(ctx, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPVZUseCase)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPVZUseCase)(nil).GetAll), ctx, includeArchived)
 }
 
 // GetByID mocks base method.
@@ -90,16 +105,46 @@ func (mr *MockPVZUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockPVZUseCase) List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*usecase.PVZWithReceptions, error) {
+func (m *MockPVZUseCase) List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool, kind models.ReceptionKind) ([]*usecase.PVZWithReceptions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, startDate, endDate, page, limit)
+	ret := m.ctrl.Call(m, "List", ctx, startDate, endDate, page, limit, includeArchived, kind)
 	ret0, _ := ret[0].([]*usecase.PVZWithReceptions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockPVZUseCaseMockRecorder) List(ctx, startDate, endDate, page, limit any) *gomock.Call {
+func (mr *MockPVZUseCaseMockRecorder) List(ctx, startDate, endDate, page, limit, includeArchived, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPVZUseCase)(nil).List), ctx, startDate, endDate, page, limit, includeArchived, kind)
+}
+
+// ListNearest mocks base method.
+func (m *MockPVZUseCase) ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearest", ctx, point, radius, limit)
+	ret0, _ := ret[0].([]*models.NearbyPVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearest indicates an expected call of ListNearest.
+func (mr *MockPVZUseCaseMockRecorder) ListNearest(ctx, point, radius, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearest", reflect.TypeOf((*MockPVZUseCase)(nil).ListNearest), ctx, point, radius, limit)
+}
+
+// SetCapacity mocks base method.
+func (m *MockPVZUseCase) SetCapacity(ctx context.Context, id uuid.UUID, capacity int) (*models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCapacity", ctx, id, capacity)
+	ret0, _ := ret[0].(*models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCapacity indicates an expected call of SetCapacity.
+func (mr *MockPVZUseCaseMockRecorder) SetCapacity(ctx, id, capacity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPVZUseCase)(nil).List), ctx, startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCapacity", reflect.TypeOf((*MockPVZUseCase)(nil).SetCapacity), ctx, id, capacity)
 }
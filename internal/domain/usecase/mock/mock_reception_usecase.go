@@ -12,9 +12,11 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,17 +59,47 @@ func (mr *MockReceptionUseCaseMockRecorder) CloseLastReception(ctx, pvzID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockReceptionUseCase)(nil).CloseLastReception), ctx, pvzID)
 }
 
+// CloseStale mocks base method.
+func (m *MockReceptionUseCase) CloseStale(ctx context.Context, idleFor time.Duration) ([]*models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseStale", ctx, idleFor)
+	ret0, _ := ret[0].([]*models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseStale indicates an expected call of CloseStale.
+func (mr *MockReceptionUseCaseMockRecorder) CloseStale(ctx, idleFor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseStale", reflect.TypeOf((*MockReceptionUseCase)(nil).CloseStale), ctx, idleFor)
+}
+
 // Create mocks base method.
-func (m *MockReceptionUseCase) Create(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error) {
+func (m *MockReceptionUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.ReceptionInput) (*models.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, pvzID)
+	ret := m.ctrl.Call(m, "Create", ctx, pvzID, input)
 	ret0, _ := ret[0].(*models.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockReceptionUseCaseMockRecorder) Create(ctx, pvzID any) *gomock.Call {
+func (mr *MockReceptionUseCaseMockRecorder) Create(ctx, pvzID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReceptionUseCase)(nil).Create), ctx, pvzID, input)
+}
+
+// Reopen mocks base method.
+func (m *MockReceptionUseCase) Reopen(ctx context.Context, receptionID uuid.UUID, reason string) (*models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, receptionID, reason)
+	ret0, _ := ret[0].(*models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockReceptionUseCaseMockRecorder) Reopen(ctx, receptionID, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReceptionUseCase)(nil).Create), ctx, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockReceptionUseCase)(nil).Reopen), ctx, receptionID, reason)
 }
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/usecase/storage_cell_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/usecase/storage_cell_usecase.go -destination=internal/domain/usecase/mock/mock_storage_cell_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+	usecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStorageCellUseCase is a mock of StorageCellUseCase interface.
+type MockStorageCellUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageCellUseCaseMockRecorder is the mock recorder for MockStorageCellUseCase.
+type MockStorageCellUseCaseMockRecorder struct {
+	mock *MockStorageCellUseCase
+}
+
+// NewMockStorageCellUseCase creates a new mock instance.
+func NewMockStorageCellUseCase(ctrl *gomock.Controller) *MockStorageCellUseCase {
+	mock := &MockStorageCellUseCase{ctrl: ctrl}
+	mock.recorder = &MockStorageCellUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellUseCase) EXPECT() *MockStorageCellUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStorageCellUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.StorageCellInput) (*models.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, pvzID, input)
+	ret0, _ := ret[0].(*models.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStorageCellUseCaseMockRecorder) Create(ctx, pvzID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStorageCellUseCase)(nil).Create), ctx, pvzID, input)
+}
+
+// List mocks base method.
+func (m *MockStorageCellUseCase) List(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pvzID)
+	ret0, _ := ret[0].([]*models.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockStorageCellUseCaseMockRecorder) List(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStorageCellUseCase)(nil).List), ctx, pvzID)
+}
+
+// ListProducts mocks base method.
+func (m *MockStorageCellUseCase) ListProducts(ctx context.Context, pvzID, cellID uuid.UUID) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProducts", ctx, pvzID, cellID)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProducts indicates an expected call of ListProducts.
+func (mr *MockStorageCellUseCaseMockRecorder) ListProducts(ctx, pvzID, cellID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockStorageCellUseCase)(nil).ListProducts), ctx, pvzID, cellID)
+}
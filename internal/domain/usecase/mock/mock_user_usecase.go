@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,18 +43,18 @@ func (m *MockUserUseCase) EXPECT() *MockUserUseCaseMockRecorder {
 }
 
 // DummyLogin mocks base method.
-func (m *MockUserUseCase) DummyLogin(ctx context.Context, role models.UserRole) (string, error) {
+func (m *MockUserUseCase) DummyLogin(ctx context.Context, role models.UserRole, pvzIDs []uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DummyLogin", ctx, role)
+	ret := m.ctrl.Call(m, "DummyLogin", ctx, role, pvzIDs)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DummyLogin indicates an expected call of DummyLogin.
-func (mr *MockUserUseCaseMockRecorder) DummyLogin(ctx, role any) *gomock.Call {
+func (mr *MockUserUseCaseMockRecorder) DummyLogin(ctx, role, pvzIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockUserUseCase)(nil).DummyLogin), ctx, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockUserUseCase)(nil).DummyLogin), ctx, role, pvzIDs)
 }
 
 // Login mocks base method.
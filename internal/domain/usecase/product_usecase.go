@@ -9,6 +9,49 @@ import (
 
 // ProductUseCase  интерфейс для работы с товарами
 type ProductUseCase interface {
-	Create(ctx context.Context, productType models.ProductType, pvzID uuid.UUID) (*models.Product, error)
+	// Create и AddToSession отклоняют товар сверх вместимости ПВЗ и квоты приемки
+	Create(ctx context.Context, pvzID uuid.UUID, input ProductInput) (*models.Product, error)
+	// CreateBatch добавляет товары в открытую приемку ПВЗ по принципу "все или ничего"
+	// и возвращает их в порядке inputs
+	CreateBatch(ctx context.Context, pvzID uuid.UUID, inputs []ProductInput) ([]*models.Product, error)
 	DeleteLastFromReception(ctx context.Context, pvzID uuid.UUID) error
+	// Delete удаляет товар по идентификатору из открытой приемки ПВЗ и возвращает его
+	Delete(ctx context.Context, pvzID, productID uuid.UUID) (*models.Product, error)
+	// FindByBarcode не находит для сотрудника товары ПВЗ, за которыми он не закреплен
+	FindByBarcode(ctx context.Context, barcode string) (*ProductLocation, error)
+	StartScanSession(ctx context.Context, pvzID uuid.UUID) (*ScanSession, error)
+	// AddToSession и UndoInSession перед каждой командой проверяют доступ сотрудника и статус ПВЗ,
+	// а закрытая за время сессии приемка отклоняет команду с ErrReceptionAlreadyClosed
+	AddToSession(ctx context.Context, session *ScanSession, input ProductInput) (*models.Product, error)
+	UndoInSession(ctx context.Context, session *ScanSession) (*models.Product, error)
+	// ChangeStatus переводит товар ПВЗ в новое состояние, например выдает его получателю
+	ChangeStatus(ctx context.Context, pvzID, productID uuid.UUID, status models.ProductStatus) (*models.Product, error)
+	// ListShelf возвращает товары, которые сейчас находятся в ПВЗ.
+	// Пустой statuses означает все состояния товаров на полке.
+	ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error)
+}
+
+// ProductInput описывает отсканированный товар. Номер заказа и штрихкод необязательны.
+// Для приемки возвратов нужны причина возврата и ссылка на выданный товар или номер заказа.
+// Без CellID товар кладется в первую свободную ячейку ПВЗ.
+type ProductInput struct {
+	Type              models.ProductType
+	OrderID           string
+	Barcode           string
+	ReturnReason      string
+	OriginalProductID *uuid.UUID
+	CellID            *uuid.UUID
+}
+
+// ProductLocation описывает товар вместе с приемкой и ПВЗ, в которые он поступил
+type ProductLocation struct {
+	Product   *models.Product   `json:"product"`
+	Reception *models.Reception `json:"reception"`
+	PVZ       *models.PVZ       `json:"pvz"`
+}
+
+// ScanSession хранит ПВЗ и приемку, найденные при открытии сессии сканирования
+type ScanSession struct {
+	PVZ       *models.PVZ       `json:"pvz"`
+	Reception *models.Reception `json:"reception"`
 }
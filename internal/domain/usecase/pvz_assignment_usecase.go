@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// PVZAssignmentUseCase интерфейс для закрепления сотрудников за ПВЗ
+type PVZAssignmentUseCase interface {
+	Assign(ctx context.Context, pvzID, userID uuid.UUID) (*models.PVZAssignment, error)
+	Unassign(ctx context.Context, pvzID, userID uuid.UUID) error
+	// List возвращает сотрудников, закрепленных за ПВЗ
+	List(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error)
+	// CheckAccess проверяет, что сотрудник из контекста закреплен за ПВЗ.
+	// Модераторов и внутренние вызовы с WithSystemCaller проверка не ограничивает,
+	// вызов без пользователя отклоняется с ErrPVZAccessDenied.
+	CheckAccess(ctx context.Context, pvzID uuid.UUID) error
+}
@@ -10,10 +10,31 @@ import (
 
 // PVZUseCase  интерфейс бизнес-логики с ПВЗ
 type PVZUseCase interface {
-	Create(ctx context.Context, city models.City) (*models.PVZ, error)
+	Create(ctx context.Context, input PVZInput) (*models.PVZ, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ, error)
-	List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*PVZWithReceptions, error)
-	GetAll(ctx context.Context) ([]*models.PVZ, error)
+	// List и GetAll возвращают архивные ПВЗ, только если includeArchived.
+	// List оставляет в выдаче только приемки вида kind, пустой kind означает любой вид.
+	List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool, kind models.ReceptionKind) ([]*PVZWithReceptions, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error)
+	// ListNearest возвращает ПВЗ в радиусе radius метров от точки, ближайшие первыми
+	ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error)
+	// ChangeStatus приостанавливает, возобновляет или архивирует ПВЗ.
+	// Для приостановки и архивации нужна причина.
+	ChangeStatus(ctx context.Context, id uuid.UUID, status models.PVZStatus, reason string) (*models.PVZ, error)
+	// SetCapacity меняет вместимость ПВЗ, 0 снимает ограничение. Вместимость можно
+	// сделать меньше текущей заполненности, тогда ПВЗ перестает принимать товары.
+	SetCapacity(ctx context.Context, id uuid.UUID, capacity int) (*models.PVZ, error)
+}
+
+// PVZInput - данные для открытия ПВЗ. Все поля, кроме города, необязательны.
+type PVZInput struct {
+	City         models.City
+	Address      string
+	Coordinates  *models.Coordinates
+	WorkingHours string
+	Phone        string
+	// Capacity - сколько товаров помещается в ПВЗ, 0 - без ограничения
+	Capacity int
 }
 
 // PVZWithReceptions представляет ПВЗ с его приемками и товарами
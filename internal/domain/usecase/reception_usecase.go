@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
@@ -9,6 +10,18 @@ import (
 
 // ReceptionUseCase интерфейс для работы с приемками
 type ReceptionUseCase interface {
-	Create(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error)
+	// Create открывает приемку поставки или возвратов от получателей в зависимости от input.Kind
+	Create(ctx context.Context, pvzID uuid.UUID, input ReceptionInput) (*models.Reception, error)
 	CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error)
+	// Reopen снова открывает закрытую по ошибке приемку, если она последняя в ПВЗ
+	Reopen(ctx context.Context, receptionID uuid.UUID, reason string) (*models.Reception, error)
+	// CloseStale закрывает от имени сервиса открытые приемки, в которые не добавляли товары дольше idleFor
+	CloseStale(ctx context.Context, idleFor time.Duration) ([]*models.Reception, error)
+}
+
+// ReceptionInput - данные для открытия приемки
+type ReceptionInput struct {
+	Kind models.ReceptionKind
+	// MaxItems - сколько товаров можно принять в приемку, 0 - без ограничения
+	MaxItems int
 }
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// StorageCellUseCase интерфейс для работы со схемой хранения ПВЗ
+type StorageCellUseCase interface {
+	Create(ctx context.Context, pvzID uuid.UUID, input StorageCellInput) (*models.StorageCell, error)
+	// List и ListProducts доступны сотруднику только в назначенных ему ПВЗ.
+	// List возвращает ячейки ПВЗ вместе с их заполненностью
+	List(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error)
+	// ListProducts возвращает товары, которые сейчас лежат в ячейке
+	ListProducts(ctx context.Context, pvzID, cellID uuid.UUID) ([]*models.Product, error)
+}
+
+// StorageCellInput - адрес ячейки в ПВЗ и ее вместимость в товарах
+type StorageCellInput struct {
+	Rack     string
+	Shelf    string
+	Cell     string
+	Capacity int
+}
@@ -5,4 +5,5 @@ type UseCases struct {
 	PVZ       PVZUseCase
 	Reception ReceptionUseCase
 	Product   ProductUseCase
+	Catalog   CatalogUseCase
 }
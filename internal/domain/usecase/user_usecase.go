@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 )
 
@@ -10,6 +11,7 @@ import (
 type UserUseCase interface {
 	Register(ctx context.Context, email, password string, role models.UserRole) (*models.User, error)
 	Login(ctx context.Context, email, password string) (string, error)
-	DummyLogin(ctx context.Context, role models.UserRole) (string, error)
+	// DummyLogin выпускает тестовый токен, pvzIDs ограничивают ПВЗ сотрудника с этим токеном
+	DummyLogin(ctx context.Context, role models.UserRole, pvzIDs []uuid.UUID) (string, error)
 	ValidateToken(ctx context.Context, token string) (*models.User, error)
 }
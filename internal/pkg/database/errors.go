@@ -0,0 +1,16 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation - код ошибки PostgreSQL при нарушении уникального индекса
+const uniqueViolation = "23505"
+
+// IsUniqueViolation проверяет, что запрос нарушил уникальный индекс
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type txCtxKey struct{}
+
+// WithinTx выполняет fn в транзакции и фиксирует ее, если fn завершилась без ошибки.
+// Запросы репозиториев с контекстом fn выполняются в этой транзакции.
+// Вложенный вызов не открывает новую транзакцию, а продолжает текущую.
+func (db *Database) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// После Commit откат ничего не делает
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(context.WithValue(ctx, txCtxKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ExecContext выполняет запрос в транзакции из контекста, если она открыта
+func (db *Database) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// QueryContext выполняет запрос в транзакции из контекста, если она открыта
+func (db *Database) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext выполняет запрос в транзакции из контекста, если она открыта
+func (db *Database) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+func txFromContext(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(txCtxKey{}).(*sql.Tx)
+	return tx
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_WithinTx(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db := &Database{DB: sqlDB}
+
+	t.Run("Commit", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE pvzs").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := db.WithinTx(context.Background(), func(ctx context.Context) error {
+			if _, err := db.ExecContext(ctx, "UPDATE pvzs SET occupied = occupied + 1"); err != nil {
+				return err
+			}
+			// Вложенный вызов продолжает открытую транзакцию
+			return db.WithinTx(ctx, func(ctx context.Context) error {
+				_, err := db.ExecContext(ctx, "INSERT INTO products DEFAULT VALUES")
+				return err
+			})
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		failed := errors.New("no free cell")
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE pvzs").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		err := db.WithinTx(context.Background(), func(ctx context.Context) error {
+			if _, err := db.ExecContext(ctx, "UPDATE pvzs SET occupied = occupied + 1"); err != nil {
+				return err
+			}
+			return failed
+		})
+		assert.ErrorIs(t, err, failed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Without Transaction", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM products").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := db.ExecContext(context.Background(), "DELETE FROM products")
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -13,6 +13,7 @@ var (
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrUnauthorized  = errors.New("unauthorized")
 	ErrForbidden     = errors.New("forbidden")
+	ErrConflict      = errors.New("conflict with current state")
 )
 
 // Ошибки для пользователей
@@ -20,27 +21,92 @@ var (
 	ErrUserNotFound       = fmt.Errorf("user not found: %w", ErrNotFound)
 	ErrUserAlreadyExists  = fmt.Errorf("user already exists: %w", ErrAlreadyExists)
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrReservedEmail      = fmt.Errorf("email prefix is reserved for test tokens: %w", ErrInvalidInput)
 )
 
 // Ошибки для ПВЗ
 var (
-	ErrPVZNotFound = fmt.Errorf("pvz not found: %w", ErrNotFound)
-	ErrInvalidCity = fmt.Errorf("invalid city: %w", ErrInvalidInput)
+	ErrPVZNotFound         = fmt.Errorf("pvz not found: %w", ErrNotFound)
+	ErrInvalidCity         = fmt.Errorf("invalid city: %w", ErrInvalidInput)
+	ErrInvalidAddress      = fmt.Errorf("invalid address: %w", ErrInvalidInput)
+	ErrInvalidCoordinates  = fmt.Errorf("invalid coordinates: %w", ErrInvalidInput)
+	ErrInvalidWorkingHours = fmt.Errorf("invalid working hours: %w", ErrInvalidInput)
+	ErrInvalidPhone        = fmt.Errorf("invalid phone: %w", ErrInvalidInput)
+	ErrInvalidSearchRadius = fmt.Errorf("invalid search radius: %w", ErrInvalidInput)
+	ErrInvalidPVZStatus    = fmt.Errorf("invalid pvz status: %w", ErrInvalidInput)
+	ErrInvalidStatusReason = fmt.Errorf("invalid status reason: %w", ErrInvalidInput)
+	ErrPVZStatusTransition = fmt.Errorf("pvz status transition not allowed: %w", ErrConflict)
+	ErrPVZNotActive        = fmt.Errorf("pvz is not active: %w", ErrConflict)
+	ErrInvalidPVZCapacity  = fmt.Errorf("invalid pvz capacity: %w", ErrInvalidInput)
+	ErrPVZFull             = fmt.Errorf("pvz is full: %w", ErrConflict)
+)
+
+// Ошибки для назначений сотрудников в ПВЗ
+var (
+	ErrAssignmentNotFound  = fmt.Errorf("assignment not found: %w", ErrNotFound)
+	ErrAssignmentExists    = fmt.Errorf("employee already assigned to pvz: %w", ErrAlreadyExists)
+	ErrAssigneeNotEmployee = fmt.Errorf("only employees can be assigned to pvz: %w", ErrInvalidInput)
+	ErrPVZAccessDenied     = fmt.Errorf("employee is not assigned to pvz: %w", ErrForbidden)
 )
 
 // Ошибки для приемок
 var (
 	ErrReceptionNotFound      = fmt.Errorf("reception not found: %w", ErrNotFound)
 	ErrOpenReceptionNotFound  = fmt.Errorf("open reception not found: %w", ErrNotFound)
-	ErrReceptionAlreadyClosed = errors.New("reception already closed")
-	ErrOpenReceptionExists    = errors.New("open reception already exists")
+	ErrReceptionAlreadyClosed = fmt.Errorf("reception already closed: %w", ErrConflict)
+	ErrOpenReceptionExists    = fmt.Errorf("open reception already exists: %w", ErrConflict)
+	ErrReceptionNotClosed     = fmt.Errorf("reception is not closed: %w", ErrConflict)
+	ErrNewerReceptionExists   = fmt.Errorf("newer reception exists: %w", ErrConflict)
+	ErrInvalidReopenReason    = fmt.Errorf("invalid reopen reason: %w", ErrInvalidInput)
+	ErrInvalidReceptionKind   = fmt.Errorf("invalid reception kind: %w", ErrInvalidInput)
+	ErrInvalidMaxItems        = fmt.Errorf("invalid reception max items: %w", ErrInvalidInput)
+	ErrReceptionQuotaExceeded = fmt.Errorf("reception item quota exceeded: %w", ErrConflict)
 )
 
 // Ошибки для товаров
 var (
 	ErrProductNotFound    = fmt.Errorf("product not found: %w", ErrNotFound)
 	ErrInvalidProductType = fmt.Errorf("invalid product type: %w", ErrInvalidInput)
-	ErrNoProductsToDelete = errors.New("no products to delete")
+	ErrNoProductsToDelete = fmt.Errorf("no products to delete: %w", ErrConflict)
+	ErrInvalidOrderID     = fmt.Errorf("invalid order id: %w", ErrInvalidInput)
+	ErrInvalidBarcode     = fmt.Errorf("invalid barcode: %w", ErrInvalidInput)
+	ErrDuplicateBarcode   = fmt.Errorf("barcode already scanned in reception: %w", ErrAlreadyExists)
+	ErrInvalidBatchSize   = fmt.Errorf("invalid product batch size: %w", ErrInvalidInput)
+
+	ErrInvalidProductStatus    = fmt.Errorf("invalid product status: %w", ErrInvalidInput)
+	ErrProductStatusTransition = fmt.Errorf("product status transition not allowed: %w", ErrConflict)
+	ErrProductNotAccepted      = fmt.Errorf("product is no longer in accepted status: %w", ErrConflict)
+
+	ErrInvalidReturnReason      = fmt.Errorf("invalid return reason: %w", ErrInvalidInput)
+	ErrReturnReferenceRequired  = fmt.Errorf("original product id or order id required for return: %w", ErrInvalidInput)
+	ErrReturnDetailsNotAllowed  = fmt.Errorf("return details allowed only in customer return reception: %w", ErrInvalidInput)
+	ErrReturnedProductNotFound  = fmt.Errorf("returned product not found: %w", ErrInvalidInput)
+	ErrReturnedProductNotIssued = fmt.Errorf("returned product was not issued: %w", ErrConflict)
+)
+
+// Ошибки для ячеек хранения
+var (
+	ErrStorageCellNotFound = fmt.Errorf("storage cell not found: %w", ErrNotFound)
+	ErrStorageCellExists   = fmt.Errorf("storage cell already exists: %w", ErrAlreadyExists)
+	ErrInvalidStorageCell  = fmt.Errorf("invalid storage cell: %w", ErrInvalidInput)
+	ErrStorageCellFull     = fmt.Errorf("storage cell is full: %w", ErrConflict)
+	ErrNoFreeStorageCell   = fmt.Errorf("no free storage cell in pvz: %w", ErrConflict)
+)
+
+// Ошибки для справочников
+var (
+	ErrCatalogNotFound      = fmt.Errorf("catalog not found: %w", ErrNotFound)
+	ErrCatalogEntryNotFound = fmt.Errorf("catalog entry not found: %w", ErrNotFound)
+	ErrCatalogEntryExists   = fmt.Errorf("catalog entry already exists: %w", ErrAlreadyExists)
+	ErrInvalidCatalogEntry  = fmt.Errorf("invalid catalog entry: %w", ErrInvalidInput)
+)
+
+// Ошибки для ключей идемпотентности
+var (
+	ErrInvalidIdempotencyKey    = fmt.Errorf("invalid idempotency key: %w", ErrInvalidInput)
+	ErrIdempotencyKeyReused     = fmt.Errorf("idempotency key already used with another request: %w", ErrInvalidInput)
+	ErrIdempotencyKeyInProgress = fmt.Errorf("request with this idempotency key is in progress: %w", ErrConflict)
+	ErrIdempotencyKeyNotFound   = fmt.Errorf("idempotency key not found: %w", ErrNotFound)
 )
 
 // Ошибки базы данных
@@ -50,6 +116,16 @@ var (
 	ErrNoRows       = errors.New("no rows in result set")
 )
 
+// Ошибки состояния сервиса
+var (
+	ErrShuttingDown = errors.New("service is shutting down")
+)
+
+// Is проверяет, содержит ли цепочка ошибки target
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
 // IsNotFound проверяет, является ли ошибка типом "не найдено"
 func IsNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
@@ -75,12 +151,143 @@ func IsForbidden(err error) bool {
 	return errors.Is(err, ErrForbidden)
 }
 
+// IsConflict проверяет, является ли ошибка типом "конфликт с текущим состоянием"
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
 // IsNoRows проверяет, является ли ошибка типом "нет строк"
 func IsNoRows(err error) bool {
 	return errors.Is(err, ErrNoRows) || (err != nil && err.Error() == "sql: no rows in result set")
 }
 
+// reasons сопоставляет ошибки со стабильными машиночитаемыми кодами.
+// Более специфичные ошибки должны идти раньше общих.
+var reasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrUserNotFound, "USER_NOT_FOUND"},
+	{ErrUserAlreadyExists, "USER_ALREADY_EXISTS"},
+	{ErrInvalidCredentials, "INVALID_CREDENTIALS"},
+	{ErrReservedEmail, "RESERVED_EMAIL"},
+	{ErrPVZNotFound, "PVZ_NOT_FOUND"},
+	{ErrInvalidCity, "INVALID_CITY"},
+	{ErrInvalidAddress, "INVALID_ADDRESS"},
+	{ErrInvalidCoordinates, "INVALID_COORDINATES"},
+	{ErrInvalidWorkingHours, "INVALID_WORKING_HOURS"},
+	{ErrInvalidPhone, "INVALID_PHONE"},
+	{ErrInvalidSearchRadius, "INVALID_SEARCH_RADIUS"},
+	{ErrInvalidPVZStatus, "INVALID_PVZ_STATUS"},
+	{ErrInvalidStatusReason, "INVALID_STATUS_REASON"},
+	{ErrPVZStatusTransition, "PVZ_STATUS_TRANSITION_NOT_ALLOWED"},
+	{ErrPVZNotActive, "PVZ_NOT_ACTIVE"},
+	{ErrInvalidPVZCapacity, "INVALID_PVZ_CAPACITY"},
+	{ErrPVZFull, "PVZ_FULL"},
+	{ErrAssignmentNotFound, "ASSIGNMENT_NOT_FOUND"},
+	{ErrAssignmentExists, "ASSIGNMENT_ALREADY_EXISTS"},
+	{ErrAssigneeNotEmployee, "ASSIGNEE_NOT_EMPLOYEE"},
+	{ErrPVZAccessDenied, "PVZ_ACCESS_DENIED"},
+	{ErrOpenReceptionNotFound, "OPEN_RECEPTION_NOT_FOUND"},
+	{ErrReceptionNotFound, "RECEPTION_NOT_FOUND"},
+	{ErrReceptionAlreadyClosed, "RECEPTION_ALREADY_CLOSED"},
+	{ErrOpenReceptionExists, "OPEN_RECEPTION_EXISTS"},
+	{ErrReceptionNotClosed, "RECEPTION_NOT_CLOSED"},
+	{ErrNewerReceptionExists, "NEWER_RECEPTION_EXISTS"},
+	{ErrInvalidReopenReason, "INVALID_REOPEN_REASON"},
+	{ErrInvalidReceptionKind, "INVALID_RECEPTION_KIND"},
+	{ErrInvalidMaxItems, "INVALID_MAX_ITEMS"},
+	{ErrReceptionQuotaExceeded, "RECEPTION_QUOTA_EXCEEDED"},
+	{ErrProductNotFound, "PRODUCT_NOT_FOUND"},
+	{ErrInvalidProductType, "INVALID_PRODUCT_TYPE"},
+	{ErrNoProductsToDelete, "NO_PRODUCTS_TO_DELETE"},
+	{ErrInvalidOrderID, "INVALID_ORDER_ID"},
+	{ErrInvalidBarcode, "INVALID_BARCODE"},
+	{ErrDuplicateBarcode, "DUPLICATE_BARCODE"},
+	{ErrInvalidBatchSize, "INVALID_BATCH_SIZE"},
+	{ErrInvalidProductStatus, "INVALID_PRODUCT_STATUS"},
+	{ErrProductStatusTransition, "PRODUCT_STATUS_TRANSITION_NOT_ALLOWED"},
+	{ErrProductNotAccepted, "PRODUCT_NOT_ACCEPTED"},
+	{ErrInvalidReturnReason, "INVALID_RETURN_REASON"},
+	{ErrReturnReferenceRequired, "RETURN_REFERENCE_REQUIRED"},
+	{ErrReturnDetailsNotAllowed, "RETURN_DETAILS_NOT_ALLOWED"},
+	{ErrReturnedProductNotFound, "RETURNED_PRODUCT_NOT_FOUND"},
+	{ErrReturnedProductNotIssued, "RETURNED_PRODUCT_NOT_ISSUED"},
+	{ErrStorageCellNotFound, "STORAGE_CELL_NOT_FOUND"},
+	{ErrStorageCellExists, "STORAGE_CELL_ALREADY_EXISTS"},
+	{ErrInvalidStorageCell, "INVALID_STORAGE_CELL"},
+	{ErrStorageCellFull, "STORAGE_CELL_FULL"},
+	{ErrNoFreeStorageCell, "NO_FREE_STORAGE_CELL"},
+	{ErrCatalogNotFound, "CATALOG_NOT_FOUND"},
+	{ErrCatalogEntryNotFound, "CATALOG_ENTRY_NOT_FOUND"},
+	{ErrCatalogEntryExists, "CATALOG_ENTRY_ALREADY_EXISTS"},
+	{ErrInvalidCatalogEntry, "INVALID_CATALOG_ENTRY"},
+	{ErrInvalidIdempotencyKey, "INVALID_IDEMPOTENCY_KEY"},
+	{ErrIdempotencyKeyReused, "IDEMPOTENCY_KEY_REUSED"},
+	{ErrIdempotencyKeyInProgress, "IDEMPOTENCY_KEY_IN_PROGRESS"},
+	{ErrIdempotencyKeyNotFound, "IDEMPOTENCY_KEY_NOT_FOUND"},
+	{ErrShuttingDown, "SHUTTING_DOWN"},
+	{ErrNotFound, "NOT_FOUND"},
+	{ErrAlreadyExists, "ALREADY_EXISTS"},
+	{ErrInvalidInput, "INVALID_INPUT"},
+	{ErrConflict, "CONFLICT"},
+	{ErrUnauthorized, "UNAUTHORIZED"},
+	{ErrForbidden, "FORBIDDEN"},
+}
+
+// Reason возвращает стабильный машиночитаемый код ошибки
+func Reason(err error) string {
+	for _, r := range reasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return "INTERNAL"
+}
+
 // Wrap оборачивает ошибку с дополнительным сообщением
 func Wrap(err error, message string) error {
 	return fmt.Errorf("%s: %w", message, err)
 }
+
+// DetailedError дополняет доменную ошибку сведениями для клиента,
+// например позицией товара в пачке или квотой приемки
+type DetailedError struct {
+	Err     error
+	Details map[string]string
+}
+
+func (e *DetailedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DetailedError) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails добавляет к ошибке сведения для клиента, не меняя ее текст и код
+func WithDetails(err error, details map[string]string) error {
+	return &DetailedError{Err: err, Details: details}
+}
+
+// Details собирает сведения всех DetailedError в цепочке ошибки.
+// При совпадении ключей остается значение внешней ошибки.
+func Details(err error) map[string]string {
+	var details map[string]string
+	for {
+		var detailed *DetailedError
+		if !errors.As(err, &detailed) {
+			return details
+		}
+
+		if details == nil {
+			details = make(map[string]string, len(detailed.Details))
+		}
+		for key, value := range detailed.Details {
+			if _, ok := details[key]; !ok {
+				details[key] = value
+			}
+		}
+		err = detailed.Err
+	}
+}
@@ -0,0 +1,54 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{"PVZ Not Found", ErrPVZNotFound, "PVZ_NOT_FOUND"},
+		{"Open Reception Not Found", ErrOpenReceptionNotFound, "OPEN_RECEPTION_NOT_FOUND"},
+		{"Open Reception Exists", ErrOpenReceptionExists, "OPEN_RECEPTION_EXISTS"},
+		{"Invalid Product Type", ErrInvalidProductType, "INVALID_PRODUCT_TYPE"},
+		{"Duplicate Barcode", ErrDuplicateBarcode, "DUPLICATE_BARCODE"},
+		{"Catalog Entry Exists", ErrCatalogEntryExists, "CATALOG_ENTRY_ALREADY_EXISTS"},
+		{"PVZ Access Denied", ErrPVZAccessDenied, "PVZ_ACCESS_DENIED"},
+		{"Wrapped", fmt.Errorf("create product: %w", ErrNoProductsToDelete), "NO_PRODUCTS_TO_DELETE"},
+		{"Generic Not Found", fmt.Errorf("something: %w", ErrNotFound), "NOT_FOUND"},
+		{"Shutting Down", ErrShuttingDown, "SHUTTING_DOWN"},
+		{"Unknown", errors.New("boom"), "INTERNAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Reason(tt.err))
+		})
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	assert.True(t, IsConflict(ErrOpenReceptionExists))
+	assert.True(t, IsConflict(ErrReceptionAlreadyClosed))
+	assert.True(t, IsConflict(ErrNoProductsToDelete))
+	assert.False(t, IsConflict(ErrPVZNotFound))
+}
+
+func TestDetails(t *testing.T) {
+	quota := WithDetails(ErrReceptionQuotaExceeded, map[string]string{"maxItems": "10", "itemsCount": "9"})
+	err := WithDetails(Wrap(quota, "products[2]"), map[string]string{"index": "2"})
+
+	assert.ErrorIs(t, err, ErrReceptionQuotaExceeded)
+	assert.Equal(t, "RECEPTION_QUOTA_EXCEEDED", Reason(err))
+	assert.Equal(t, "products[2]: "+ErrReceptionQuotaExceeded.Error(), err.Error())
+	assert.Equal(t, map[string]string{"index": "2", "maxItems": "10", "itemsCount": "9"}, Details(err))
+
+	assert.Nil(t, Details(ErrPVZNotFound))
+}
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// subscriberBufferSize ограничивает число недоставленных событий на подписчика
+const subscriberBufferSize = 64
+
+// Publisher публикует события ПВЗ
+type Publisher interface {
+	Publish(event *models.PVZEvent)
+}
+
+// Subscriber выдает события ПВЗ подписчикам
+type Subscriber interface {
+	Subscribe(filter models.PVZEventFilter) (<-chan *models.PVZEvent, func())
+}
+
+type subscription struct {
+	filter models.PVZEventFilter
+	ch     chan *models.PVZEvent
+}
+
+// Broker рассылает события ПВЗ подписчикам внутри процесса
+type Broker struct {
+	mu            sync.RWMutex
+	nextID        uint64
+	subscriptions map[uint64]*subscription
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscriptions: make(map[uint64]*subscription),
+	}
+}
+
+// Publish отправляет событие подходящим подписчикам.
+// Публикация не блокируется: если буфер подписчика заполнен, событие для него теряется.
+func (b *Broker) Publish(event *models.PVZEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscriptions {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe возвращает канал событий и функцию отписки, которая закрывает канал
+func (b *Broker) Subscribe(filter models.PVZEventFilter) (<-chan *models.PVZEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	sub := &subscription{
+		filter: filter,
+		ch:     make(chan *models.PVZEvent, subscriberBufferSize),
+	}
+	b.subscriptions[id] = sub
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscriptions, id)
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, unsubscribe
+}
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker()
+
+	moscowPVZ := models.NewPVZ(models.CityMoscow)
+	kazanPVZ := models.NewPVZ(models.CityKazan)
+
+	all, unsubscribeAll := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribeAll()
+	byPVZ, unsubscribeByPVZ := broker.Subscribe(models.PVZEventFilter{PVZID: moscowPVZ.ID})
+	defer unsubscribeByPVZ()
+	byCity, unsubscribeByCity := broker.Subscribe(models.PVZEventFilter{City: models.CityKazan})
+	defer unsubscribeByCity()
+
+	moscowEvent := models.NewReceptionEvent(models.PVZEventReceptionOpened, moscowPVZ, models.NewReception(moscowPVZ.ID))
+	kazanEvent := models.NewProductEvent(models.PVZEventProductAdded, kazanPVZ, models.NewProduct(models.ProductTypeShoes, uuid.New()))
+
+	broker.Publish(moscowEvent)
+	broker.Publish(kazanEvent)
+
+	require.Len(t, all, 2)
+	assert.Equal(t, moscowEvent, <-all)
+	assert.Equal(t, kazanEvent, <-all)
+
+	require.Len(t, byPVZ, 1)
+	assert.Equal(t, moscowEvent, <-byPVZ)
+
+	require.Len(t, byCity, 1)
+	assert.Equal(t, kazanEvent, <-byCity)
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	broker := NewBroker()
+
+	ch, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	unsubscribe()
+	unsubscribe()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	broker.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, models.NewReception(pvz.ID)))
+}
+
+func TestBroker_SlowSubscriberDoesNotBlock(t *testing.T) {
+	broker := NewBroker()
+
+	ch, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	for i := 0; i < subscriberBufferSize*2; i++ {
+		broker.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, models.NewReception(pvz.ID)))
+	}
+
+	assert.Len(t, ch, subscriberBufferSize)
+}
+
+func TestBroker_EventHoldsSnapshot(t *testing.T) {
+	broker := NewBroker()
+
+	ch, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeShoes, reception.ID)
+
+	broker.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, reception))
+	broker.Publish(models.NewProductEvent(models.PVZEventProductAdded, pvz, product))
+
+	reception.ItemsCount = 10
+	reception.MaxItems = 20
+	product.Status = models.ProductStatusIssued
+
+	receptionEvent := <-ch
+	assert.Zero(t, receptionEvent.Reception.ItemsCount)
+	assert.Zero(t, receptionEvent.Reception.MaxItems)
+
+	productEvent := <-ch
+	assert.Equal(t, models.ProductStatusAccepted, productEvent.Product.Status)
+}
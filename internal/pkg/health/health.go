@@ -0,0 +1,56 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+// pingTimeout ограничивает время проверки базы данных, чтобы проба не зависала
+const pingTimeout = 2 * time.Second
+
+// Pinger проверяет доступность базы данных, его реализует database.Database
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// ReadinessChecker проверяет готовность приложения принимать трафик
+type ReadinessChecker interface {
+	Ready(ctx context.Context) error
+}
+
+// Checker проверяет готовность приложения принимать трафик.
+// Используется и HTTP пробами, и gRPC health сервисом.
+type Checker struct {
+	db           Pinger
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(db Pinger) *Checker {
+	return &Checker{db: db}
+}
+
+// Ready возвращает ошибку, если приложение останавливается или база данных недоступна
+func (c *Checker) Ready(ctx context.Context) error {
+	if c.shuttingDown.Load() {
+		return errors.ErrShuttingDown
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDBConnection, err)
+	}
+
+	return nil
+}
+
+// SetShuttingDown переводит приложение в неготовое состояние до остановки серверов,
+// чтобы балансировщик успел перестать направлять на него запросы
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
@@ -0,0 +1,34 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+type testPinger struct {
+	err error
+}
+
+func (p *testPinger) PingContext(_ context.Context) error {
+	return p.err
+}
+
+func TestChecker_Ready(t *testing.T) {
+	pinger := &testPinger{}
+	checker := NewChecker(pinger)
+
+	assert.NoError(t, checker.Ready(context.Background()))
+
+	pinger.err = fmt.Errorf("connection refused")
+	err := checker.Ready(context.Background())
+	assert.ErrorIs(t, err, errors.ErrDBConnection)
+
+	pinger.err = nil
+	checker.SetShuttingDown()
+	assert.ErrorIs(t, checker.Ready(context.Background()), errors.ErrShuttingDown)
+}
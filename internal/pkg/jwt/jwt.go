@@ -14,6 +14,10 @@ type Claims struct {
 	UserID string          `json:"user_id"`
 	Email  string          `json:"email"`
 	Role   models.UserRole `json:"role"`
+	// Dummy отмечает тестовый токен: его пользователя нет в БД
+	Dummy bool `json:"dummy,omitempty"`
+	// PVZIDs ограничивает ПВЗ тестового токена, у обычных токенов не заполняется
+	PVZIDs []string `json:"pvz_ids,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -44,11 +48,20 @@ func (m *Manager) GenerateToken(userID uuid.UUID, email string, role models.User
 	return token.SignedString([]byte(m.signingKey))
 }
 
-func (m *Manager) GenerateDummyToken(role models.UserRole) (string, error) {
+// GenerateDummyToken выпускает тестовый токен для роли.
+// Если переданы ПВЗ, сотрудник с таким токеном работает только с ними.
+func (m *Manager) GenerateDummyToken(role models.UserRole, pvzIDs ...uuid.UUID) (string, error) {
+	var scope []string
+	for _, id := range pvzIDs {
+		scope = append(scope, id.String())
+	}
+
 	claims := &Claims{
 		UserID: uuid.New().String(),
-		Email:  fmt.Sprintf("dummy_%s@example.com", role),
+		Email:  fmt.Sprintf("%s%s@example.com", models.DummyEmailPrefix, role),
 		Role:   role,
+		Dummy:  true,
+		PVZIDs: scope,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.expiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
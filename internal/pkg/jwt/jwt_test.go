@@ -30,6 +30,7 @@ func TestJWTManager(t *testing.T) {
 		assert.Equal(t, userID.String(), claims.UserID)
 		assert.Equal(t, email, claims.Email)
 		assert.Equal(t, role, claims.Role)
+		assert.False(t, claims.Dummy)
 	})
 
 	t.Run("GenerateDummyToken", func(t *testing.T) {
@@ -44,6 +45,18 @@ func TestJWTManager(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, role, claims.Role)
 		assert.Contains(t, claims.Email, "dummy_")
+		assert.True(t, claims.Dummy)
+	})
+
+	t.Run("GenerateDummyToken - PVZ Scope", func(t *testing.T) {
+		pvzID := uuid.New()
+
+		token, err := manager.GenerateDummyToken(models.EmployeeRole, pvzID)
+		require.NoError(t, err)
+
+		claims, err := manager.ParseToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, []string{pvzID.String()}, claims.PVZIDs)
 	})
 
 	t.Run("ParseToken - Invalid Token", func(t *testing.T) {
@@ -8,15 +8,21 @@ type MetricsInterface interface {
 	IncPVZCreated()
 	IncReceptionCreated()
 	IncProductAdded()
+	IncProductStatusChanged(status string)
+	IncPVZNearlyFull(city string)
+	AddReceptionAutoClosed(count int)
 	ObserveRequestDuration(method, endpoint string, duration float64)
 	IncRequestCount(method, endpoint, status string)
 	ObserveGRPCRequestDuration(method string, duration float64)
 	IncGRPCRequestCount(method, status string)
+	IncDeprecatedRequestCount(method, endpoint string)
 }
 
 type Metrics struct {
 	RequestCount    *prometheus.CounterVec
 	RequestDuration *prometheus.HistogramVec
+	// DeprecatedRequestCount считает обращения к устаревшим путям HTTP API
+	DeprecatedRequestCount *prometheus.CounterVec
 
 	GRPCRequestCount    *prometheus.CounterVec
 	GRPCRequestDuration *prometheus.HistogramVec
@@ -24,6 +30,12 @@ type Metrics struct {
 	PVZCreated       prometheus.Counter
 	ReceptionCreated prometheus.Counter
 	ProductAdded     prometheus.Counter
+	// ProductStatusChanged считает переходы товаров по состояниям, в том числе выдачи и отказы
+	ProductStatusChanged *prometheus.CounterVec
+	// PVZNearlyFull считает, сколько раз ПВЗ доходили до порога заполнения
+	PVZNearlyFull *prometheus.CounterVec
+	// ReceptionAutoClosed считает приемки, закрытые фоновой задачей по таймауту
+	ReceptionAutoClosed prometheus.Counter
 }
 
 func NewMetrics() *Metrics {
@@ -43,6 +55,13 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"method", "endpoint"},
 		),
+		DeprecatedRequestCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "http_deprecated_requests_total",
+				Help: "Total number of HTTP requests to deprecated routes",
+			},
+			[]string{"method", "endpoint"},
+		),
 		GRPCRequestCount: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "grpc_requests_total",
@@ -76,17 +95,41 @@ func NewMetrics() *Metrics {
 				Help: "Total number of added products",
 			},
 		),
+		ProductStatusChanged: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "product_status_changed_total",
+				Help: "Total number of product status changes by new status",
+			},
+			[]string{"status"},
+		),
+		PVZNearlyFull: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "pvz_nearly_full_total",
+				Help: "Total number of times PVZs reached the nearly full threshold by city",
+			},
+			[]string{"city"},
+		),
+		ReceptionAutoClosed: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "reception_auto_closed_total",
+				Help: "Total number of receptions closed by timeout",
+			},
+		),
 	}
 
 	// Регистрация метрик
 	prometheus.MustRegister(
 		metrics.RequestCount,
 		metrics.RequestDuration,
+		metrics.DeprecatedRequestCount,
 		metrics.GRPCRequestCount,
 		metrics.GRPCRequestDuration,
 		metrics.PVZCreated,
 		metrics.ReceptionCreated,
 		metrics.ProductAdded,
+		metrics.ProductStatusChanged,
+		metrics.PVZNearlyFull,
+		metrics.ReceptionAutoClosed,
 	)
 
 	return metrics
@@ -104,6 +147,18 @@ func (m *Metrics) IncProductAdded() {
 	m.ProductAdded.Inc()
 }
 
+func (m *Metrics) IncProductStatusChanged(status string) {
+	m.ProductStatusChanged.WithLabelValues(status).Inc()
+}
+
+func (m *Metrics) IncPVZNearlyFull(city string) {
+	m.PVZNearlyFull.WithLabelValues(city).Inc()
+}
+
+func (m *Metrics) AddReceptionAutoClosed(count int) {
+	m.ReceptionAutoClosed.Add(float64(count))
+}
+
 func (m *Metrics) ObserveRequestDuration(method, endpoint string, duration float64) {
 	m.RequestDuration.WithLabelValues(method, endpoint).Observe(duration)
 }
@@ -119,3 +174,7 @@ func (m *Metrics) ObserveGRPCRequestDuration(method string, duration float64) {
 func (m *Metrics) IncGRPCRequestCount(method, status string) {
 	m.GRPCRequestCount.WithLabelValues(method, status).Inc()
 }
+
+func (m *Metrics) IncDeprecatedRequestCount(method, endpoint string) {
+	m.DeprecatedRequestCount.WithLabelValues(method, endpoint).Inc()
+}
@@ -48,6 +48,39 @@ func TestMetrics(t *testing.T) {
 		assert.Equal(t, float64(4), metric.Counter.GetValue())
 	})
 
+	t.Run("Product Status Metrics", func(t *testing.T) {
+		// Проверяем счетчик выданных товаров
+		metrics.IncProductStatusChanged("issued")
+		metrics.IncProductStatusChanged("issued")
+		metrics.IncProductStatusChanged("refused")
+
+		metric := &dto.Metric{}
+		err := metrics.ProductStatusChanged.WithLabelValues("issued").Write(metric)
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), metric.Counter.GetValue())
+	})
+
+	t.Run("PVZ Nearly Full Metrics", func(t *testing.T) {
+		// Проверяем счетчик сигналов о заполнении ПВЗ
+		metrics.IncPVZNearlyFull("Москва")
+
+		metric := &dto.Metric{}
+		err := metrics.PVZNearlyFull.WithLabelValues("Москва").Write(metric)
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), metric.Counter.GetValue())
+	})
+
+	t.Run("Reception Auto Close Metrics", func(t *testing.T) {
+		// Проверяем счетчик приемок, закрытых по таймауту
+		metrics.AddReceptionAutoClosed(3)
+		metrics.AddReceptionAutoClosed(0)
+
+		metric := &dto.Metric{}
+		err := metrics.ReceptionAutoClosed.Write(metric)
+		require.NoError(t, err)
+		assert.Equal(t, float64(3), metric.Counter.GetValue())
+	})
+
 	t.Run("HTTP Request Metrics", func(t *testing.T) {
 		// Проверяем метрики HTTP запросов
 		method := "GET"
@@ -71,6 +104,17 @@ func TestMetrics(t *testing.T) {
 		assert.NotNil(t, histogram)
 	})
 
+	t.Run("Deprecated Request Metrics", func(t *testing.T) {
+		// Проверяем счетчик обращений к устаревшим путям
+		metrics.IncDeprecatedRequestCount("POST", "/receptions")
+		metrics.IncDeprecatedRequestCount("POST", "/receptions")
+
+		metric := &dto.Metric{}
+		err := metrics.DeprecatedRequestCount.WithLabelValues("POST", "/receptions").Write(metric)
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), metric.Counter.GetValue())
+	})
+
 	t.Run("gRPC Request Metrics", func(t *testing.T) {
 		// Проверяем метрики gRPC запросов
 		method := "CreatePVZ"
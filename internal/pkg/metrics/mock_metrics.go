@@ -12,6 +12,12 @@ func (m *MockMetrics) IncReceptionCreated() {}
 
 func (m *MockMetrics) IncProductAdded() {}
 
+func (m *MockMetrics) IncProductStatusChanged(status string) {}
+
+func (m *MockMetrics) IncPVZNearlyFull(city string) {}
+
+func (m *MockMetrics) AddReceptionAutoClosed(count int) {}
+
 func (m *MockMetrics) ObserveRequestDuration(method, endpoint string, duration float64) {}
 
 func (m *MockMetrics) IncRequestCount(method, endpoint, status string) {}
@@ -19,3 +25,5 @@ func (m *MockMetrics) IncRequestCount(method, endpoint, status string) {}
 func (m *MockMetrics) ObserveGRPCRequestDuration(method string, duration float64) {}
 
 func (m *MockMetrics) IncGRPCRequestCount(method, status string) {}
+
+func (m *MockMetrics) IncDeprecatedRequestCount(method, endpoint string) {}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../domain/repository/catalog_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// MockCatalogRepository is a mock of CatalogRepository interface.
+type MockCatalogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogRepositoryMockRecorder
+}
+
+// MockCatalogRepositoryMockRecorder is the mock recorder for MockCatalogRepository.
+type MockCatalogRepositoryMockRecorder struct {
+	mock *MockCatalogRepository
+}
+
+// NewMockCatalogRepository creates a new mock instance.
+func NewMockCatalogRepository(ctrl *gomock.Controller) *MockCatalogRepository {
+	mock := &MockCatalogRepository{ctrl: ctrl}
+	mock.recorder = &MockCatalogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogRepository) EXPECT() *MockCatalogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCatalogRepository) Create(ctx context.Context, kind models.CatalogKind, entry *models.CatalogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, kind, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCatalogRepositoryMockRecorder) Create(ctx, kind, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCatalogRepository)(nil).Create), ctx, kind, entry)
+}
+
+// List mocks base method.
+func (m *MockCatalogRepository) List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, kind)
+	ret0, _ := ret[0].([]*models.CatalogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCatalogRepositoryMockRecorder) List(ctx, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCatalogRepository)(nil).List), ctx, kind)
+}
+
+// SetActive mocks base method.
+func (m *MockCatalogRepository) SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, kind, name, active)
+	ret0, _ := ret[0].(*models.CatalogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockCatalogRepositoryMockRecorder) SetActive(ctx, kind, name, active interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockCatalogRepository)(nil).SetActive), ctx, kind, name, active)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../domain/repository/idempotency_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyRepository) Complete(ctx context.Context, record *models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyRepositoryMockRecorder) Complete(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyRepository)(nil).Complete), ctx, record)
+}
+
+// Delete mocks base method.
+func (m *MockIdempotencyRepository) Delete(ctx context.Context, record *models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyRepositoryMockRecorder) Delete(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyRepository)(nil).Delete), ctx, record)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpired), ctx, before)
+}
+
+// Get mocks base method.
+func (m *MockIdempotencyRepository) Get(ctx context.Context, userID uuid.UUID, key string) (*models.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, key)
+	ret0, _ := ret[0].(*models.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIdempotencyRepositoryMockRecorder) Get(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIdempotencyRepository)(nil).Get), ctx, userID, key)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, record)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyRepositoryMockRecorder) Reserve(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyRepository)(nil).Reserve), ctx, record)
+}
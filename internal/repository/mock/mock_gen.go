@@ -4,3 +4,8 @@ package mock
 //go:generate mockgen -source=../../domain/repository/pvz_repository.go -destination=pvz_repository_mock.go -package=mock
 //go:generate mockgen -source=../../domain/repository/reception_repository.go -destination=reception_repository_mock.go -package=mock
 //go:generate mockgen -source=../../domain/repository/product_repository.go -destination=product_repository_mock.go -package=mock
+//go:generate mockgen -source=../../domain/repository/catalog_repository.go -destination=catalog_repository_mock.go -package=mock
+//go:generate mockgen -source=../../domain/repository/storage_cell_repository.go -destination=storage_cell_repository_mock.go -package=mock
+//go:generate mockgen -source=../../domain/repository/idempotency_repository.go -destination=idempotency_repository_mock.go -package=mock
+//go:generate mockgen -source=../../domain/repository/pvz_assignment_repository.go -destination=pvz_assignment_repository_mock.go -package=mock
+//go:generate mockgen -source=../../domain/repository/transactor.go -destination=transactor_mock.go -package=mock
@@ -50,6 +50,20 @@ func (mr *MockProductRepositoryMockRecorder) Create(ctx, product interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductRepository)(nil).Create), ctx, product)
 }
 
+// CreateBatch mocks base method.
+func (m *MockProductRepository) CreateBatch(ctx context.Context, products []*models.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, products)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockProductRepositoryMockRecorder) CreateBatch(ctx, products interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockProductRepository)(nil).CreateBatch), ctx, products)
+}
+
 // Delete mocks base method.
 func (m *MockProductRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -79,6 +93,36 @@ func (mr *MockProductRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProductRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByReceptionIDAndBarcode mocks base method.
+func (m *MockProductRepository) GetByReceptionIDAndBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReceptionIDAndBarcode", ctx, receptionID, barcode)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReceptionIDAndBarcode indicates an expected call of GetByReceptionIDAndBarcode.
+func (mr *MockProductRepositoryMockRecorder) GetByReceptionIDAndBarcode(ctx, receptionID, barcode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReceptionIDAndBarcode", reflect.TypeOf((*MockProductRepository)(nil).GetByReceptionIDAndBarcode), ctx, receptionID, barcode)
+}
+
+// GetLastByBarcode mocks base method.
+func (m *MockProductRepository) GetLastByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastByBarcode indicates an expected call of GetLastByBarcode.
+func (mr *MockProductRepositoryMockRecorder) GetLastByBarcode(ctx, barcode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastByBarcode", reflect.TypeOf((*MockProductRepository)(nil).GetLastByBarcode), ctx, barcode)
+}
+
 // GetLastByReceptionID mocks base method.
 func (m *MockProductRepository) GetLastByReceptionID(ctx context.Context, receptionID uuid.UUID) (*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +138,21 @@ func (mr *MockProductRepositoryMockRecorder) GetLastByReceptionID(ctx, reception
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastByReceptionID", reflect.TypeOf((*MockProductRepository)(nil).GetLastByReceptionID), ctx, receptionID)
 }
 
+// ListByCellID mocks base method.
+func (m *MockProductRepository) ListByCellID(ctx context.Context, cellID uuid.UUID, statuses []models.ProductStatus) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCellID", ctx, cellID, statuses)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCellID indicates an expected call of ListByCellID.
+func (mr *MockProductRepositoryMockRecorder) ListByCellID(ctx, cellID, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCellID", reflect.TypeOf((*MockProductRepository)(nil).ListByCellID), ctx, cellID, statuses)
+}
+
 // ListByReceptionID mocks base method.
 func (m *MockProductRepository) ListByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +167,32 @@ func (mr *MockProductRepositoryMockRecorder) ListByReceptionID(ctx, receptionID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReceptionID", reflect.TypeOf((*MockProductRepository)(nil).ListByReceptionID), ctx, receptionID)
 }
+
+// ListShelf mocks base method.
+func (m *MockProductRepository) ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShelf", ctx, pvzID, statuses, page, limit)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShelf indicates an expected call of ListShelf.
+func (mr *MockProductRepositoryMockRecorder) ListShelf(ctx, pvzID, statuses, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShelf", reflect.TypeOf((*MockProductRepository)(nil).ListShelf), ctx, pvzID, statuses, page, limit)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockProductRepository) UpdateStatus(ctx context.Context, product *models.Product, from models.ProductStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, product, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockProductRepositoryMockRecorder) UpdateStatus(ctx, product, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockProductRepository)(nil).UpdateStatus), ctx, product, from)
+}
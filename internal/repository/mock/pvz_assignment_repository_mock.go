@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../domain/repository/pvz_assignment_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// MockPVZAssignmentRepository is a mock of PVZAssignmentRepository interface.
+type MockPVZAssignmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPVZAssignmentRepositoryMockRecorder
+}
+
+// MockPVZAssignmentRepositoryMockRecorder is the mock recorder for MockPVZAssignmentRepository.
+type MockPVZAssignmentRepositoryMockRecorder struct {
+	mock *MockPVZAssignmentRepository
+}
+
+// NewMockPVZAssignmentRepository creates a new mock instance.
+func NewMockPVZAssignmentRepository(ctrl *gomock.Controller) *MockPVZAssignmentRepository {
+	mock := &MockPVZAssignmentRepository{ctrl: ctrl}
+	mock.recorder = &MockPVZAssignmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPVZAssignmentRepository) EXPECT() *MockPVZAssignmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPVZAssignmentRepository) Create(ctx context.Context, assignment *models.PVZAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, assignment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPVZAssignmentRepositoryMockRecorder) Create(ctx, assignment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPVZAssignmentRepository)(nil).Create), ctx, assignment)
+}
+
+// Delete mocks base method.
+func (m *MockPVZAssignmentRepository) Delete(ctx context.Context, userID, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPVZAssignmentRepositoryMockRecorder) Delete(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPVZAssignmentRepository)(nil).Delete), ctx, userID, pvzID)
+}
+
+// Exists mocks base method.
+func (m *MockPVZAssignmentRepository) Exists(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, userID, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockPVZAssignmentRepositoryMockRecorder) Exists(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockPVZAssignmentRepository)(nil).Exists), ctx, userID, pvzID)
+}
+
+// ListByPVZID mocks base method.
+func (m *MockPVZAssignmentRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPVZID", ctx, pvzID)
+	ret0, _ := ret[0].([]*models.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPVZID indicates an expected call of ListByPVZID.
+func (mr *MockPVZAssignmentRepositoryMockRecorder) ListByPVZID(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPVZID", reflect.TypeOf((*MockPVZAssignmentRepository)(nil).ListByPVZID), ctx, pvzID)
+}
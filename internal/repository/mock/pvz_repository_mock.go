@@ -52,18 +52,18 @@ func (mr *MockPVZRepositoryMockRecorder) Create(ctx, pvz interface{}) *gomock.Ca
 }
 
 // GetAll mocks base method.
-func (m *MockPVZRepository) GetAll(ctx context.Context) ([]*models.PVZ, error) {
+func (m *MockPVZRepository) GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*models.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockPVZRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+func (mr *MockPVZRepositoryMockRecorder) GetAll(ctx, includeArchived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPVZRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPVZRepository)(nil).GetAll), ctx, includeArchived)
 }
 
 // GetByID mocks base method.
@@ -82,16 +82,87 @@ func (mr *MockPVZRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Ca
 }
 
 // List mocks base method.
-func (m *MockPVZRepository) List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*models.PVZ, error) {
+func (m *MockPVZRepository) List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool) ([]*models.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, startDate, endDate, page, limit)
+	ret := m.ctrl.Call(m, "List", ctx, startDate, endDate, page, limit, includeArchived)
 	ret0, _ := ret[0].([]*models.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockPVZRepositoryMockRecorder) List(ctx, startDate, endDate, page, limit interface{}) *gomock.Call {
+func (mr *MockPVZRepositoryMockRecorder) List(ctx, startDate, endDate, page, limit, includeArchived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPVZRepository)(nil).List), ctx, startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPVZRepository)(nil).List), ctx, startDate, endDate, page, limit, includeArchived)
+}
+
+// ListNearest mocks base method.
+func (m *MockPVZRepository) ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearest", ctx, point, radius, limit)
+	ret0, _ := ret[0].([]*models.NearbyPVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearest indicates an expected call of ListNearest.
+func (mr *MockPVZRepositoryMockRecorder) ListNearest(ctx, point, radius, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearest", reflect.TypeOf((*MockPVZRepository)(nil).ListNearest), ctx, point, radius, limit)
+}
+
+// Occupy mocks base method.
+func (m *MockPVZRepository) Occupy(ctx context.Context, pvz *models.PVZ, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occupy", ctx, pvz, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Occupy indicates an expected call of Occupy.
+func (mr *MockPVZRepositoryMockRecorder) Occupy(ctx, pvz, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occupy", reflect.TypeOf((*MockPVZRepository)(nil).Occupy), ctx, pvz, count)
+}
+
+// Release mocks base method.
+func (m *MockPVZRepository) Release(ctx context.Context, id uuid.UUID, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPVZRepositoryMockRecorder) Release(ctx, id, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPVZRepository)(nil).Release), ctx, id, count)
+}
+
+// UpdateCapacity mocks base method.
+func (m *MockPVZRepository) UpdateCapacity(ctx context.Context, pvz *models.PVZ) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCapacity", ctx, pvz)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCapacity indicates an expected call of UpdateCapacity.
+func (mr *MockPVZRepositoryMockRecorder) UpdateCapacity(ctx, pvz interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacity", reflect.TypeOf((*MockPVZRepository)(nil).UpdateCapacity), ctx, pvz)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPVZRepository) UpdateStatus(ctx context.Context, pvz *models.PVZ, from models.PVZStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, pvz, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPVZRepositoryMockRecorder) UpdateStatus(ctx, pvz, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPVZRepository)(nil).UpdateStatus), ctx, pvz, from)
 }
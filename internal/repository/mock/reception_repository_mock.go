@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,35 @@ func (m *MockReceptionRepository) EXPECT() *MockReceptionRepositoryMockRecorder
 	return m.recorder
 }
 
+// AddItems mocks base method.
+func (m *MockReceptionRepository) AddItems(ctx context.Context, reception *models.Reception, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItems", ctx, reception, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItems indicates an expected call of AddItems.
+func (mr *MockReceptionRepositoryMockRecorder) AddItems(ctx, reception, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItems", reflect.TypeOf((*MockReceptionRepository)(nil).AddItems), ctx, reception, count)
+}
+
+// CloseStale mocks base method.
+func (m *MockReceptionRepository) CloseStale(ctx context.Context, idleSince time.Time, limit uint64) ([]*models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseStale", ctx, idleSince, limit)
+	ret0, _ := ret[0].([]*models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseStale indicates an expected call of CloseStale.
+func (mr *MockReceptionRepositoryMockRecorder) CloseStale(ctx, idleSince, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseStale", reflect.TypeOf((*MockReceptionRepository)(nil).CloseStale), ctx, idleSince, limit)
+}
+
 // Create mocks base method.
 func (m *MockReceptionRepository) Create(ctx context.Context, reception *models.Reception) error {
 	m.ctrl.T.Helper()
@@ -96,18 +126,32 @@ func (mr *MockReceptionRepositoryMockRecorder) GetLastOpenByPVZID(ctx, pvzID int
 }
 
 // ListByPVZID mocks base method.
-func (m *MockReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.Reception, error) {
+func (m *MockReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID, kind models.ReceptionKind) ([]*models.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByPVZID", ctx, pvzID)
+	ret := m.ctrl.Call(m, "ListByPVZID", ctx, pvzID, kind)
 	ret0, _ := ret[0].([]*models.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByPVZID indicates an expected call of ListByPVZID.
-func (mr *MockReceptionRepositoryMockRecorder) ListByPVZID(ctx, pvzID interface{}) *gomock.Call {
+func (mr *MockReceptionRepositoryMockRecorder) ListByPVZID(ctx, pvzID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPVZID", reflect.TypeOf((*MockReceptionRepository)(nil).ListByPVZID), ctx, pvzID, kind)
+}
+
+// RemoveItems mocks base method.
+func (m *MockReceptionRepository) RemoveItems(ctx context.Context, id uuid.UUID, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItems", ctx, id, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItems indicates an expected call of RemoveItems.
+func (mr *MockReceptionRepositoryMockRecorder) RemoveItems(ctx, id, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPVZID", reflect.TypeOf((*MockReceptionRepository)(nil).ListByPVZID), ctx, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItems", reflect.TypeOf((*MockReceptionRepository)(nil).RemoveItems), ctx, id, count)
 }
 
 // Update mocks base method.
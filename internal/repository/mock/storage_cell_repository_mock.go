@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../domain/repository/storage_cell_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/smthjapanese/avito_pvz/internal/domain/models"
+)
+
+// MockStorageCellRepository is a mock of StorageCellRepository interface.
+type MockStorageCellRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellRepositoryMockRecorder
+}
+
+// MockStorageCellRepositoryMockRecorder is the mock recorder for MockStorageCellRepository.
+type MockStorageCellRepositoryMockRecorder struct {
+	mock *MockStorageCellRepository
+}
+
+// NewMockStorageCellRepository creates a new mock instance.
+func NewMockStorageCellRepository(ctrl *gomock.Controller) *MockStorageCellRepository {
+	mock := &MockStorageCellRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageCellRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellRepository) EXPECT() *MockStorageCellRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStorageCellRepository) Create(ctx context.Context, cell *models.StorageCell) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cell)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStorageCellRepositoryMockRecorder) Create(ctx, cell interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStorageCellRepository)(nil).Create), ctx, cell)
+}
+
+// GetByID mocks base method.
+func (m *MockStorageCellRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockStorageCellRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockStorageCellRepository)(nil).GetByID), ctx, id)
+}
+
+// ListByPVZID mocks base method.
+func (m *MockStorageCellRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPVZID", ctx, pvzID)
+	ret0, _ := ret[0].([]*models.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPVZID indicates an expected call of ListByPVZID.
+func (mr *MockStorageCellRepositoryMockRecorder) ListByPVZID(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPVZID", reflect.TypeOf((*MockStorageCellRepository)(nil).ListByPVZID), ctx, pvzID)
+}
+
+// Occupy mocks base method.
+func (m *MockStorageCellRepository) Occupy(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occupy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Occupy indicates an expected call of Occupy.
+func (mr *MockStorageCellRepositoryMockRecorder) Occupy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occupy", reflect.TypeOf((*MockStorageCellRepository)(nil).Occupy), ctx, id)
+}
+
+// OccupyFree mocks base method.
+func (m *MockStorageCellRepository) OccupyFree(ctx context.Context, pvzID uuid.UUID) (*models.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OccupyFree", ctx, pvzID)
+	ret0, _ := ret[0].(*models.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OccupyFree indicates an expected call of OccupyFree.
+func (mr *MockStorageCellRepositoryMockRecorder) OccupyFree(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OccupyFree", reflect.TypeOf((*MockStorageCellRepository)(nil).OccupyFree), ctx, pvzID)
+}
+
+// Release mocks base method.
+func (m *MockStorageCellRepository) Release(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockStorageCellRepositoryMockRecorder) Release(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockStorageCellRepository)(nil).Release), ctx, id)
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+var catalogColumns = []string{"name", "active", "created_at", "updated_at"}
+
+// catalogTables сопоставляет вид справочника с таблицей
+var catalogTables = map[models.CatalogKind]string{
+	models.CatalogCities:       "cities",
+	models.CatalogProductTypes: "product_types",
+}
+
+type CatalogRepository struct {
+	db *database.Database
+	sb squirrel.StatementBuilderType
+}
+
+func NewCatalogRepository(db *database.Database) repository.CatalogRepository {
+	return &CatalogRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *CatalogRepository) List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error) {
+	table, err := catalogTable(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.sb.Select(catalogColumns...).
+		From(table).
+		OrderBy("name ASC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.CatalogEntry
+	for rows.Next() {
+		entry, err := scanCatalogEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *CatalogRepository) Create(ctx context.Context, kind models.CatalogKind, entry *models.CatalogEntry) error {
+	table, err := catalogTable(kind)
+	if err != nil {
+		return err
+	}
+
+	query := r.sb.Insert(table).
+		Columns(catalogColumns...).
+		Values(entry.Name, entry.Active, entry.CreatedAt, entry.UpdatedAt)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return errors.ErrCatalogEntryExists
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// SetActive вводит значение в оборот или выводит из него и возвращает обновленную запись
+func (r *CatalogRepository) SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error) {
+	table, err := catalogTable(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.sb.Update(table).
+		Set("active", active).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"name": name}).
+		Suffix("RETURNING name, active, created_at, updated_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	entry, err := scanCatalogEntry(r.db.QueryRowContext(ctx, sql, args...))
+	if err != nil {
+		if errors.IsNoRows(err) {
+			return nil, errors.ErrCatalogEntryNotFound
+		}
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to update catalog entry: %v", err))
+	}
+
+	return entry, nil
+}
+
+func catalogTable(kind models.CatalogKind) (string, error) {
+	table, ok := catalogTables[kind]
+	if !ok {
+		return "", errors.ErrCatalogNotFound
+	}
+	return table, nil
+}
+
+func scanCatalogEntry(row rowScanner) (*models.CatalogEntry, error) {
+	var entry models.CatalogEntry
+	err := row.Scan(
+		&entry.Name,
+		&entry.Active,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+func TestCatalogRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	now := time.Now()
+	rows := sqlmock.NewRows(catalogColumns).
+		AddRow("Казань", true, now, now).
+		AddRow("Тверь", false, now, now)
+
+	mock.ExpectQuery("SELECT name, active, created_at, updated_at FROM cities ORDER BY name ASC").
+		WillReturnRows(rows)
+
+	entries, err := repo.List(context.Background(), models.CatalogCities)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Казань", entries[0].Name)
+	assert.True(t, entries[0].Active)
+	assert.False(t, entries[1].Active)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestCatalogRepository_List_UnknownCatalog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	_, err = repo.List(context.Background(), models.CatalogKind("countries"))
+	assert.ErrorIs(t, err, errors.ErrCatalogNotFound)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestCatalogRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	entry := models.NewCatalogEntry("бытовая техника")
+
+	mock.ExpectExec("INSERT INTO product_types").
+		WithArgs(entry.Name, entry.Active, entry.CreatedAt, entry.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(context.Background(), models.CatalogProductTypes, entry)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestCatalogRepository_Create_AlreadyExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	entry := models.NewCatalogEntry("Казань")
+
+	mock.ExpectExec("INSERT INTO cities").
+		WithArgs(entry.Name, entry.Active, entry.CreatedAt, entry.UpdatedAt).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Create(context.Background(), models.CatalogCities, entry)
+	assert.ErrorIs(t, err, errors.ErrCatalogEntryExists)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestCatalogRepository_SetActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	now := time.Now()
+	rows := sqlmock.NewRows(catalogColumns).AddRow("Казань", false, now, now)
+
+	mock.ExpectQuery("UPDATE cities SET active = \\$1, updated_at = CURRENT_TIMESTAMP WHERE name = \\$2 RETURNING").
+		WithArgs(false, "Казань").
+		WillReturnRows(rows)
+
+	entry, err := repo.SetActive(context.Background(), models.CatalogCities, "Казань", false)
+	require.NoError(t, err)
+	assert.Equal(t, "Казань", entry.Name)
+	assert.False(t, entry.Active)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestCatalogRepository_SetActive_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCatalogRepository(&database.Database{DB: db})
+
+	mock.ExpectQuery("UPDATE product_types").
+		WithArgs(true, "мебель").
+		WillReturnError(errors.ErrNoRows)
+
+	_, err = repo.SetActive(context.Background(), models.CatalogProductTypes, "мебель", true)
+	assert.ErrorIs(t, err, errors.ErrCatalogEntryNotFound)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
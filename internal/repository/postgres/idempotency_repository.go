@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+var idempotencyColumns = []string{"user_id", "key", "request_hash", "status_code", "response", "completed_at", "created_at", "expires_at"}
+
+type IdempotencyRepository struct {
+	db *database.Database
+	sb squirrel.StatementBuilderType
+}
+
+func NewIdempotencyRepository(db *database.Database) repository.IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+// Reserve вставляет запись или перезаписывает истекшую одним запросом,
+// поэтому из одновременных запросов с одним ключом выполняется только один.
+// В record.CreatedAt возвращается время резервирования в точности БД: по нему
+// Complete и Delete отличают свою резервацию от перезаписавшей ее после истечения.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error) {
+	query := r.sb.Insert("idempotency_keys").
+		Columns("user_id", "key", "request_hash", "created_at", "expires_at").
+		Values(record.UserID, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt).
+		Suffix(`ON CONFLICT (user_id, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = 0,
+			response = NULL,
+			completed_at = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+		RETURNING created_at`)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&record.CreatedAt); err != nil {
+		if errors.IsNoRows(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to reserve idempotency key: %v", err))
+	}
+
+	return true, nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, userID uuid.UUID, key string) (*models.IdempotencyRecord, error) {
+	query := r.sb.Select(idempotencyColumns...).
+		From("idempotency_keys").
+		Where(squirrel.Eq{"user_id": userID, "key": key})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var record models.IdempotencyRecord
+	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
+		&record.UserID,
+		&record.Key,
+		&record.RequestHash,
+		&record.StatusCode,
+		&record.Response,
+		&record.CompletedAt,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.IsNoRows(err) {
+			return nil, errors.ErrIdempotencyKeyNotFound
+		}
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get idempotency key: %v", err))
+	}
+
+	return &record, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, record *models.IdempotencyRecord) error {
+	query := r.sb.Update("idempotency_keys").
+		Set("status_code", record.StatusCode).
+		Set("response", record.Response).
+		Set("completed_at", record.CompletedAt).
+		Set("expires_at", record.ExpiresAt).
+		Where(squirrel.Eq{"user_id": record.UserID, "key": record.Key, "created_at": record.CreatedAt})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// Delete удаляет только незавершенную резервацию record: если она истекла и ключ занял
+// другой запрос, его запись остается
+func (r *IdempotencyRepository) Delete(ctx context.Context, record *models.IdempotencyRecord) error {
+	query := r.sb.Delete("idempotency_keys").
+		Where(squirrel.Eq{
+			"user_id":      record.UserID,
+			"key":          record.Key,
+			"created_at":   record.CreatedAt,
+			"completed_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	query := r.sb.Delete("idempotency_keys").
+		Where(squirrel.LtOrEq{"expires_at": before})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+func TestIdempotencyRepository_Reserve(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdempotencyRepository(&database.Database{DB: db})
+
+	record := models.NewIdempotencyRecord(uuid.New(), "scan-42", "hash", time.Hour)
+
+	// БД хранит время с точностью до микросекунд
+	storedCreatedAt := record.CreatedAt.Truncate(time.Microsecond)
+
+	mock.ExpectQuery(`INSERT INTO idempotency_keys (.+) ON CONFLICT \(user_id, key\) DO UPDATE (.+) WHERE idempotency_keys.expires_at <= EXCLUDED.created_at RETURNING created_at`).
+		WithArgs(record.UserID, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(storedCreatedAt))
+
+	reserved, err := repo.Reserve(context.Background(), record)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	assert.Equal(t, storedCreatedAt, record.CreatedAt)
+
+	// Ключ занят действующей записью: строка не возвращается
+	mock.ExpectQuery("INSERT INTO idempotency_keys").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+	reserved, err = repo.Reserve(context.Background(), record)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdempotencyRepository(&database.Database{DB: db})
+
+	record := models.NewIdempotencyRecord(uuid.New(), "scan-42", "hash", time.Hour)
+	record.Complete(201, []byte(`{"id":"1"}`), 24*time.Hour)
+
+	mock.ExpectQuery(`SELECT (.+) FROM idempotency_keys WHERE key = \$1 AND user_id = \$2`).
+		WithArgs(record.Key, record.UserID).
+		WillReturnRows(sqlmock.NewRows(idempotencyColumns).AddRow(
+			record.UserID, record.Key, record.RequestHash, record.StatusCode, record.Response,
+			record.CompletedAt, record.CreatedAt, record.ExpiresAt,
+		))
+
+	found, err := repo.Get(context.Background(), record.UserID, record.Key)
+	require.NoError(t, err)
+	assert.Equal(t, record.RequestHash, found.RequestHash)
+	assert.Equal(t, 201, found.StatusCode)
+	assert.Equal(t, record.Response, found.Response)
+	assert.True(t, found.IsCompleted())
+
+	mock.ExpectQuery("SELECT (.+) FROM idempotency_keys").
+		WillReturnRows(sqlmock.NewRows(idempotencyColumns))
+
+	_, err = repo.Get(context.Background(), record.UserID, record.Key)
+	assert.ErrorIs(t, err, errors.ErrIdempotencyKeyNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyRepository_Complete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdempotencyRepository(&database.Database{DB: db})
+
+	record := models.NewIdempotencyRecord(uuid.New(), "scan-42", "hash", time.Minute)
+	record.Complete(201, []byte(`{}`), time.Hour)
+
+	mock.ExpectExec(`UPDATE idempotency_keys SET (.+) WHERE created_at = \$5 AND key = \$6 AND user_id = \$7`).
+		WithArgs(record.StatusCode, record.Response, record.CompletedAt, record.ExpiresAt, record.CreatedAt, record.Key, record.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Complete(context.Background(), record))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdempotencyRepository(&database.Database{DB: db})
+
+	record := models.NewIdempotencyRecord(uuid.New(), "scan-42", "hash", time.Minute)
+
+	// Удаляется только своя незавершенная резервация, а не запись запроса, занявшего истекший ключ
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE completed_at IS NULL AND created_at = \$1 AND key = \$2 AND user_id = \$3`).
+		WithArgs(record.CreatedAt, record.Key, record.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, repo.Delete(context.Background(), record))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyRepository_DeleteExpired(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdempotencyRepository(&database.Database{DB: db})
+
+	before := time.Now()
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE expires_at <= \$1`).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	deleted, err := repo.DeleteExpired(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
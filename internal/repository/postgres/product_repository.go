@@ -12,6 +12,11 @@ import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 )
 
+var productColumns = []string{
+	"id", "date_time", "type", "reception_id", "order_id", "barcode", "status", "status_changed_at",
+	"original_product_id", "return_reason", "cell_id", "created_at",
+}
+
 type ProductRepository struct {
 	db *database.Database
 	sb squirrel.StatementBuilderType
@@ -25,57 +30,184 @@ func NewProductRepository(db *database.Database) repository.ProductRepository {
 }
 
 func (r *ProductRepository) Create(ctx context.Context, product *models.Product) error {
-	query := r.sb.Insert("products").
-		Columns("id", "date_time", "type", "reception_id").
-		Values(product.ID, product.DateTime, product.Type, product.ReceptionID)
-
-	sql, args, err := query.ToSql()
+	sql, args, err := r.insert([]*models.Product{product}).ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build SQL: %w", err)
 	}
 
 	_, err = r.db.ExecContext(ctx, sql, args...)
 	if err != nil {
+		// Уникальный индекс по штрихкоду в приемке страхует от одновременных сканирований
+		if database.IsUniqueViolation(err) {
+			return errors.ErrDuplicateBarcode
+		}
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
 	return nil
 }
 
+// CreateBatch вставляет товары одним многострочным INSERT.
+// При любой ошибке, в том числе повторе штрихкода, не сохраняется ни один товар.
+func (r *ProductRepository) CreateBatch(ctx context.Context, products []*models.Product) error {
+	sql, args, err := r.insert(products).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, sql, args...); err != nil {
+		if database.IsUniqueViolation(err) {
+			return errors.ErrDuplicateBarcode
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// insert строит INSERT со строкой на каждый товар
+func (r *ProductRepository) insert(products []*models.Product) squirrel.InsertBuilder {
+	query := r.sb.Insert("products").
+		Columns("id", "date_time", "type", "reception_id", "order_id", "barcode", "status", "status_changed_at",
+			"original_product_id", "return_reason", "cell_id")
+	for _, product := range products {
+		query = query.Values(product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode,
+			product.Status, product.StatusChangedAt, product.OriginalProductID, product.ReturnReason, product.CellID)
+	}
+	return query
+}
+
 func (r *ProductRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Product, error) {
-	query := r.sb.Select("id", "date_time", "type", "reception_id", "created_at").
+	query := r.sb.Select(productColumns...).
 		From("products").
 		Where(squirrel.Eq{"id": id})
 
+	return r.getOne(ctx, query, "failed to get product by ID")
+}
+
+func (r *ProductRepository) ListByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]*models.Product, error) {
+	query := r.sb.Select(productColumns...).
+		From("products").
+		Where(squirrel.Eq{"reception_id": receptionID}).
+		OrderBy("date_time ASC")
+
+	return r.list(ctx, query)
+}
+
+// ListShelf возвращает товары ПВЗ в состояниях statuses, принятые раньше - первыми
+func (r *ProductRepository) ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error) {
+	receptions := squirrel.Select("id").
+		From("receptions").
+		Where(squirrel.Eq{"pvz_id": pvzID})
+
+	offset := (page - 1) * limit
+	query := r.sb.Select(productColumns...).
+		From("products").
+		Where(squirrel.Expr("reception_id IN (?)", receptions)).
+		Where(squirrel.Eq{"status": statuses}).
+		OrderBy("date_time ASC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	return r.list(ctx, query)
+}
+
+func (r *ProductRepository) GetLastByReceptionID(ctx context.Context, receptionID uuid.UUID) (*models.Product, error) {
+	query := r.sb.Select(productColumns...).
+		From("products").
+		Where(squirrel.Eq{"reception_id": receptionID}).
+		OrderBy("date_time DESC").
+		Limit(1)
+
+	return r.getOne(ctx, query, "failed to get last product for reception")
+}
+
+// GetByReceptionIDAndBarcode ищет товар с указанным штрихкодом в приемке
+func (r *ProductRepository) GetByReceptionIDAndBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*models.Product, error) {
+	query := r.sb.Select(productColumns...).
+		From("products").
+		Where(squirrel.Eq{"reception_id": receptionID, "barcode": barcode})
+
+	return r.getOne(ctx, query, "failed to get product by barcode in reception")
+}
+
+// GetLastByBarcode возвращает последний принятый товар с указанным штрихкодом
+func (r *ProductRepository) GetLastByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	query := r.sb.Select(productColumns...).
+		From("products").
+		Where(squirrel.Eq{"barcode": barcode}).
+		OrderBy("date_time DESC").
+		Limit(1)
+
+	return r.getOne(ctx, query, "failed to get product by barcode")
+}
+
+func (r *ProductRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := r.sb.Delete("products").
+		Where(squirrel.Eq{"id": id})
+
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("failed to build SQL: %w", err)
+		return fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var product models.Product
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&product.ID,
-		&product.DateTime,
-		&product.Type,
-		&product.ReceptionID,
-		&product.CreatedAt,
-	)
+	result, err := r.db.ExecContext(ctx, sql, args...)
 	if err != nil {
-		if errors.IsNoRows(err) {
-			return nil, errors.ErrProductNotFound
-		}
-		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get product by ID: %v", err))
+		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
-	return &product, nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrProductNotFound
+	}
+
+	return nil
 }
 
-func (r *ProductRepository) ListByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]*models.Product, error) {
-	query := r.sb.Select("id", "date_time", "type", "reception_id", "created_at").
+// UpdateStatus сохраняет новое состояние товара, если текущее состояние в БД все еще from.
+// Иначе товар успели выдать или вернуть параллельно и переход отклоняется.
+func (r *ProductRepository) UpdateStatus(ctx context.Context, product *models.Product, from models.ProductStatus) error {
+	query := r.sb.Update("products").
+		Set("status", product.Status).
+		Set("status_changed_at", product.StatusChangedAt).
+		Where(squirrel.Eq{"id": product.ID, "status": from})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrProductStatusTransition
+	}
+
+	return nil
+}
+
+func (r *ProductRepository) ListByCellID(ctx context.Context, cellID uuid.UUID, statuses []models.ProductStatus) ([]*models.Product, error) {
+	query := r.sb.Select(productColumns...).
 		From("products").
-		Where(squirrel.Eq{"reception_id": receptionID}).
+		Where(squirrel.Eq{"cell_id": cellID, "status": statuses}).
 		OrderBy("date_time ASC")
 
+	return r.list(ctx, query)
+}
+
+func (r *ProductRepository) list(ctx context.Context, query squirrel.SelectBuilder) ([]*models.Product, error) {
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
@@ -89,18 +221,11 @@ func (r *ProductRepository) ListByReceptionID(ctx context.Context, receptionID u
 
 	var products []*models.Product
 	for rows.Next() {
-		var product models.Product
-		err := rows.Scan(
-			&product.ID,
-			&product.DateTime,
-			&product.Type,
-			&product.ReceptionID,
-			&product.CreatedAt,
-		)
+		product, err := scanProduct(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		products = append(products, &product)
+		products = append(products, product)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -110,58 +235,45 @@ func (r *ProductRepository) ListByReceptionID(ctx context.Context, receptionID u
 	return products, nil
 }
 
-func (r *ProductRepository) GetLastByReceptionID(ctx context.Context, receptionID uuid.UUID) (*models.Product, error) {
-	query := r.sb.Select("id", "date_time", "type", "reception_id", "created_at").
-		From("products").
-		Where(squirrel.Eq{"reception_id": receptionID}).
-		OrderBy("date_time DESC").
-		Limit(1)
-
+func (r *ProductRepository) getOne(ctx context.Context, query squirrel.SelectBuilder, message string) (*models.Product, error) {
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var product models.Product
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&product.ID,
-		&product.DateTime,
-		&product.Type,
-		&product.ReceptionID,
-		&product.CreatedAt,
-	)
+	product, err := scanProduct(r.db.QueryRowContext(ctx, sql, args...))
 	if err != nil {
 		if errors.IsNoRows(err) {
 			return nil, errors.ErrProductNotFound
 		}
-		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get last product for reception: %v", err))
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("%s: %v", message, err))
 	}
 
-	return &product, nil
+	return product, nil
 }
 
-func (r *ProductRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := r.sb.Delete("products").
-		Where(squirrel.Eq{"id": id})
-
-	sql, args, err := query.ToSql()
-	if err != nil {
-		return fmt.Errorf("failed to build SQL: %w", err)
-	}
-
-	result, err := r.db.ExecContext(ctx, sql, args...)
-	if err != nil {
-		return fmt.Errorf("failed to execute query: %w", err)
-	}
+type rowScanner interface {
+	Scan(dest ...any) error
+}
 
-	rowsAffected, err := result.RowsAffected()
+func scanProduct(row rowScanner) (*models.Product, error) {
+	var product models.Product
+	err := row.Scan(
+		&product.ID,
+		&product.DateTime,
+		&product.Type,
+		&product.ReceptionID,
+		&product.OrderID,
+		&product.Barcode,
+		&product.Status,
+		&product.StatusChangedAt,
+		&product.OriginalProductID,
+		&product.ReturnReason,
+		&product.CellID,
+		&product.CreatedAt,
+	)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return nil, err
 	}
-
-	if rowsAffected == 0 {
-		return fmt.Errorf("product not found")
-	}
-
-	return nil
+	return &product, nil
 }
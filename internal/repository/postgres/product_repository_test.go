@@ -2,11 +2,13 @@ package postgres
 
 import (
 	"context"
+	"database/sql/driver"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -32,7 +34,7 @@ func TestProductRepository_Create(t *testing.T) {
 	}
 
 	mock.ExpectExec("INSERT INTO products").
-		WithArgs(product.ID, product.DateTime, product.Type, product.ReceptionID).
+		WithArgs(product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode, product.Status, product.StatusChangedAt, product.OriginalProductID, product.ReturnReason, product.CellID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(context.Background(), product)
@@ -59,8 +61,8 @@ func TestProductRepository_GetByID(t *testing.T) {
 		CreatedAt:   time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "type", "reception_id", "created_at"}).
-		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.CreatedAt)
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.OrderID, expectedProduct.Barcode, expectedProduct.Status, expectedProduct.StatusChangedAt, nil, "", nil, expectedProduct.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM products").
 		WithArgs(productID).
@@ -76,6 +78,86 @@ func TestProductRepository_GetByID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestProductRepository_GetByID_CustomerReturn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	originalID := uuid.New()
+	expectedProduct := models.NewProduct(models.ProductTypeShoes, uuid.New())
+
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.OrderID, expectedProduct.Barcode, expectedProduct.Status, expectedProduct.StatusChangedAt, originalID.String(), "брак", nil, expectedProduct.CreatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM products").
+		WithArgs(expectedProduct.ID).
+		WillReturnRows(rows)
+
+	product, err := repo.GetByID(context.Background(), expectedProduct.ID)
+	require.NoError(t, err)
+	require.NotNil(t, product.OriginalProductID)
+	assert.Equal(t, originalID, *product.OriginalProductID)
+	assert.Equal(t, "брак", product.ReturnReason)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProductRepository_CreateBatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	receptionID := uuid.New()
+	first := models.NewProduct(models.ProductTypeShoes, receptionID)
+	second := models.NewProduct(models.ProductTypeClothes, receptionID)
+
+	args := func(product *models.Product) []driver.Value {
+		return []driver.Value{product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode, product.Status, product.StatusChangedAt, product.OriginalProductID, product.ReturnReason, product.CellID}
+	}
+
+	mock.ExpectExec(`INSERT INTO products \(.+\) VALUES \(\$1,.+,\$11\),\(\$12,.+,\$22\)`).
+		WithArgs(append(args(first), args(second)...)...).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.CreateBatch(context.Background(), []*models.Product{first, second})
+	require.NoError(t, err)
+
+	// Повтор штрихкода отклоняет всю пачку
+	mock.ExpectExec("INSERT INTO products").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.CreateBatch(context.Background(), []*models.Product{first, second})
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_Create_DuplicateBarcode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.Barcode = "4600000000001"
+
+	mock.ExpectExec("INSERT INTO products").
+		WithArgs(product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode, product.Status, product.StatusChangedAt, product.OriginalProductID, product.ReturnReason, product.CellID).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Create(context.Background(), product)
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
 func TestProductRepository_GetByID_NotFound(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -120,9 +202,9 @@ func TestProductRepository_ListByReceptionID(t *testing.T) {
 		CreatedAt:   time.Now().Add(-1 * time.Hour),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "type", "reception_id", "created_at"}).
-		AddRow(product1.ID, product1.DateTime, product1.Type, product1.ReceptionID, product1.CreatedAt).
-		AddRow(product2.ID, product2.DateTime, product2.Type, product2.ReceptionID, product2.CreatedAt)
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(product1.ID, product1.DateTime, product1.Type, product1.ReceptionID, product1.OrderID, product1.Barcode, product1.Status, product1.StatusChangedAt, nil, "", nil, product1.CreatedAt).
+		AddRow(product2.ID, product2.DateTime, product2.Type, product2.ReceptionID, product2.OrderID, product2.Barcode, product2.Status, product2.StatusChangedAt, nil, "", nil, product2.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM products").
 		WithArgs(receptionID).
@@ -152,8 +234,8 @@ func TestProductRepository_GetLastByReceptionID(t *testing.T) {
 		CreatedAt:   time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "type", "reception_id", "created_at"}).
-		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.CreatedAt)
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.OrderID, expectedProduct.Barcode, expectedProduct.Status, expectedProduct.StatusChangedAt, nil, "", nil, expectedProduct.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM products").
 		WithArgs(receptionID).
@@ -203,8 +285,151 @@ func TestProductRepository_Delete_NotFound(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	err = repo.Delete(context.Background(), productID)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "product not found")
+	assert.ErrorIs(t, err, errors.ErrProductNotFound)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_GetByReceptionIDAndBarcode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	receptionID := uuid.New()
+	barcode := "4600000000001"
+
+	mock.ExpectQuery("SELECT (.+) FROM products WHERE barcode = \\$1 AND reception_id = \\$2").
+		WithArgs(barcode, receptionID).
+		WillReturnError(errors.ErrNoRows)
+
+	_, err = repo.GetByReceptionIDAndBarcode(context.Background(), receptionID, barcode)
+	assert.ErrorIs(t, err, errors.ErrProductNotFound)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_GetLastByBarcode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	expectedProduct := models.NewProduct(models.ProductTypeClothes, uuid.New())
+	expectedProduct.OrderID = "ORD-1"
+	expectedProduct.Barcode = "4600000000001"
+
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(expectedProduct.ID, expectedProduct.DateTime, expectedProduct.Type, expectedProduct.ReceptionID, expectedProduct.OrderID, expectedProduct.Barcode, expectedProduct.Status, expectedProduct.StatusChangedAt, nil, "", nil, expectedProduct.CreatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM products WHERE barcode = \\$1 ORDER BY date_time DESC LIMIT 1").
+		WithArgs(expectedProduct.Barcode).
+		WillReturnRows(rows)
+
+	product, err := repo.GetLastByBarcode(context.Background(), expectedProduct.Barcode)
+	require.NoError(t, err)
+	assert.Equal(t, expectedProduct.ID, product.ID)
+	assert.Equal(t, expectedProduct.OrderID, product.OrderID)
+	assert.Equal(t, expectedProduct.Barcode, product.Barcode)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.SetStatus(models.ProductStatusReadyForPickup)
+
+	mock.ExpectExec(`UPDATE products SET status = \$1, status_changed_at = \$2 WHERE id = \$3 AND status = \$4`).
+		WithArgs(models.ProductStatusReadyForPickup, product.StatusChangedAt, product.ID, models.ProductStatusAccepted).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateStatus(context.Background(), product, models.ProductStatusAccepted)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_UpdateStatus_Concurrent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.SetStatus(models.ProductStatusIssued)
+
+	// Товар успели выдать в другом запросе
+	mock.ExpectExec("UPDATE products").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateStatus(context.Background(), product, models.ProductStatusReadyForPickup)
+	assert.ErrorIs(t, err, errors.ErrProductStatusTransition)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_ListShelf(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	pvzID := uuid.New()
+	product := models.NewProduct(models.ProductTypeClothes, uuid.New())
+
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode, product.Status, product.StatusChangedAt, nil, "", nil, product.CreatedAt)
+
+	mock.ExpectQuery(`SELECT (.+) FROM products WHERE reception_id IN \(SELECT id FROM receptions WHERE pvz_id = \$1\) AND status IN \(\$2,\$3\) ORDER BY date_time ASC LIMIT 10 OFFSET 10`).
+		WithArgs(pvzID, models.ProductStatusAccepted, models.ProductStatusReadyForPickup).
+		WillReturnRows(rows)
+
+	products, err := repo.ListShelf(context.Background(), pvzID, models.ShelfProductStatuses, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	assert.Equal(t, models.ProductStatusAccepted, products[0].Status)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProductRepository_ListByCellID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProductRepository(&database.Database{DB: db})
+
+	cellID := uuid.New()
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.CellID = &cellID
+
+	rows := sqlmock.NewRows(productColumns).
+		AddRow(product.ID, product.DateTime, product.Type, product.ReceptionID, product.OrderID, product.Barcode, product.Status, product.StatusChangedAt, nil, "", cellID.String(), product.CreatedAt)
+
+	mock.ExpectQuery(`SELECT (.+) FROM products WHERE cell_id = \$1 AND status IN \(\$2,\$3\) ORDER BY date_time ASC`).
+		WithArgs(cellID, models.ProductStatusAccepted, models.ProductStatusReadyForPickup).
+		WillReturnRows(rows)
+
+	products, err := repo.ListByCellID(context.Background(), cellID, models.ShelfProductStatuses)
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	assert.Equal(t, &cellID, products[0].CellID)
 
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
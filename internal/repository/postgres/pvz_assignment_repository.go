@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+var pvzAssignmentColumns = []string{"user_id", "pvz_id", "created_at"}
+
+type PVZAssignmentRepository struct {
+	db *database.Database
+	sb squirrel.StatementBuilderType
+}
+
+func NewPVZAssignmentRepository(db *database.Database) repository.PVZAssignmentRepository {
+	return &PVZAssignmentRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *PVZAssignmentRepository) Create(ctx context.Context, assignment *models.PVZAssignment) error {
+	query := r.sb.Insert("pvz_assignments").
+		Columns(pvzAssignmentColumns...).
+		Values(assignment.UserID, assignment.PVZID, assignment.CreatedAt)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return errors.ErrAssignmentExists
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PVZAssignmentRepository) Delete(ctx context.Context, userID, pvzID uuid.UUID) error {
+	query := r.sb.Delete("pvz_assignments").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (r *PVZAssignmentRepository) Exists(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	query := r.sb.Select("1").
+		From("pvz_assignments").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var found int
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&found); err != nil {
+		if errors.IsNoRows(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to check assignment: %v", err))
+	}
+
+	return true, nil
+}
+
+func (r *PVZAssignmentRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error) {
+	query := r.sb.Select(pvzAssignmentColumns...).
+		From("pvz_assignments").
+		Where(squirrel.Eq{"pvz_id": pvzID}).
+		OrderBy("created_at ASC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []*models.PVZAssignment
+	for rows.Next() {
+		var assignment models.PVZAssignment
+		if err := rows.Scan(&assignment.UserID, &assignment.PVZID, &assignment.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		assignments = append(assignments, &assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+func TestPVZAssignmentRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZAssignmentRepository(&database.Database{DB: db})
+
+	assignment := models.NewPVZAssignment(uuid.New(), uuid.New())
+
+	mock.ExpectExec("INSERT INTO pvz_assignments").
+		WithArgs(assignment.UserID, assignment.PVZID, assignment.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Create(context.Background(), assignment))
+
+	// Повторное назначение нарушает первичный ключ
+	mock.ExpectExec("INSERT INTO pvz_assignments").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Create(context.Background(), assignment)
+	assert.ErrorIs(t, err, errors.ErrAssignmentExists)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPVZAssignmentRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZAssignmentRepository(&database.Database{DB: db})
+
+	userID := uuid.New()
+	pvzID := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM pvz_assignments WHERE pvz_id = \$1 AND user_id = \$2`).
+		WithArgs(pvzID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Delete(context.Background(), userID, pvzID))
+
+	mock.ExpectExec("DELETE FROM pvz_assignments").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Delete(context.Background(), userID, pvzID)
+	assert.ErrorIs(t, err, errors.ErrAssignmentNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPVZAssignmentRepository_Exists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZAssignmentRepository(&database.Database{DB: db})
+
+	userID := uuid.New()
+	pvzID := uuid.New()
+
+	mock.ExpectQuery(`SELECT 1 FROM pvz_assignments WHERE pvz_id = \$1 AND user_id = \$2`).
+		WithArgs(pvzID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	exists, err := repo.Exists(context.Background(), userID, pvzID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	mock.ExpectQuery("SELECT 1 FROM pvz_assignments").
+		WillReturnRows(sqlmock.NewRows([]string{"?column?"}))
+
+	exists, err = repo.Exists(context.Background(), userID, pvzID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,7 +2,10 @@ package postgres
 
 import (
 	"context"
+	dbsql "database/sql"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -13,6 +16,20 @@ import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 )
 
+var pvzColumns = []string{
+	"id", "registration_date", "city", "address", "latitude", "longitude", "working_hours", "phone",
+	"status", "status_reason", "status_changed_at", "capacity", "occupied", "created_at",
+}
+
+// notArchived исключает архивные ПВЗ из выборок по умолчанию
+var notArchived = squirrel.NotEq{"status": models.PVZStatusArchived}
+
+// haversineDistance - расстояние в метрах от точки (широта, долгота) до ПВЗ по формуле гаверсинусов.
+// LEAST защищает ASIN от значений чуть больше 1 из-за погрешности округления.
+const haversineDistance = "2 * ? * ASIN(LEAST(1, SQRT(" +
+	"POWER(SIN(RADIANS(latitude - ?) / 2), 2) + " +
+	"COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2))))"
+
 type PVZRepository struct {
 	db *database.Database
 	sb squirrel.StatementBuilderType
@@ -26,9 +43,16 @@ func NewPVZRepository(db *database.Database) repository.PVZRepository {
 }
 
 func (r *PVZRepository) Create(ctx context.Context, pvz *models.PVZ) error {
+	var latitude, longitude *float64
+	if pvz.Coordinates != nil {
+		latitude, longitude = &pvz.Coordinates.Latitude, &pvz.Coordinates.Longitude
+	}
+
 	query := r.sb.Insert("pvzs").
-		Columns("id", "registration_date", "city").
-		Values(pvz.ID, pvz.RegistrationDate, pvz.City)
+		Columns("id", "registration_date", "city", "address", "latitude", "longitude", "working_hours", "phone",
+			"status", "status_reason", "status_changed_at", "capacity").
+		Values(pvz.ID, pvz.RegistrationDate, pvz.City, pvz.Address, latitude, longitude, pvz.WorkingHours, pvz.Phone,
+			pvz.Status, pvz.StatusReason, pvz.StatusChangedAt, pvz.Capacity)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -44,7 +68,7 @@ func (r *PVZRepository) Create(ctx context.Context, pvz *models.PVZ) error {
 }
 
 func (r *PVZRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ, error) {
-	query := r.sb.Select("id", "registration_date", "city", "created_at").
+	query := r.sb.Select(pvzColumns...).
 		From("pvzs").
 		Where(squirrel.Eq{"id": id})
 
@@ -53,13 +77,7 @@ func (r *PVZRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ,
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var pvz models.PVZ
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&pvz.ID,
-		&pvz.RegistrationDate,
-		&pvz.City,
-		&pvz.CreatedAt,
-	)
+	pvz, err := scanPVZ(r.db.QueryRowContext(ctx, sql, args...))
 	if err != nil {
 		if errors.IsNoRows(err) {
 			return nil, errors.ErrPVZNotFound
@@ -67,13 +85,17 @@ func (r *PVZRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ,
 		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get PVZ by ID: %v", err))
 	}
 
-	return &pvz, nil
+	return pvz, nil
 }
 
-func (r *PVZRepository) List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*models.PVZ, error) {
-	query := r.sb.Select("id", "registration_date", "city", "created_at").
+func (r *PVZRepository) List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool) ([]*models.PVZ, error) {
+	query := r.sb.Select(pvzColumns...).
 		From("pvzs")
 
+	if !includeArchived {
+		query = query.Where(notArchived)
+	}
+
 	if startDate != nil && endDate != nil {
 		query = query.Where(squirrel.And{
 			squirrel.GtOrEq{"registration_date": startDate},
@@ -88,6 +110,38 @@ func (r *PVZRepository) List(ctx context.Context, startDate, endDate *time.Time,
 	offset := (page - 1) * limit
 	query = query.OrderBy("registration_date DESC").Limit(uint64(limit)).Offset(uint64(offset))
 
+	return r.list(ctx, query)
+}
+
+func (r *PVZRepository) GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error) {
+	query := r.sb.Select(pvzColumns...).
+		From("pvzs").
+		OrderBy("registration_date DESC")
+
+	if !includeArchived {
+		query = query.Where(notArchived)
+	}
+
+	return r.list(ctx, query)
+}
+
+// ListNearest возвращает ПВЗ в радиусе radius метров от точки, ближайшие первыми.
+// ПВЗ без координат и архивные ПВЗ в выборку не попадают.
+func (r *PVZRepository) ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error) {
+	distance := squirrel.Expr(haversineDistance, models.EarthRadiusMeters, point.Latitude, point.Latitude, point.Longitude)
+
+	nearby := squirrel.Select(pvzColumns...).
+		Column(squirrel.Alias(distance, "distance")).
+		From("pvzs").
+		Where(boundingBox(point, radius)).
+		Where(notArchived)
+
+	query := r.sb.Select(append(pvzColumns, "distance")...).
+		FromSelect(nearby, "nearby").
+		Where(squirrel.LtOrEq{"distance": radius}).
+		OrderBy("distance ASC").
+		Limit(uint64(limit))
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
@@ -99,33 +153,174 @@ func (r *PVZRepository) List(ctx context.Context, startDate, endDate *time.Time,
 	}
 	defer rows.Close()
 
-	var pvzs []*models.PVZ
+	var result []*models.NearbyPVZ
 	for rows.Next() {
-		var pvz models.PVZ
-		err := rows.Scan(
-			&pvz.ID,
-			&pvz.RegistrationDate,
-			&pvz.City,
-			&pvz.CreatedAt,
-		)
+		var nearbyPVZ models.NearbyPVZ
+		nearbyPVZ.PVZ, err = scanPVZ(rows, &nearbyPVZ.Distance)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		pvzs = append(pvzs, &pvz)
+		result = append(result, &nearbyPVZ)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating rows: %w", err)
 	}
 
-	return pvzs, nil
+	return result, nil
 }
 
-func (r *PVZRepository) GetAll(ctx context.Context) ([]*models.PVZ, error) {
-	query := r.sb.Select("id", "registration_date", "city", "created_at").
+// UpdateStatus сохраняет новый статус ПВЗ, если текущий статус в БД все еще from.
+// Иначе статус успели изменить параллельно и переход отклоняется.
+func (r *PVZRepository) UpdateStatus(ctx context.Context, pvz *models.PVZ, from models.PVZStatus) error {
+	query := r.sb.Update("pvzs").
+		Set("status", pvz.Status).
+		Set("status_reason", pvz.StatusReason).
+		Set("status_changed_at", pvz.StatusChangedAt).
+		Where(squirrel.Eq{"id": pvz.ID, "status": from})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrPVZStatusTransition
+	}
+
+	return nil
+}
+
+// UpdateCapacity сохраняет вместимость ПВЗ и возвращает в pvz текущую заполненность
+func (r *PVZRepository) UpdateCapacity(ctx context.Context, pvz *models.PVZ) error {
+	query := r.sb.Update("pvzs").
+		Set("capacity", pvz.Capacity).
+		Where(squirrel.Eq{"id": pvz.ID}).
+		Suffix("RETURNING occupied")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&pvz.Occupied); err != nil {
+		if errors.IsNoRows(err) {
+			return errors.ErrPVZNotFound
+		}
+		return errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to update PVZ capacity: %v", err))
+	}
+
+	return nil
+}
+
+// Occupy занимает в ПВЗ место под count товаров, если вместимость не ограничена или места хватает на все товары.
+// Условный UPDATE не дает параллельным приемкам переполнить ПВЗ. Новые вместимость
+// и заполненность возвращаются в pvz.
+func (r *PVZRepository) Occupy(ctx context.Context, pvz *models.PVZ, count int) error {
+	query := r.sb.Update("pvzs").
+		Set("occupied", squirrel.Expr("occupied + ?", count)).
+		Where(squirrel.Eq{"id": pvz.ID}).
+		Where("(capacity = 0 OR occupied + ? <= capacity)", count).
+		Suffix("RETURNING capacity, occupied")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&pvz.Capacity, &pvz.Occupied); err != nil {
+		if errors.IsNoRows(err) {
+			return r.fullError(ctx, pvz.ID, count)
+		}
+		return errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to occupy PVZ: %v", err))
+	}
+
+	return nil
+}
+
+// fullError возвращает ErrPVZFull со сведениями о текущей вместимости и заполненности ПВЗ
+func (r *PVZRepository) fullError(ctx context.Context, id uuid.UUID, count int) error {
+	query := r.sb.Select("capacity", "occupied").
 		From("pvzs").
-		OrderBy("registration_date DESC")
+		Where(squirrel.Eq{"id": id})
 
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var capacity, occupied int
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&capacity, &occupied); err != nil {
+		if errors.IsNoRows(err) {
+			return errors.ErrPVZNotFound
+		}
+		return errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get PVZ occupancy: %v", err))
+	}
+
+	return errors.WithDetails(errors.ErrPVZFull, map[string]string{
+		"capacity":  strconv.Itoa(capacity),
+		"occupied":  strconv.Itoa(occupied),
+		"requested": strconv.Itoa(count),
+	})
+}
+
+// Release освобождает место count товаров, покинувших ПВЗ. Заполненность не опускается ниже нуля.
+func (r *PVZRepository) Release(ctx context.Context, id uuid.UUID, count int) error {
+	query := r.sb.Update("pvzs").
+		Set("occupied", squirrel.Expr("GREATEST(occupied - ?, 0)", count)).
+		Where(squirrel.Eq{"id": id}).
+		Where("occupied > 0")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// boundingBox отбирает точки в прямоугольнике вокруг окружности поиска, чтобы
+// считать расстояние только для них. Если окружность захватывает полюс или
+// линию перемены дат, ограничение по долготе не накладывается.
+func boundingBox(point models.Coordinates, radius float64) squirrel.Sqlizer {
+	deltaLatitude := radius / models.EarthRadiusMeters * 180 / math.Pi
+	minLatitude, maxLatitude := point.Latitude-deltaLatitude, point.Latitude+deltaLatitude
+
+	box := squirrel.And{
+		squirrel.GtOrEq{"latitude": minLatitude},
+		squirrel.LtOrEq{"latitude": maxLatitude},
+	}
+	if minLatitude <= -90 || maxLatitude >= 90 {
+		return box
+	}
+
+	deltaLongitude := deltaLatitude / math.Cos(point.Latitude*math.Pi/180)
+	minLongitude, maxLongitude := point.Longitude-deltaLongitude, point.Longitude+deltaLongitude
+	if minLongitude < -180 || maxLongitude > 180 {
+		return box
+	}
+
+	return append(box,
+		squirrel.GtOrEq{"longitude": minLongitude},
+		squirrel.LtOrEq{"longitude": maxLongitude},
+	)
+}
+
+func (r *PVZRepository) list(ctx context.Context, query squirrel.SelectBuilder) ([]*models.PVZ, error) {
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
@@ -139,17 +334,11 @@ func (r *PVZRepository) GetAll(ctx context.Context) ([]*models.PVZ, error) {
 
 	var pvzs []*models.PVZ
 	for rows.Next() {
-		var pvz models.PVZ
-		err := rows.Scan(
-			&pvz.ID,
-			&pvz.RegistrationDate,
-			&pvz.City,
-			&pvz.CreatedAt,
-		)
+		pvz, err := scanPVZ(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		pvzs = append(pvzs, &pvz)
+		pvzs = append(pvzs, pvz)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -158,3 +347,33 @@ func (r *PVZRepository) GetAll(ctx context.Context) ([]*models.PVZ, error) {
 
 	return pvzs, nil
 }
+
+// scanPVZ читает ПВЗ в порядке pvzColumns, extra - дополнительные колонки после них
+func scanPVZ(row rowScanner, extra ...any) (*models.PVZ, error) {
+	var pvz models.PVZ
+	var latitude, longitude dbsql.NullFloat64
+	dest := append([]any{
+		&pvz.ID,
+		&pvz.RegistrationDate,
+		&pvz.City,
+		&pvz.Address,
+		&latitude,
+		&longitude,
+		&pvz.WorkingHours,
+		&pvz.Phone,
+		&pvz.Status,
+		&pvz.StatusReason,
+		&pvz.StatusChangedAt,
+		&pvz.Capacity,
+		&pvz.Occupied,
+		&pvz.CreatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if latitude.Valid && longitude.Valid {
+		pvz.Coordinates = &models.Coordinates{Latitude: latitude.Float64, Longitude: longitude.Float64}
+	}
+	return &pvz, nil
+}
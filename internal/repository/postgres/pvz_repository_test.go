@@ -30,7 +30,8 @@ func TestPVZRepository_Create(t *testing.T) {
 	}
 
 	mock.ExpectExec("INSERT INTO pvzs").
-		WithArgs(pvz.ID, pvz.RegistrationDate, pvz.City).
+		WithArgs(pvz.ID, pvz.RegistrationDate, pvz.City, pvz.Address, nil, nil, pvz.WorkingHours, pvz.Phone,
+			pvz.Status, pvz.StatusReason, pvz.StatusChangedAt, pvz.Capacity).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(context.Background(), pvz)
@@ -55,8 +56,8 @@ func TestPVZRepository_GetByID(t *testing.T) {
 		CreatedAt:        time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "registration_date", "city", "created_at"}).
-		AddRow(expectedPVZ.ID, expectedPVZ.RegistrationDate, expectedPVZ.City, expectedPVZ.CreatedAt)
+	rows := sqlmock.NewRows(pvzColumns).
+		AddRow(expectedPVZ.ID, expectedPVZ.RegistrationDate, expectedPVZ.City, expectedPVZ.Address, nil, nil, expectedPVZ.WorkingHours, expectedPVZ.Phone, expectedPVZ.Status, expectedPVZ.StatusReason, expectedPVZ.StatusChangedAt, expectedPVZ.Capacity, expectedPVZ.Occupied, expectedPVZ.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM pvzs").
 		WithArgs(pvzID).
@@ -117,14 +118,15 @@ func TestPVZRepository_List(t *testing.T) {
 		CreatedAt:        time.Now().Add(-6 * time.Hour),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "registration_date", "city", "created_at"}).
-		AddRow(pvz1.ID, pvz1.RegistrationDate, pvz1.City, pvz1.CreatedAt).
-		AddRow(pvz2.ID, pvz2.RegistrationDate, pvz2.City, pvz2.CreatedAt)
+	rows := sqlmock.NewRows(pvzColumns).
+		AddRow(pvz1.ID, pvz1.RegistrationDate, pvz1.City, pvz1.Address, nil, nil, pvz1.WorkingHours, pvz1.Phone, pvz1.Status, pvz1.StatusReason, pvz1.StatusChangedAt, pvz1.Capacity, pvz1.Occupied, pvz1.CreatedAt).
+		AddRow(pvz2.ID, pvz2.RegistrationDate, pvz2.City, pvz2.Address, nil, nil, pvz2.WorkingHours, pvz2.Phone, pvz2.Status, pvz2.StatusReason, pvz2.StatusChangedAt, pvz2.Capacity, pvz2.Occupied, pvz2.CreatedAt)
 
-	mock.ExpectQuery("SELECT (.+) FROM pvzs").
+	mock.ExpectQuery(`SELECT (.+) FROM pvzs WHERE status <> \$1 AND \(registration_date >= \$2 AND registration_date <= \$3\)`).
+		WithArgs(models.PVZStatusArchived, startDate, endDate).
 		WillReturnRows(rows)
 
-	pvzs, err := repo.List(context.Background(), &startDate, &endDate, page, limit)
+	pvzs, err := repo.List(context.Background(), &startDate, &endDate, page, limit, false)
 	require.NoError(t, err)
 	assert.Len(t, pvzs, 2)
 
@@ -153,17 +155,235 @@ func TestPVZRepository_GetAll(t *testing.T) {
 		CreatedAt:        time.Now().Add(-6 * time.Hour),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "registration_date", "city", "created_at"}).
-		AddRow(pvz1.ID, pvz1.RegistrationDate, pvz1.City, pvz1.CreatedAt).
-		AddRow(pvz2.ID, pvz2.RegistrationDate, pvz2.City, pvz2.CreatedAt)
+	rows := sqlmock.NewRows(pvzColumns).
+		AddRow(pvz1.ID, pvz1.RegistrationDate, pvz1.City, pvz1.Address, nil, nil, pvz1.WorkingHours, pvz1.Phone, pvz1.Status, pvz1.StatusReason, pvz1.StatusChangedAt, pvz1.Capacity, pvz1.Occupied, pvz1.CreatedAt).
+		AddRow(pvz2.ID, pvz2.RegistrationDate, pvz2.City, pvz2.Address, nil, nil, pvz2.WorkingHours, pvz2.Phone, pvz2.Status, pvz2.StatusReason, pvz2.StatusChangedAt, pvz2.Capacity, pvz2.Occupied, pvz2.CreatedAt)
 
-	mock.ExpectQuery("SELECT (.+) FROM pvzs").
+	mock.ExpectQuery("SELECT (.+) FROM pvzs WHERE status <> \\$1 ORDER BY registration_date DESC").
+		WithArgs(models.PVZStatusArchived).
 		WillReturnRows(rows)
 
-	pvzs, err := repo.GetAll(context.Background())
+	pvzs, err := repo.GetAll(context.Background(), false)
 	require.NoError(t, err)
 	assert.Len(t, pvzs, 2)
 
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
+
+func TestPVZRepository_GetAll_IncludeArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityKazan)
+	pvz.SetStatus(models.PVZStatusArchived, "помещение закрыто")
+
+	rows := sqlmock.NewRows(pvzColumns).
+		AddRow(pvz.ID, pvz.RegistrationDate, pvz.City, pvz.Address, nil, nil, pvz.WorkingHours, pvz.Phone, pvz.Status, pvz.StatusReason, pvz.StatusChangedAt, pvz.Capacity, pvz.Occupied, pvz.CreatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM pvzs ORDER BY registration_date DESC").
+		WithArgs().
+		WillReturnRows(rows)
+
+	pvzs, err := repo.GetAll(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, pvzs, 1)
+	assert.Equal(t, models.PVZStatusArchived, pvzs[0].Status)
+	assert.Equal(t, "помещение закрыто", pvzs[0].StatusReason)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+
+	mock.ExpectExec(`UPDATE pvzs SET status = \$1, status_reason = \$2, status_changed_at = \$3 WHERE id = \$4 AND status = \$5`).
+		WithArgs(models.PVZStatusSuspended, "ремонт", pvz.StatusChangedAt, pvz.ID, models.PVZStatusActive).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateStatus(context.Background(), pvz, models.PVZStatusActive)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_UpdateCapacity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.Capacity = 300
+
+	mock.ExpectQuery(`UPDATE pvzs SET capacity = \$1 WHERE id = \$2 RETURNING occupied`).
+		WithArgs(300, pvz.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"occupied"}).AddRow(120))
+
+	err = repo.UpdateCapacity(context.Background(), pvz)
+	require.NoError(t, err)
+	assert.Equal(t, 120, pvz.Occupied)
+
+	mock.ExpectQuery("UPDATE pvzs").
+		WillReturnRows(sqlmock.NewRows([]string{"occupied"}))
+
+	err = repo.UpdateCapacity(context.Background(), pvz)
+	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_Occupy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	mock.ExpectQuery(`UPDATE pvzs SET occupied = occupied \+ \$1 WHERE id = \$2 AND \(capacity = 0 OR occupied \+ \$3 <= capacity\) RETURNING capacity, occupied`).
+		WithArgs(1, pvz.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity", "occupied"}).AddRow(10, 9))
+
+	err = repo.Occupy(context.Background(), pvz, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 10, pvz.Capacity)
+	assert.Equal(t, 9, pvz.Occupied)
+
+	// Пачка товаров не помещается в оставшееся место целиком
+	mock.ExpectQuery("UPDATE pvzs").
+		WithArgs(5, pvz.ID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity", "occupied"}))
+	mock.ExpectQuery(`SELECT capacity, occupied FROM pvzs WHERE id = \$1`).
+		WithArgs(pvz.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity", "occupied"}).AddRow(10, 9))
+
+	err = repo.Occupy(context.Background(), pvz, 5)
+	assert.ErrorIs(t, err, errors.ErrPVZFull)
+	assert.Equal(t, map[string]string{"capacity": "10", "occupied": "9", "requested": "5"}, errors.Details(err))
+
+	mock.ExpectExec(`UPDATE pvzs SET occupied = GREATEST\(occupied - \$1, 0\) WHERE id = \$2 AND occupied > 0`).
+		WithArgs(5, pvz.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Release(context.Background(), pvz.ID, 5)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_UpdateStatus_Concurrent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+
+	// Статус успели изменить в другом запросе
+	mock.ExpectExec("UPDATE pvzs").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateStatus(context.Background(), pvz, models.PVZStatusActive)
+	assert.ErrorIs(t, err, errors.ErrPVZStatusTransition)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_Create_WithDetails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.Address = "ул. Тверская, 1"
+	pvz.Coordinates = &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137}
+	pvz.WorkingHours = "ежедневно 09:00-21:00"
+	pvz.Phone = "+74951234567"
+
+	mock.ExpectExec("INSERT INTO pvzs").
+		WithArgs(pvz.ID, pvz.RegistrationDate, pvz.City, pvz.Address, 55.7576, 37.6137, pvz.WorkingHours, pvz.Phone,
+			models.PVZStatusActive, "", pvz.StatusChangedAt, 0).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(context.Background(), pvz)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestPVZRepository_ListNearest(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPVZRepository(&database.Database{DB: db})
+
+	point := models.Coordinates{Latitude: 55.75, Longitude: 37.61}
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.Address = "ул. Тверская, 1"
+
+	rows := sqlmock.NewRows(append(pvzColumns, "distance")).
+		AddRow(pvz.ID, pvz.RegistrationDate, pvz.City, pvz.Address, 55.7576, 37.6137, pvz.WorkingHours, pvz.Phone, pvz.Status, pvz.StatusReason, pvz.StatusChangedAt, pvz.Capacity, pvz.Occupied, pvz.CreatedAt, 872.5)
+
+	mock.ExpectQuery(`SELECT (.+), distance FROM \(SELECT (.+) AS distance FROM pvzs WHERE \(latitude >= \$5 AND latitude <= \$6 AND longitude >= \$7 AND longitude <= \$8\) AND status <> \$9\) AS nearby WHERE distance <= \$10 ORDER BY distance ASC LIMIT 5`).
+		WithArgs(models.EarthRadiusMeters, point.Latitude, point.Latitude, point.Longitude,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), models.PVZStatusArchived, 5000.0).
+		WillReturnRows(rows)
+
+	result, err := repo.ListNearest(context.Background(), point, 5000, 5)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, pvz.ID, result[0].PVZ.ID)
+	assert.Equal(t, &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137}, result[0].PVZ.Coordinates)
+	assert.Equal(t, 872.5, result[0].Distance)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestBoundingBox(t *testing.T) {
+	t.Run("Regular", func(t *testing.T) {
+		sql, args, err := boundingBox(models.Coordinates{Latitude: 55.75, Longitude: 37.61}, 5000).ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, "(latitude >= ? AND latitude <= ? AND longitude >= ? AND longitude <= ?)", sql)
+		require.Len(t, args, 4)
+		// 5 км - это примерно 0.045 градуса широты и 0.08 градуса долготы на широте Москвы
+		assert.InDelta(t, 55.705, args[0], 0.001)
+		assert.InDelta(t, 37.53, args[2], 0.001)
+	})
+
+	t.Run("Antimeridian", func(t *testing.T) {
+		sql, _, err := boundingBox(models.Coordinates{Latitude: 65, Longitude: 179.99}, 5000).ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, "(latitude >= ? AND latitude <= ?)", sql)
+	})
+
+	t.Run("Pole", func(t *testing.T) {
+		sql, _, err := boundingBox(models.Coordinates{Latitude: 89.99, Longitude: 0}, 5000).ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, "(latitude >= ? AND latitude <= ?)", sql)
+	})
+}
@@ -2,7 +2,11 @@ package postgres
 
 import (
 	"context"
+	dbsql "database/sql"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -12,6 +16,8 @@ import (
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 )
 
+var receptionColumns = []string{"id", "date_time", "pvz_id", "status", "kind", "reopen_reason", "reopened_at", "max_items", "items_count", "closed_by", "close_reason", "created_at"}
+
 type ReceptionRepository struct {
 	db *database.Database
 	sb squirrel.StatementBuilderType
@@ -26,8 +32,8 @@ func NewReceptionRepository(db *database.Database) repository.ReceptionRepositor
 
 func (r *ReceptionRepository) Create(ctx context.Context, reception *models.Reception) error {
 	query := r.sb.Insert("receptions").
-		Columns("id", "date_time", "pvz_id", "status").
-		Values(reception.ID, reception.DateTime, reception.PVZID, reception.Status)
+		Columns("id", "date_time", "pvz_id", "status", "kind", "max_items").
+		Values(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.MaxItems)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -36,6 +42,10 @@ func (r *ReceptionRepository) Create(ctx context.Context, reception *models.Rece
 
 	_, err = r.db.ExecContext(ctx, sql, args...)
 	if err != nil {
+		// Уникальный индекс по открытым приемкам ПВЗ страхует от одновременного открытия
+		if database.IsUniqueViolation(err) {
+			return errors.ErrOpenReceptionExists
+		}
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
@@ -43,7 +53,7 @@ func (r *ReceptionRepository) Create(ctx context.Context, reception *models.Rece
 }
 
 func (r *ReceptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Reception, error) {
-	query := r.sb.Select("id", "date_time", "pvz_id", "status", "created_at").
+	query := r.sb.Select(receptionColumns...).
 		From("receptions").
 		Where(squirrel.Eq{"id": id})
 
@@ -52,14 +62,7 @@ func (r *ReceptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*model
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var reception models.Reception
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&reception.ID,
-		&reception.DateTime,
-		&reception.PVZID,
-		&reception.Status,
-		&reception.CreatedAt,
-	)
+	reception, err := scanReception(r.db.QueryRowContext(ctx, sql, args...))
 	if err != nil {
 		if errors.IsNoRows(err) {
 			return nil, errors.ErrReceptionNotFound
@@ -67,11 +70,11 @@ func (r *ReceptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*model
 		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get reception by ID: %v", err))
 	}
 
-	return &reception, nil
+	return reception, nil
 }
 
 func (r *ReceptionRepository) GetLastByPVZID(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error) {
-	query := r.sb.Select("id", "date_time", "pvz_id", "status", "created_at").
+	query := r.sb.Select(receptionColumns...).
 		From("receptions").
 		Where(squirrel.Eq{"pvz_id": pvzID}).
 		OrderBy("date_time DESC").
@@ -82,14 +85,7 @@ func (r *ReceptionRepository) GetLastByPVZID(ctx context.Context, pvzID uuid.UUI
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var reception models.Reception
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&reception.ID,
-		&reception.DateTime,
-		&reception.PVZID,
-		&reception.Status,
-		&reception.CreatedAt,
-	)
+	reception, err := scanReception(r.db.QueryRowContext(ctx, sql, args...))
 	if err != nil {
 		if errors.IsNoRows(err) {
 			return nil, errors.ErrReceptionNotFound
@@ -97,11 +93,11 @@ func (r *ReceptionRepository) GetLastByPVZID(ctx context.Context, pvzID uuid.UUI
 		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get last reception for PVZ: %v", err))
 	}
 
-	return &reception, nil
+	return reception, nil
 }
 
 func (r *ReceptionRepository) GetLastOpenByPVZID(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error) {
-	query := r.sb.Select("id", "date_time", "pvz_id", "status", "created_at").
+	query := r.sb.Select(receptionColumns...).
 		From("receptions").
 		Where(squirrel.And{
 			squirrel.Eq{"pvz_id": pvzID},
@@ -115,14 +111,7 @@ func (r *ReceptionRepository) GetLastOpenByPVZID(ctx context.Context, pvzID uuid
 		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	var reception models.Reception
-	err = r.db.QueryRowContext(ctx, sql, args...).Scan(
-		&reception.ID,
-		&reception.DateTime,
-		&reception.PVZID,
-		&reception.Status,
-		&reception.CreatedAt,
-	)
+	reception, err := scanReception(r.db.QueryRowContext(ctx, sql, args...))
 	if err != nil {
 		if errors.IsNoRows(err) {
 			return nil, errors.ErrOpenReceptionNotFound
@@ -130,12 +119,16 @@ func (r *ReceptionRepository) GetLastOpenByPVZID(ctx context.Context, pvzID uuid
 		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get last open reception for PVZ: %v", err))
 	}
 
-	return &reception, nil
+	return reception, nil
 }
 
 func (r *ReceptionRepository) Update(ctx context.Context, reception *models.Reception) error {
 	query := r.sb.Update("receptions").
 		Set("status", reception.Status).
+		Set("reopen_reason", reception.ReopenReason).
+		Set("reopened_at", reception.ReopenedAt).
+		Set("closed_by", reception.ClosedBy).
+		Set("close_reason", reception.CloseReason).
 		Where(squirrel.Eq{"id": reception.ID})
 
 	sql, args, err := query.ToSql()
@@ -143,6 +136,77 @@ func (r *ReceptionRepository) Update(ctx context.Context, reception *models.Rece
 		return fmt.Errorf("failed to build SQL: %w", err)
 	}
 
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		// Повторное открытие приемки упирается в тот же индекс, что и создание
+		if database.IsUniqueViolation(err) {
+			return errors.ErrOpenReceptionExists
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrReceptionNotFound
+	}
+
+	return nil
+}
+
+// AddItems увеличивает счетчик товаров открытой приемки на count, если квота не ограничена или товары в нее помещаются.
+// Условный UPDATE блокирует строку приемки до конца транзакции добавления, поэтому параллельные
+// сканирования не превысят квоту, а закрытие приемки дождется сохранения товаров. Новые квота
+// и число товаров возвращаются в reception.
+func (r *ReceptionRepository) AddItems(ctx context.Context, reception *models.Reception, count int) error {
+	query := r.sb.Update("receptions").
+		Set("items_count", squirrel.Expr("items_count + ?", count)).
+		Where(squirrel.Eq{"id": reception.ID, "status": models.ReceptionStatusInProgress}).
+		Where("(max_items = 0 OR items_count + ? <= max_items)", count).
+		Suffix("RETURNING max_items, items_count")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, sql, args...).Scan(&reception.MaxItems, &reception.ItemsCount); err != nil {
+		if !errors.IsNoRows(err) {
+			return errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to add items to reception: %v", err))
+		}
+
+		// Приемку могли закрыть после того, как ее нашли
+		current, err := r.GetByID(ctx, reception.ID)
+		if err != nil {
+			return err
+		}
+		if !current.IsInProgress() {
+			return errors.ErrReceptionAlreadyClosed
+		}
+		return errors.WithDetails(errors.ErrReceptionQuotaExceeded, map[string]string{
+			"maxItems":   strconv.Itoa(current.MaxItems),
+			"itemsCount": strconv.Itoa(current.ItemsCount),
+			"requested":  strconv.Itoa(count),
+		})
+	}
+
+	return nil
+}
+
+// RemoveItems уменьшает счетчик товаров открытой приемки на count, не опуская его ниже нуля
+func (r *ReceptionRepository) RemoveItems(ctx context.Context, id uuid.UUID, count int) error {
+	query := r.sb.Update("receptions").
+		Set("items_count", squirrel.Expr("GREATEST(items_count - ?, 0)", count)).
+		Where(squirrel.Eq{"id": id, "status": models.ReceptionStatusInProgress})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
 	result, err := r.db.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
@@ -154,17 +218,67 @@ func (r *ReceptionRepository) Update(ctx context.Context, reception *models.Rece
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("reception not found")
+		return errors.ErrReceptionAlreadyClosed
 	}
 
 	return nil
 }
 
-func (r *ReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.Reception, error) {
-	query := r.sb.Select("id", "date_time", "pvz_id", "status", "created_at").
+// CloseStale закрывает от имени сервиса открытые приемки без товаров и повторных открытий после idleSince.
+// Строки берутся с FOR UPDATE SKIP LOCKED, поэтому несколько реплик не закроют одну приемку дважды,
+// а приемки, в которые прямо сейчас добавляется товар, пропускаются до следующего прохода.
+func (r *ReceptionRepository) CloseStale(ctx context.Context, idleSince time.Time, limit uint64) ([]*models.Reception, error) {
+	stale := squirrel.Select("id").
+		From("receptions").
+		Where(squirrel.Eq{"status": models.ReceptionStatusInProgress}).
+		Where(squirrel.Expr("GREATEST(date_time, reopened_at, (SELECT MAX(products.date_time) FROM products WHERE products.reception_id = receptions.id)) < ?", idleSince)).
+		OrderBy("date_time ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := r.sb.Update("receptions").
+		Set("status", models.ReceptionStatusClose).
+		Set("closed_by", models.SystemActor).
+		Set("close_reason", models.ReceptionCloseReasonTimedOut).
+		Where(squirrel.Expr("id IN (?)", stale)).
+		Suffix("RETURNING " + strings.Join(receptionColumns, ", "))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to close stale receptions: %v", err))
+	}
+	defer rows.Close()
+
+	var receptions []*models.Reception
+	for rows.Next() {
+		reception, err := scanReception(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		receptions = append(receptions, reception)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return receptions, nil
+}
+
+// ListByPVZID возвращает приемки ПВЗ, новые первыми. Пустой kind означает приемки любого вида.
+func (r *ReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID, kind models.ReceptionKind) ([]*models.Reception, error) {
+	query := r.sb.Select(receptionColumns...).
 		From("receptions").
 		Where(squirrel.Eq{"pvz_id": pvzID}).
 		OrderBy("date_time DESC")
+	if kind != "" {
+		query = query.Where(squirrel.Eq{"kind": kind})
+	}
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -179,18 +293,11 @@ func (r *ReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID)
 
 	var receptions []*models.Reception
 	for rows.Next() {
-		var reception models.Reception
-		err := rows.Scan(
-			&reception.ID,
-			&reception.DateTime,
-			&reception.PVZID,
-			&reception.Status,
-			&reception.CreatedAt,
-		)
+		reception, err := scanReception(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		receptions = append(receptions, &reception)
+		receptions = append(receptions, reception)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -199,3 +306,31 @@ func (r *ReceptionRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID)
 
 	return receptions, nil
 }
+
+// scanReception читает приемку в порядке receptionColumns
+func scanReception(row rowScanner) (*models.Reception, error) {
+	var reception models.Reception
+	var reopenedAt dbsql.NullTime
+	err := row.Scan(
+		&reception.ID,
+		&reception.DateTime,
+		&reception.PVZID,
+		&reception.Status,
+		&reception.Kind,
+		&reception.ReopenReason,
+		&reopenedAt,
+		&reception.MaxItems,
+		&reception.ItemsCount,
+		&reception.ClosedBy,
+		&reception.CloseReason,
+		&reception.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if reopenedAt.Valid {
+		reception.ReopenedAt = &reopenedAt.Time
+	}
+	return &reception, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -32,7 +33,7 @@ func TestReceptionRepository_Create(t *testing.T) {
 	}
 
 	mock.ExpectExec("INSERT INTO receptions").
-		WithArgs(reception.ID, reception.DateTime, reception.PVZID, reception.Status).
+		WithArgs(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.MaxItems).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(context.Background(), reception)
@@ -42,6 +43,26 @@ func TestReceptionRepository_Create(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReceptionRepository_Create_OpenReceptionExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	reception := models.NewReception(uuid.New())
+
+	mock.ExpectExec("INSERT INTO receptions").
+		WithArgs(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.MaxItems).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Create(context.Background(), reception)
+	assert.ErrorIs(t, err, errors.ErrOpenReceptionExists)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
 func TestReceptionRepository_GetByID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -59,8 +80,8 @@ func TestReceptionRepository_GetByID(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "pvz_id", "status", "created_at"}).
-		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.CreatedAt)
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.Kind, expectedReception.ReopenReason, nil, expectedReception.MaxItems, expectedReception.ItemsCount, expectedReception.ClosedBy, expectedReception.CloseReason, expectedReception.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM receptions").
 		WithArgs(receptionID).
@@ -112,8 +133,8 @@ func TestReceptionRepository_GetLastByPVZID(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "pvz_id", "status", "created_at"}).
-		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.CreatedAt)
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.Kind, expectedReception.ReopenReason, nil, expectedReception.MaxItems, expectedReception.ItemsCount, expectedReception.ClosedBy, expectedReception.CloseReason, expectedReception.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM receptions").
 		WithArgs(pvzID).
@@ -145,8 +166,8 @@ func TestReceptionRepository_GetLastOpenByPVZID(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "pvz_id", "status", "created_at"}).
-		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.CreatedAt)
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(expectedReception.ID, expectedReception.DateTime, expectedReception.PVZID, expectedReception.Status, expectedReception.Kind, expectedReception.ReopenReason, nil, expectedReception.MaxItems, expectedReception.ItemsCount, expectedReception.ClosedBy, expectedReception.CloseReason, expectedReception.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM receptions").
 		WithArgs(pvzID, models.ReceptionStatusInProgress).
@@ -178,7 +199,7 @@ func TestReceptionRepository_Update(t *testing.T) {
 	}
 
 	mock.ExpectExec("UPDATE receptions").
-		WithArgs(reception.Status, reception.ID).
+		WithArgs(reception.Status, reception.ReopenReason, reception.ReopenedAt, reception.ClosedBy, reception.CloseReason, reception.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	err = repo.Update(context.Background(), reception)
@@ -188,6 +209,87 @@ func TestReceptionRepository_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReceptionRepository_Update_OpenReceptionExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	reception := models.NewReception(uuid.New())
+
+	mock.ExpectExec("UPDATE receptions").
+		WithArgs(reception.Status, reception.ReopenReason, reception.ReopenedAt, reception.ClosedBy, reception.CloseReason, reception.ID).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Update(context.Background(), reception)
+	assert.ErrorIs(t, err, errors.ErrOpenReceptionExists)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestReceptionRepository_CloseStale(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	idleSince := time.Now().Add(-2 * time.Hour)
+	reception := models.NewReception(uuid.New())
+	// Так приемку закрывает CloseStale
+	reception.Status = models.ReceptionStatusClose
+	reception.ClosedBy = models.SystemActor
+	reception.CloseReason = models.ReceptionCloseReasonTimedOut
+
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.ReopenReason, nil, reception.MaxItems, reception.ItemsCount, reception.ClosedBy, reception.CloseReason, reception.CreatedAt)
+
+	mock.ExpectQuery(`UPDATE receptions SET status = \$1, closed_by = \$2, close_reason = \$3 WHERE id IN \(SELECT id FROM receptions WHERE status = \$4 AND GREATEST\(.+\) < \$5 ORDER BY date_time ASC LIMIT 100 FOR UPDATE SKIP LOCKED\) RETURNING`).
+		WithArgs(models.ReceptionStatusClose, models.SystemActor, models.ReceptionCloseReasonTimedOut, models.ReceptionStatusInProgress, idleSince).
+		WillReturnRows(rows)
+
+	receptions, err := repo.CloseStale(context.Background(), idleSince, 100)
+	require.NoError(t, err)
+	require.Len(t, receptions, 1)
+	assert.False(t, receptions[0].IsInProgress())
+	assert.True(t, receptions[0].IsTimedOut())
+	assert.Equal(t, models.SystemActor, receptions[0].ClosedBy)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestReceptionRepository_GetByID_Reopened(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	reception := models.NewReception(uuid.New())
+	reception.Close()
+	reception.Reopen("машина еще разгружается")
+
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.ReopenReason, *reception.ReopenedAt, reception.MaxItems, reception.ItemsCount, reception.ClosedBy, reception.CloseReason, reception.CreatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM receptions").
+		WithArgs(reception.ID).
+		WillReturnRows(rows)
+
+	result, err := repo.GetByID(context.Background(), reception.ID)
+	require.NoError(t, err)
+	assert.True(t, result.IsInProgress())
+	assert.Equal(t, "машина еще разгружается", result.ReopenReason)
+	require.NotNil(t, result.ReopenedAt)
+	assert.True(t, reception.ReopenedAt.Equal(*result.ReopenedAt))
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
 func TestReceptionRepository_ListByPVZID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -212,18 +314,122 @@ func TestReceptionRepository_ListByPVZID(t *testing.T) {
 		CreatedAt: time.Now().Add(-6 * time.Hour),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "date_time", "pvz_id", "status", "created_at"}).
-		AddRow(reception1.ID, reception1.DateTime, reception1.PVZID, reception1.Status, reception1.CreatedAt).
-		AddRow(reception2.ID, reception2.DateTime, reception2.PVZID, reception2.Status, reception2.CreatedAt)
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(reception1.ID, reception1.DateTime, reception1.PVZID, reception1.Status, reception1.Kind, reception1.ReopenReason, nil, reception1.MaxItems, reception1.ItemsCount, reception1.ClosedBy, reception1.CloseReason, reception1.CreatedAt).
+		AddRow(reception2.ID, reception2.DateTime, reception2.PVZID, reception2.Status, reception2.Kind, reception2.ReopenReason, nil, reception2.MaxItems, reception2.ItemsCount, reception2.ClosedBy, reception2.CloseReason, reception2.CreatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM receptions").
 		WithArgs(pvzID).
 		WillReturnRows(rows)
 
-	receptions, err := repo.ListByPVZID(context.Background(), pvzID)
+	receptions, err := repo.ListByPVZID(context.Background(), pvzID, "")
 	require.NoError(t, err)
 	assert.Len(t, receptions, 2)
 
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
+
+func TestReceptionRepository_ListByPVZID_Kind(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	pvzID := uuid.New()
+	reception := models.NewReception(pvzID)
+	reception.Kind = models.ReceptionKindCustomerReturn
+
+	rows := sqlmock.NewRows(receptionColumns).
+		AddRow(reception.ID, reception.DateTime, reception.PVZID, reception.Status, reception.Kind, reception.ReopenReason, nil, reception.MaxItems, reception.ItemsCount, reception.ClosedBy, reception.CloseReason, reception.CreatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM receptions WHERE pvz_id = \\$1 AND kind = \\$2").
+		WithArgs(pvzID, models.ReceptionKindCustomerReturn).
+		WillReturnRows(rows)
+
+	receptions, err := repo.ListByPVZID(context.Background(), pvzID, models.ReceptionKindCustomerReturn)
+	require.NoError(t, err)
+	require.Len(t, receptions, 1)
+	assert.Equal(t, models.ReceptionKindCustomerReturn, receptions[0].Kind)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReceptionRepository_AddItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	reception := models.NewReception(uuid.New())
+	reception.MaxItems = 10
+
+	mock.ExpectQuery(`UPDATE receptions SET items_count = items_count \+ \$1 WHERE id = \$2 AND status = \$3 AND \(max_items = 0 OR items_count \+ \$4 <= max_items\) RETURNING max_items, items_count`).
+		WithArgs(2, reception.ID, models.ReceptionStatusInProgress, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"max_items", "items_count"}).AddRow(10, 7))
+
+	err = repo.AddItems(context.Background(), reception, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 10, reception.MaxItems)
+	assert.Equal(t, 7, reception.ItemsCount)
+
+	row := func(status models.ReceptionStatus) *sqlmock.Rows {
+		return sqlmock.NewRows(receptionColumns).
+			AddRow(reception.ID, reception.DateTime, reception.PVZID, status, reception.Kind, "", nil, reception.MaxItems, reception.ItemsCount, "", "", reception.CreatedAt)
+	}
+
+	// Товары не помещаются в квоту открытой приемки
+	mock.ExpectQuery("UPDATE receptions").
+		WithArgs(5, reception.ID, models.ReceptionStatusInProgress, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"max_items", "items_count"}))
+	mock.ExpectQuery("SELECT (.+) FROM receptions").
+		WithArgs(reception.ID).
+		WillReturnRows(row(models.ReceptionStatusInProgress))
+
+	err = repo.AddItems(context.Background(), reception, 5)
+	assert.ErrorIs(t, err, errors.ErrReceptionQuotaExceeded)
+	assert.Equal(t, map[string]string{"maxItems": "10", "itemsCount": "7", "requested": "5"}, errors.Details(err))
+
+	// Приемку закрыли после того, как сессия сканирования ее нашла
+	mock.ExpectQuery("UPDATE receptions").
+		WithArgs(1, reception.ID, models.ReceptionStatusInProgress, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"max_items", "items_count"}))
+	mock.ExpectQuery("SELECT (.+) FROM receptions").
+		WithArgs(reception.ID).
+		WillReturnRows(row(models.ReceptionStatusClose))
+
+	err = repo.AddItems(context.Background(), reception, 1)
+	assert.ErrorIs(t, err, errors.ErrReceptionAlreadyClosed)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestReceptionRepository_RemoveItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReceptionRepository(&database.Database{DB: db})
+
+	receptionID := uuid.New()
+
+	mock.ExpectExec(`UPDATE receptions SET items_count = GREATEST\(items_count - \$1, 0\) WHERE id = \$2 AND status = \$3`).
+		WithArgs(1, receptionID, models.ReceptionStatusInProgress).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.RemoveItems(context.Background(), receptionID, 1)
+	require.NoError(t, err)
+
+	mock.ExpectExec("UPDATE receptions").
+		WithArgs(1, receptionID, models.ReceptionStatusInProgress).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.RemoveItems(context.Background(), receptionID, 1)
+	assert.ErrorIs(t, err, errors.ErrReceptionAlreadyClosed)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
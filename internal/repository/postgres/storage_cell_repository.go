@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+var storageCellColumns = []string{"id", "pvz_id", "rack", "shelf", "cell", "capacity", "occupied", "created_at"}
+
+// storageCellOrder - порядок обхода ячеек: по стеллажам, затем по полкам и ячейкам
+const storageCellOrder = "rack ASC, shelf ASC, cell ASC"
+
+type StorageCellRepository struct {
+	db *database.Database
+	sb squirrel.StatementBuilderType
+}
+
+func NewStorageCellRepository(db *database.Database) repository.StorageCellRepository {
+	return &StorageCellRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *StorageCellRepository) Create(ctx context.Context, cell *models.StorageCell) error {
+	query := r.sb.Insert("storage_cells").
+		Columns("id", "pvz_id", "rack", "shelf", "cell", "capacity", "occupied").
+		Values(cell.ID, cell.PVZID, cell.Rack, cell.Shelf, cell.Cell, cell.Capacity, cell.Occupied)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return errors.ErrStorageCellExists
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *StorageCellRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StorageCell, error) {
+	query := r.sb.Select(storageCellColumns...).
+		From("storage_cells").
+		Where(squirrel.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	cell, err := scanStorageCell(r.db.QueryRowContext(ctx, sql, args...))
+	if err != nil {
+		if errors.IsNoRows(err) {
+			return nil, errors.ErrStorageCellNotFound
+		}
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to get storage cell by ID: %v", err))
+	}
+
+	return cell, nil
+}
+
+func (r *StorageCellRepository) ListByPVZID(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error) {
+	query := r.sb.Select(storageCellColumns...).
+		From("storage_cells").
+		Where(squirrel.Eq{"pvz_id": pvzID}).
+		OrderBy(storageCellOrder)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var cells []*models.StorageCell
+	for rows.Next() {
+		cell, err := scanStorageCell(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		cells = append(cells, cell)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return cells, nil
+}
+
+func (r *StorageCellRepository) Occupy(ctx context.Context, id uuid.UUID) error {
+	query := r.sb.Update("storage_cells").
+		Set("occupied", squirrel.Expr("occupied + 1")).
+		Where(squirrel.Eq{"id": id}).
+		Where("occupied < capacity")
+
+	rowsAffected, err := r.changeOccupancy(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrStorageCellFull
+	}
+
+	return nil
+}
+
+// OccupyFree выбирает ячейку и занимает в ней место одним запросом.
+// SKIP LOCKED позволяет параллельным приемкам занимать разные ячейки, не дожидаясь друг друга.
+func (r *StorageCellRepository) OccupyFree(ctx context.Context, pvzID uuid.UUID) (*models.StorageCell, error) {
+	free := squirrel.Select("id").
+		From("storage_cells").
+		Where(squirrel.Eq{"pvz_id": pvzID}).
+		Where("occupied < capacity").
+		OrderBy(storageCellOrder).
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := r.sb.Update("storage_cells").
+		Set("occupied", squirrel.Expr("occupied + 1")).
+		Where(squirrel.Expr("id = (?)", free)).
+		Suffix("RETURNING " + strings.Join(storageCellColumns, ", "))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	cell, err := scanStorageCell(r.db.QueryRowContext(ctx, sql, args...))
+	if err != nil {
+		if errors.IsNoRows(err) {
+			return nil, errors.ErrNoFreeStorageCell
+		}
+		return nil, errors.Wrap(errors.ErrDBQuery, fmt.Sprintf("failed to occupy free storage cell: %v", err))
+	}
+
+	return cell, nil
+}
+
+// Release не опускает заполненность ниже нуля, повторное освобождение ничего не меняет
+func (r *StorageCellRepository) Release(ctx context.Context, id uuid.UUID) error {
+	query := r.sb.Update("storage_cells").
+		Set("occupied", squirrel.Expr("occupied - 1")).
+		Where(squirrel.Eq{"id": id}).
+		Where("occupied > 0")
+
+	_, err := r.changeOccupancy(ctx, query)
+	return err
+}
+
+// changeOccupancy выполняет условное изменение заполненности и возвращает число измененных ячеек
+func (r *StorageCellRepository) changeOccupancy(ctx context.Context, query squirrel.UpdateBuilder) (int64, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func scanStorageCell(row rowScanner) (*models.StorageCell, error) {
+	var cell models.StorageCell
+	err := row.Scan(
+		&cell.ID,
+		&cell.PVZID,
+		&cell.Rack,
+		&cell.Shelf,
+		&cell.Cell,
+		&cell.Capacity,
+		&cell.Occupied,
+		&cell.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &cell, nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/database"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+func TestStorageCellRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewStorageCellRepository(&database.Database{DB: db})
+
+	cell := models.NewStorageCell(uuid.New(), "A", "1", "3", 5)
+
+	mock.ExpectExec("INSERT INTO storage_cells").
+		WithArgs(cell.ID, cell.PVZID, cell.Rack, cell.Shelf, cell.Cell, cell.Capacity, cell.Occupied).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(context.Background(), cell)
+	require.NoError(t, err)
+
+	// Ячейка с таким адресом уже есть в ПВЗ
+	mock.ExpectExec("INSERT INTO storage_cells").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = repo.Create(context.Background(), cell)
+	assert.ErrorIs(t, err, errors.ErrStorageCellExists)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestStorageCellRepository_ListByPVZID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewStorageCellRepository(&database.Database{DB: db})
+
+	pvzID := uuid.New()
+	first := models.NewStorageCell(pvzID, "A", "1", "1", 5)
+	second := models.NewStorageCell(pvzID, "A", "1", "2", 5)
+
+	rows := sqlmock.NewRows(storageCellColumns).
+		AddRow(first.ID, first.PVZID, first.Rack, first.Shelf, first.Cell, first.Capacity, 5, first.CreatedAt).
+		AddRow(second.ID, second.PVZID, second.Rack, second.Shelf, second.Cell, second.Capacity, 0, second.CreatedAt)
+
+	mock.ExpectQuery(`SELECT (.+) FROM storage_cells WHERE pvz_id = \$1 ORDER BY rack ASC, shelf ASC, cell ASC`).
+		WithArgs(pvzID).
+		WillReturnRows(rows)
+
+	cells, err := repo.ListByPVZID(context.Background(), pvzID)
+	require.NoError(t, err)
+	require.Len(t, cells, 2)
+	assert.True(t, cells[0].IsFull())
+	assert.False(t, cells[1].IsFull())
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestStorageCellRepository_Occupy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewStorageCellRepository(&database.Database{DB: db})
+
+	cellID := uuid.New()
+
+	mock.ExpectExec(`UPDATE storage_cells SET occupied = occupied \+ 1 WHERE id = \$1 AND occupied < capacity`).
+		WithArgs(cellID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Occupy(context.Background(), cellID)
+	require.NoError(t, err)
+
+	// Ячейку успели заполнить в другом запросе
+	mock.ExpectExec("UPDATE storage_cells").
+		WithArgs(cellID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Occupy(context.Background(), cellID)
+	assert.ErrorIs(t, err, errors.ErrStorageCellFull)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestStorageCellRepository_OccupyFree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewStorageCellRepository(&database.Database{DB: db})
+
+	pvzID := uuid.New()
+	cell := models.NewStorageCell(pvzID, "B", "2", "4", 3)
+
+	rows := sqlmock.NewRows(storageCellColumns).
+		AddRow(cell.ID, cell.PVZID, cell.Rack, cell.Shelf, cell.Cell, cell.Capacity, 1, cell.CreatedAt)
+
+	mock.ExpectQuery(`UPDATE storage_cells SET occupied = occupied \+ 1 WHERE id = \(SELECT id FROM storage_cells WHERE pvz_id = \$1 AND occupied < capacity ORDER BY rack ASC, shelf ASC, cell ASC LIMIT 1 FOR UPDATE SKIP LOCKED\) RETURNING`).
+		WithArgs(pvzID).
+		WillReturnRows(rows)
+
+	occupied, err := repo.OccupyFree(context.Background(), pvzID)
+	require.NoError(t, err)
+	assert.Equal(t, cell.ID, occupied.ID)
+	assert.Equal(t, 1, occupied.Occupied)
+
+	// Свободных ячеек не осталось
+	mock.ExpectQuery("UPDATE storage_cells").
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows(storageCellColumns))
+
+	_, err = repo.OccupyFree(context.Background(), pvzID)
+	assert.ErrorIs(t, err, errors.ErrNoFreeStorageCell)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestStorageCellRepository_Release(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewStorageCellRepository(&database.Database{DB: db})
+
+	cellID := uuid.New()
+
+	// Пустая ячейка не считается ошибкой
+	mock.ExpectExec(`UPDATE storage_cells SET occupied = occupied - 1 WHERE id = \$1 AND occupied > 0`).
+		WithArgs(cellID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Release(context.Background(), cellID)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
@@ -7,17 +7,28 @@ import (
 )
 
 type Repositories struct {
-	User      repository.UserRepository
-	PVZ       repository.PVZRepository
-	Reception repository.ReceptionRepository
-	Product   repository.ProductRepository
+	User        repository.UserRepository
+	PVZ         repository.PVZRepository
+	Reception   repository.ReceptionRepository
+	Product     repository.ProductRepository
+	Catalog     repository.CatalogRepository
+	Cell        repository.StorageCellRepository
+	Idempotency repository.IdempotencyRepository
+	Assignment  repository.PVZAssignmentRepository
+	// Tx объединяет изменения нескольких хранилищ в одну транзакцию
+	Tx repository.Transactor
 }
 
 func NewRepositories(db *database.Database) *Repositories {
 	return &Repositories{
-		User:      postgres.NewUserRepository(db),
-		PVZ:       postgres.NewPVZRepository(db),
-		Reception: postgres.NewReceptionRepository(db),
-		Product:   postgres.NewProductRepository(db),
+		User:        postgres.NewUserRepository(db),
+		PVZ:         postgres.NewPVZRepository(db),
+		Reception:   postgres.NewReceptionRepository(db),
+		Product:     postgres.NewProductRepository(db),
+		Catalog:     postgres.NewCatalogRepository(db),
+		Cell:        postgres.NewStorageCellRepository(db),
+		Idempotency: postgres.NewIdempotencyRepository(db),
+		Assignment:  postgres.NewPVZAssignmentRepository(db),
+		Tx:          db,
 	}
 }
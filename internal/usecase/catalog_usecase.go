@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+// catalogCacheTTL - время жизни справочника в кэше. Изменения, сделанные
+// через другую реплику, становятся видны не позже чем через этот интервал.
+const catalogCacheTTL = time.Minute
+
+// catalogSnapshot - загруженный из БД справочник
+type catalogSnapshot struct {
+	entries  []*models.CatalogEntry
+	byName   map[string]*models.CatalogEntry
+	loadedAt time.Time
+}
+
+type CatalogUseCase struct {
+	catalogRepo repository.CatalogRepository
+	ttl         time.Duration
+	now         func() time.Time
+
+	mu    sync.RWMutex
+	cache map[models.CatalogKind]*catalogSnapshot
+	// versions растет при каждом изменении справочника, чтобы загрузка,
+	// начатая до изменения, не вернула в кэш устаревшие данные
+	versions map[models.CatalogKind]uint64
+}
+
+func NewCatalogUseCase(catalogRepo repository.CatalogRepository) usecase.CatalogUseCase {
+	return &CatalogUseCase{
+		catalogRepo: catalogRepo,
+		ttl:         catalogCacheTTL,
+		now:         time.Now,
+		cache:       make(map[models.CatalogKind]*catalogSnapshot),
+		versions:    make(map[models.CatalogKind]uint64),
+	}
+}
+
+func (uc *CatalogUseCase) List(ctx context.Context, kind models.CatalogKind) ([]*models.CatalogEntry, error) {
+	snapshot, err := uc.snapshot(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*models.CatalogEntry, len(snapshot.entries))
+	copy(entries, snapshot.entries)
+	return entries, nil
+}
+
+func (uc *CatalogUseCase) Create(ctx context.Context, kind models.CatalogKind, name string) (*models.CatalogEntry, error) {
+	if !models.IsValidCatalogKind(kind) {
+		return nil, errors.ErrCatalogNotFound
+	}
+	if !models.IsValidCatalogName(name) {
+		return nil, errors.ErrInvalidCatalogEntry
+	}
+
+	entry := models.NewCatalogEntry(name)
+	if err := uc.catalogRepo.Create(ctx, kind, entry); err != nil {
+		return nil, err
+	}
+
+	uc.invalidate(kind)
+
+	return entry, nil
+}
+
+func (uc *CatalogUseCase) SetActive(ctx context.Context, kind models.CatalogKind, name string, active bool) (*models.CatalogEntry, error) {
+	if !models.IsValidCatalogKind(kind) {
+		return nil, errors.ErrCatalogNotFound
+	}
+
+	entry, err := uc.catalogRepo.SetActive(ctx, kind, name, active)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidate(kind)
+
+	return entry, nil
+}
+
+func (uc *CatalogUseCase) IsActive(ctx context.Context, kind models.CatalogKind, name string) (bool, error) {
+	snapshot, err := uc.snapshot(ctx, kind)
+	if err != nil {
+		return false, err
+	}
+
+	entry, ok := snapshot.byName[name]
+	return ok && entry.Active, nil
+}
+
+// snapshot возвращает справочник из кэша, перечитывая его из БД по истечении TTL
+func (uc *CatalogUseCase) snapshot(ctx context.Context, kind models.CatalogKind) (*catalogSnapshot, error) {
+	if !models.IsValidCatalogKind(kind) {
+		return nil, errors.ErrCatalogNotFound
+	}
+
+	uc.mu.RLock()
+	snapshot, ok := uc.cache[kind]
+	version := uc.versions[kind]
+	uc.mu.RUnlock()
+
+	if ok && uc.now().Sub(snapshot.loadedAt) < uc.ttl {
+		return snapshot, nil
+	}
+
+	entries, err := uc.catalogRepo.List(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot = &catalogSnapshot{
+		entries:  entries,
+		byName:   make(map[string]*models.CatalogEntry, len(entries)),
+		loadedAt: uc.now(),
+	}
+	for _, entry := range entries {
+		snapshot.byName[entry.Name] = entry
+	}
+
+	uc.mu.Lock()
+	if uc.versions[kind] == version {
+		uc.cache[kind] = snapshot
+	}
+	uc.mu.Unlock()
+
+	return snapshot, nil
+}
+
+func (uc *CatalogUseCase) invalidate(kind models.CatalogKind) {
+	uc.mu.Lock()
+	delete(uc.cache, kind)
+	uc.versions[kind]++
+	uc.mu.Unlock()
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
+)
+
+// newTestCatalog возвращает справочник с исходными городами и категориями товаров
+func newTestCatalog(ctrl *gomock.Controller) domainUsecase.CatalogUseCase {
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return(catalogEntries(
+		string(models.CityMoscow), string(models.CitySaintPetersburg), string(models.CityKazan),
+	), nil).AnyTimes()
+	catalogRepo.EXPECT().List(gomock.Any(), models.CatalogProductTypes).Return(catalogEntries(
+		string(models.ProductTypeElectronics), string(models.ProductTypeClothes), string(models.ProductTypeShoes),
+	), nil).AnyTimes()
+
+	return NewCatalogUseCase(catalogRepo)
+}
+
+func catalogEntries(names ...string) []*models.CatalogEntry {
+	entries := make([]*models.CatalogEntry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, models.NewCatalogEntry(name))
+	}
+	return entries
+}
+
+func TestCatalogUseCase_IsActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	uc := NewCatalogUseCase(catalogRepo)
+
+	retired := models.NewCatalogEntry("Тверь")
+	retired.Active = false
+
+	// Справочник читается из БД один раз, дальше запросы идут в кэш
+	catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).
+		Return([]*models.CatalogEntry{models.NewCatalogEntry("Новосибирск"), retired}, nil)
+
+	active, err := uc.IsActive(context.Background(), models.CatalogCities, "Новосибирск")
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = uc.IsActive(context.Background(), models.CatalogCities, "Тверь")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	active, err = uc.IsActive(context.Background(), models.CatalogCities, "Париж")
+	require.NoError(t, err)
+	assert.False(t, active)
+}
+
+func TestCatalogUseCase_IsActive_Expired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	uc := NewCatalogUseCase(catalogRepo).(*CatalogUseCase)
+
+	now := time.Now()
+	uc.now = func() time.Time { return now }
+
+	gomock.InOrder(
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return(nil, nil),
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return(catalogEntries("Новосибирск"), nil),
+	)
+
+	active, err := uc.IsActive(context.Background(), models.CatalogCities, "Новосибирск")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	// Значение, добавленное через другую реплику, появляется после истечения TTL
+	now = now.Add(catalogCacheTTL)
+
+	active, err = uc.IsActive(context.Background(), models.CatalogCities, "Новосибирск")
+	require.NoError(t, err)
+	assert.True(t, active)
+}
+
+func TestCatalogUseCase_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	uc := NewCatalogUseCase(catalogRepo)
+
+	gomock.InOrder(
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogProductTypes).Return(nil, nil),
+		catalogRepo.EXPECT().Create(gomock.Any(), models.CatalogProductTypes, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ models.CatalogKind, entry *models.CatalogEntry) error {
+				assert.Equal(t, "бытовая техника", entry.Name)
+				assert.True(t, entry.Active)
+				return nil
+			}),
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogProductTypes).Return(catalogEntries("бытовая техника"), nil),
+	)
+
+	active, err := uc.IsActive(context.Background(), models.CatalogProductTypes, "бытовая техника")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	entry, err := uc.Create(context.Background(), models.CatalogProductTypes, "бытовая техника")
+	require.NoError(t, err)
+	assert.Equal(t, "бытовая техника", entry.Name)
+
+	// Изменение сбрасывает кэш, новое значение доступно сразу
+	active, err = uc.IsActive(context.Background(), models.CatalogProductTypes, "бытовая техника")
+	require.NoError(t, err)
+	assert.True(t, active)
+}
+
+func TestCatalogUseCase_Create_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewCatalogUseCase(mock.NewMockCatalogRepository(ctrl))
+
+	_, err := uc.Create(context.Background(), models.CatalogKind("countries"), "Россия")
+	assert.ErrorIs(t, err, errors.ErrCatalogNotFound)
+
+	_, err = uc.Create(context.Background(), models.CatalogCities, "")
+	assert.ErrorIs(t, err, errors.ErrInvalidCatalogEntry)
+
+	_, err = uc.Create(context.Background(), models.CatalogCities, " Омск")
+	assert.ErrorIs(t, err, errors.ErrInvalidCatalogEntry)
+}
+
+func TestCatalogUseCase_SetActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	uc := NewCatalogUseCase(catalogRepo)
+
+	retired := models.NewCatalogEntry(string(models.CityKazan))
+	retired.Active = false
+
+	gomock.InOrder(
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return(catalogEntries(string(models.CityKazan)), nil),
+		catalogRepo.EXPECT().SetActive(gomock.Any(), models.CatalogCities, string(models.CityKazan), false).Return(retired, nil),
+		catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return([]*models.CatalogEntry{retired}, nil),
+	)
+
+	entries, err := uc.List(context.Background(), models.CatalogCities)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Active)
+
+	entry, err := uc.SetActive(context.Background(), models.CatalogCities, string(models.CityKazan), false)
+	require.NoError(t, err)
+	assert.False(t, entry.Active)
+
+	// Выведенное значение остается в справочнике
+	entries, err = uc.List(context.Background(), models.CatalogCities)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.False(t, entries[0].Active)
+}
+
+func TestCatalogUseCase_SetActive_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+	uc := NewCatalogUseCase(catalogRepo)
+
+	catalogRepo.EXPECT().SetActive(gomock.Any(), models.CatalogCities, "Париж", false).Return(nil, errors.ErrCatalogEntryNotFound)
+
+	_, err := uc.SetActive(context.Background(), models.CatalogCities, "Париж", false)
+	assert.ErrorIs(t, err, errors.ErrCatalogEntryNotFound)
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+const (
+	// defaultIdempotencyTTL используется, если время хранения ответов не задано в конфигурации
+	defaultIdempotencyTTL = 24 * time.Hour
+	// idempotencyLockTimeout - сколько ключ остается занятым выполняющимся запросом.
+	// Если реплика упала посреди запроса, повтор выполнится после этого времени.
+	idempotencyLockTimeout = time.Minute
+)
+
+type IdempotencyUseCase struct {
+	idempotencyRepo repository.IdempotencyRepository
+	ttl             time.Duration
+}
+
+func NewIdempotencyUseCase(idempotencyRepo repository.IdempotencyRepository, ttl time.Duration) usecase.IdempotencyUseCase {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	return &IdempotencyUseCase{
+		idempotencyRepo: idempotencyRepo,
+		ttl:             ttl,
+	}
+}
+
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*models.IdempotencyRecord, error) {
+	if !models.IsValidIdempotencyKey(key) {
+		return nil, errors.ErrInvalidIdempotencyKey
+	}
+
+	record := models.NewIdempotencyRecord(userID, key, requestHash, idempotencyLockTimeout)
+
+	// Запись может исчезнуть между попытками, если занявший ключ запрос завершился ошибкой
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := uc.idempotencyRepo.Reserve(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		if reserved {
+			return record, nil
+		}
+
+		existing, err := uc.idempotencyRepo.Get(ctx, userID, key)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if existing.RequestHash != requestHash {
+			return nil, errors.ErrIdempotencyKeyReused
+		}
+		if !existing.IsCompleted() {
+			return nil, errors.ErrIdempotencyKeyInProgress
+		}
+		return existing, nil
+	}
+
+	return nil, errors.ErrIdempotencyKeyInProgress
+}
+
+func (uc *IdempotencyUseCase) Complete(ctx context.Context, record *models.IdempotencyRecord, statusCode int, response []byte) error {
+	record.Complete(statusCode, response, uc.ttl)
+	return uc.idempotencyRepo.Complete(ctx, record)
+}
+
+func (uc *IdempotencyUseCase) Release(ctx context.Context, record *models.IdempotencyRecord) error {
+	return uc.idempotencyRepo.Delete(ctx, record)
+}
+
+func (uc *IdempotencyUseCase) DeleteExpired(ctx context.Context) (int64, error) {
+	return uc.idempotencyRepo.DeleteExpired(ctx, time.Now())
+}
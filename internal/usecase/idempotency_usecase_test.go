@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
+)
+
+func TestIdempotencyUseCase_Begin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idempotencyRepo := mock.NewMockIdempotencyRepository(ctrl)
+	uc := NewIdempotencyUseCase(idempotencyRepo, time.Hour)
+
+	userID := uuid.New()
+	completed := models.NewIdempotencyRecord(userID, "scan-42", "hash", time.Minute)
+	completed.Complete(201, []byte(`{"id":"1"}`), time.Hour)
+
+	// Свободный ключ резервируется за запросом
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record *models.IdempotencyRecord) (bool, error) {
+		assert.Equal(t, userID, record.UserID)
+		assert.Equal(t, "scan-42", record.Key)
+		assert.Equal(t, "hash", record.RequestHash)
+		return true, nil
+	})
+
+	record, err := uc.Begin(context.Background(), userID, "scan-42", "hash")
+	require.NoError(t, err)
+	assert.False(t, record.IsCompleted())
+
+	// Повтор выполненного запроса получает сохраненный ответ
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+	idempotencyRepo.EXPECT().Get(gomock.Any(), userID, "scan-42").Return(completed, nil)
+
+	record, err = uc.Begin(context.Background(), userID, "scan-42", "hash")
+	require.NoError(t, err)
+	assert.True(t, record.IsCompleted())
+	assert.Equal(t, completed.Response, record.Response)
+
+	// Тот же ключ с другим запросом
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+	idempotencyRepo.EXPECT().Get(gomock.Any(), userID, "scan-42").Return(completed, nil)
+
+	_, err = uc.Begin(context.Background(), userID, "scan-42", "other")
+	assert.ErrorIs(t, err, errors.ErrIdempotencyKeyReused)
+
+	// Исходный запрос еще выполняется
+	inProgress := models.NewIdempotencyRecord(userID, "scan-42", "hash", time.Minute)
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+	idempotencyRepo.EXPECT().Get(gomock.Any(), userID, "scan-42").Return(inProgress, nil)
+
+	_, err = uc.Begin(context.Background(), userID, "scan-42", "hash")
+	assert.ErrorIs(t, err, errors.ErrIdempotencyKeyInProgress)
+
+	// Исходный запрос завершился ошибкой и освободил ключ между попытками
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+	idempotencyRepo.EXPECT().Get(gomock.Any(), userID, "scan-42").Return(nil, errors.ErrIdempotencyKeyNotFound)
+	idempotencyRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(true, nil)
+
+	record, err = uc.Begin(context.Background(), userID, "scan-42", "hash")
+	require.NoError(t, err)
+	assert.False(t, record.IsCompleted())
+
+	_, err = uc.Begin(context.Background(), userID, "", "hash")
+	assert.ErrorIs(t, err, errors.ErrInvalidIdempotencyKey)
+
+	_, err = uc.Begin(context.Background(), userID, "ключ", "hash")
+	assert.ErrorIs(t, err, errors.ErrInvalidIdempotencyKey)
+}
+
+func TestIdempotencyUseCase_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idempotencyRepo := mock.NewMockIdempotencyRepository(ctrl)
+	uc := NewIdempotencyUseCase(idempotencyRepo, time.Hour)
+
+	record := models.NewIdempotencyRecord(uuid.New(), "scan-42", "hash", time.Minute)
+
+	idempotencyRepo.EXPECT().Complete(gomock.Any(), record).DoAndReturn(func(_ context.Context, record *models.IdempotencyRecord) error {
+		assert.True(t, record.IsCompleted())
+		assert.Equal(t, 201, record.StatusCode)
+		// Ответ хранится весь TTL, а не только время блокировки ключа
+		assert.WithinDuration(t, time.Now().Add(time.Hour), record.ExpiresAt, time.Minute)
+		return nil
+	})
+
+	require.NoError(t, uc.Complete(context.Background(), record, 201, []byte(`{}`)))
+
+	idempotencyRepo.EXPECT().Delete(gomock.Any(), record).Return(nil)
+
+	require.NoError(t, uc.Release(context.Background(), record))
+}
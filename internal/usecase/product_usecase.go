@@ -2,78 +2,643 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
 )
 
 type ProductUseCase struct {
 	pvzRepo       repository.PVZRepository
 	receptionRepo repository.ReceptionRepository
 	productRepo   repository.ProductRepository
+	cellRepo      repository.StorageCellRepository
+	tx            repository.Transactor
+	catalog       usecase.CatalogUseCase
+	access        usecase.PVZAssignmentUseCase
+	events        events.Publisher
+	metrics       metrics.MetricsInterface
 }
 
 func NewProductUseCase(
 	pvzRepo repository.PVZRepository,
 	receptionRepo repository.ReceptionRepository,
 	productRepo repository.ProductRepository,
+	cellRepo repository.StorageCellRepository,
+	tx repository.Transactor,
+	catalog usecase.CatalogUseCase,
+	access usecase.PVZAssignmentUseCase,
+	eventPublisher events.Publisher,
+	metrics metrics.MetricsInterface,
 ) usecase.ProductUseCase {
 	return &ProductUseCase{
 		pvzRepo:       pvzRepo,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
+		cellRepo:      cellRepo,
+		tx:            tx,
+		catalog:       catalog,
+		access:        access,
+		events:        eventPublisher,
+		metrics:       metrics,
 	}
 }
 
-func (uc *ProductUseCase) Create(ctx context.Context, productType models.ProductType, pvzID uuid.UUID) (*models.Product, error) {
-	if !models.IsValidProductType(productType) {
-		return nil, errors.ErrInvalidProductType
+func (uc *ProductUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.ProductInput) (*models.Product, error) {
+	if err := uc.validateProductInput(ctx, input); err != nil {
+		return nil, err
 	}
 
-	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
 	if err != nil {
 		return nil, err
 	}
+	if !pvz.IsActive() {
+		return nil, errors.ErrPVZNotActive
+	}
 
 	reception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
 	if err != nil {
 		return nil, err
 	}
 
-	product := models.NewProduct(productType, reception.ID)
+	return uc.addToReception(ctx, pvz, reception, input)
+}
 
-	if err := uc.productRepo.Create(ctx, product); err != nil {
+func (uc *ProductUseCase) CreateBatch(ctx context.Context, pvzID uuid.UUID, inputs []usecase.ProductInput) ([]*models.Product, error) {
+	if !models.IsValidProductBatchSize(len(inputs)) {
+		return nil, errors.ErrInvalidBatchSize
+	}
+	if err := uc.validateBatch(ctx, inputs); err != nil {
 		return nil, err
 	}
 
-	return product, nil
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+	if !pvz.IsActive() {
+		return nil, errors.ErrPVZNotActive
+	}
+
+	reception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs {
+		if err := uc.validateReturnDetails(ctx, reception, input); err != nil {
+			return nil, batchItemError(i, err)
+		}
+	}
+
+	// Время приема растет по порядку пачки, чтобы последним товаром приемки оставался последний в пачке.
+	// Шаг в микросекунду - точность времени в PostgreSQL.
+	now := time.Now()
+	products := make([]*models.Product, len(inputs))
+	for i, input := range inputs {
+		product := models.NewProduct(input.Type, reception.ID)
+		product.DateTime = now.Add(time.Duration(i) * time.Microsecond)
+		product.StatusChangedAt = product.DateTime
+		product.CreatedAt = product.DateTime
+		product.OrderID = input.OrderID
+		product.Barcode = input.Barcode
+		product.ReturnReason = input.ReturnReason
+		product.OriginalProductID = input.OriginalProductID
+		products[i] = product
+	}
+
+	// Квота приемки, место в ПВЗ, ячейки и сами товары сохраняются одной транзакцией:
+	// пачка принимается целиком или не принимается
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.receptionRepo.AddItems(ctx, reception, len(products)); err != nil {
+			return err
+		}
+		if err := uc.pvzRepo.Occupy(ctx, pvz, len(products)); err != nil {
+			return err
+		}
+		if err := uc.placeBatch(ctx, pvz, products, inputs); err != nil {
+			return err
+		}
+		// Повтор штрихкода, уже принятого в приемку, отклоняет уникальный индекс при вставке
+		return uc.productRepo.CreateBatch(ctx, products)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, product := range products {
+		uc.events.Publish(models.NewProductEvent(models.PVZEventProductAdded, pvz, product))
+	}
+	uc.signalNearlyFull(pvz, len(products))
+
+	return products, nil
 }
 
 func (uc *ProductUseCase) DeleteLastFromReception(ctx context.Context, pvzID uuid.UUID) error {
-	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return err
+	}
+
+	reception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
 	if err != nil {
 		return err
 	}
 
+	_, err = uc.deleteLastFromReception(ctx, pvz, reception)
+	return err
+}
+
+func (uc *ProductUseCase) Delete(ctx context.Context, pvzID, productID uuid.UUID) (*models.Product, error) {
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	product, reception, err := uc.getPVZProduct(ctx, pvz, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reception.IsInProgress() {
+		return nil, errors.ErrReceptionAlreadyClosed
+	}
+	if product.Status != models.ProductStatusAccepted {
+		return nil, errors.ErrProductNotAccepted
+	}
+
+	if err := uc.removeProduct(ctx, pvz, product); err != nil {
+		return nil, err
+	}
+
+	uc.events.Publish(models.NewProductEvent(models.PVZEventProductDeleted, pvz, product))
+
+	return product, nil
+}
+
+func (uc *ProductUseCase) ChangeStatus(ctx context.Context, pvzID, productID uuid.UUID, status models.ProductStatus) (*models.Product, error) {
+	if !models.IsValidProductStatus(status) {
+		return nil, errors.ErrInvalidProductStatus
+	}
+
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	product, _, err := uc.getPVZProduct(ctx, pvz, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !product.CanTransitionTo(status) {
+		return nil, errors.ErrProductStatusTransition
+	}
+
+	from := product.Status
+	product.SetStatus(status)
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.productRepo.UpdateStatus(ctx, product, from); err != nil {
+			return err
+		}
+		// Выданный или отказной товар покидает полку и освобождает место в ПВЗ и ячейке
+		if models.IsShelfProductStatus(status) {
+			return nil
+		}
+		return uc.release(ctx, pvz, product)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.events.Publish(models.NewProductEvent(models.PVZEventProductStatusChanged, pvz, product))
+
+	return product, nil
+}
+
+func (uc *ProductUseCase) ListShelf(ctx context.Context, pvzID uuid.UUID, statuses []models.ProductStatus, page, limit int) ([]*models.Product, error) {
+	if len(statuses) == 0 {
+		statuses = models.ShelfProductStatuses
+	}
+	for _, status := range statuses {
+		// Выданные и отказные товары на полке не лежат
+		if !models.IsShelfProductStatus(status) {
+			return nil, errors.ErrInvalidProductStatus
+		}
+	}
+
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.pvzRepo.GetByID(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	return uc.productRepo.ListShelf(ctx, pvzID, statuses, page, limit)
+}
+
+// FindByBarcode находит последний принятый товар с указанным штрихкодом и ПВЗ, в который он поступил
+func (uc *ProductUseCase) FindByBarcode(ctx context.Context, barcode string) (*usecase.ProductLocation, error) {
+	if barcode == "" || !models.IsValidProductIdentifier(barcode) {
+		return nil, errors.ErrInvalidBarcode
+	}
+
+	product, err := uc.productRepo.GetLastByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := uc.receptionRepo.GetByID(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Товар чужого ПВЗ для сотрудника не существует, чтобы не раскрывать, где он хранится
+	if err := uc.access.CheckAccess(ctx, reception.PVZID); err != nil {
+		if errors.Is(err, errors.ErrPVZAccessDenied) {
+			return nil, errors.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, reception.PVZID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.ProductLocation{Product: product, Reception: reception, PVZ: pvz}, nil
+}
+
+// StartScanSession находит открытую приемку ПВЗ или открывает новую, если открытой нет
+func (uc *ProductUseCase) StartScanSession(ctx context.Context, pvzID uuid.UUID) (*usecase.ScanSession, error) {
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+	if !pvz.IsActive() {
+		return nil, errors.ErrPVZNotActive
+	}
+
 	reception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		reception = models.NewReception(pvzID)
+		if err := uc.receptionRepo.Create(ctx, reception); err != nil {
+			return nil, err
+		}
+
+		uc.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, reception))
+	}
+
+	return &usecase.ScanSession{PVZ: pvz, Reception: reception}, nil
+}
+
+// AddToSession добавляет товар в приемку сессии. Приемка повторно не ищется:
+// если ее закрыли за время сессии, товар отклоняется при сохранении.
+func (uc *ProductUseCase) AddToSession(ctx context.Context, session *usecase.ScanSession, input usecase.ProductInput) (*models.Product, error) {
+	if err := uc.validateProductInput(ctx, input); err != nil {
+		return nil, err
+	}
+	if err := uc.refreshSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return uc.addToReception(ctx, session.PVZ, session.Reception, input)
+}
+
+// UndoInSession удаляет последний товар из приемки сессии и возвращает его
+func (uc *ProductUseCase) UndoInSession(ctx context.Context, session *usecase.ScanSession) (*models.Product, error) {
+	if err := uc.refreshSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return uc.deleteLastFromReception(ctx, session.PVZ, session.Reception)
+}
+
+// refreshSession повторяет проверки StartScanSession перед командой сессии: за время
+// сессии сотрудника могли снять с ПВЗ, а ПВЗ - приостановить или архивировать
+func (uc *ProductUseCase) refreshSession(ctx context.Context, session *usecase.ScanSession) error {
+	if err := uc.access.CheckAccess(ctx, session.PVZ.ID); err != nil {
+		return err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, session.PVZ.ID)
 	if err != nil {
 		return err
 	}
+	if !pvz.IsActive() {
+		return errors.ErrPVZNotActive
+	}
 
+	session.PVZ = pvz
+	return nil
+}
+
+func (uc *ProductUseCase) addToReception(ctx context.Context, pvz *models.PVZ, reception *models.Reception, input usecase.ProductInput) (*models.Product, error) {
+	// Повторное сканирование того же штрихкода в приемке отклоняется
+	if input.Barcode != "" {
+		_, err := uc.productRepo.GetByReceptionIDAndBarcode(ctx, reception.ID, input.Barcode)
+		if err == nil {
+			return nil, errors.ErrDuplicateBarcode
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if err := uc.validateReturnDetails(ctx, reception, input); err != nil {
+		return nil, err
+	}
+
+	product := models.NewProduct(input.Type, reception.ID)
+	product.OrderID = input.OrderID
+	product.Barcode = input.Barcode
+	product.ReturnReason = input.ReturnReason
+	product.OriginalProductID = input.OriginalProductID
+
+	// Квота приемки и место в ПВЗ и ячейке занимаются в одной транзакции с сохранением товара,
+	// поэтому при ошибке их возвращает откат
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.receptionRepo.AddItems(ctx, reception, 1); err != nil {
+			return err
+		}
+		if err := uc.pvzRepo.Occupy(ctx, pvz, 1); err != nil {
+			return err
+		}
+		cellID, err := uc.placeProduct(ctx, pvz, input.CellID)
+		if err != nil {
+			return err
+		}
+		product.CellID = cellID
+		return uc.productRepo.Create(ctx, product)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.events.Publish(models.NewProductEvent(models.PVZEventProductAdded, pvz, product))
+	uc.signalNearlyFull(pvz, 1)
+
+	return product, nil
+}
+
+func (uc *ProductUseCase) deleteLastFromReception(ctx context.Context, pvz *models.PVZ, reception *models.Reception) (*models.Product, error) {
 	product, err := uc.productRepo.GetLastByReceptionID(ctx, reception.ID)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return errors.ErrNoProductsToDelete
+			return nil, errors.ErrNoProductsToDelete
+		}
+		return nil, err
+	}
+	if product.Status != models.ProductStatusAccepted {
+		return nil, errors.ErrProductNotAccepted
+	}
+
+	if err := uc.removeProduct(ctx, pvz, product); err != nil {
+		return nil, err
+	}
+
+	uc.events.Publish(models.NewProductEvent(models.PVZEventProductDeleted, pvz, product))
+
+	return product, nil
+}
+
+// signalNearlyFull сообщает, что added товаров довели ПВЗ до порога заполнения.
+// Счетчик для логистики увеличивается здесь, а не по событию: брокер при переполнении
+// теряет события, и в него сигнал передается только для подписчиков WatchPVZEvents.
+func (uc *ProductUseCase) signalNearlyFull(pvz *models.PVZ, added int) {
+	if !pvz.ReachedNearlyFull(added) {
+		return
+	}
+	uc.metrics.IncPVZNearlyFull(string(pvz.City))
+	uc.events.Publish(models.NewPVZEvent(models.PVZEventNearlyFull, pvz))
+}
+
+// placeProduct занимает место в выбранной ячейке или в первой свободной ячейке ПВЗ
+// и возвращает ее. ПВЗ без схемы хранения принимает товары без ячейки.
+func (uc *ProductUseCase) placeProduct(ctx context.Context, pvz *models.PVZ, cellID *uuid.UUID) (*uuid.UUID, error) {
+	if cellID != nil {
+		cell, err := uc.cellRepo.GetByID(ctx, *cellID)
+		if err != nil {
+			return nil, err
+		}
+		if cell.PVZID != pvz.ID {
+			return nil, errors.ErrStorageCellNotFound
+		}
+		if err := uc.cellRepo.Occupy(ctx, cell.ID); err != nil {
+			return nil, err
+		}
+		return &cell.ID, nil
+	}
+
+	cell, err := uc.cellRepo.OccupyFree(ctx, pvz.ID)
+	if err == nil {
+		return &cell.ID, nil
+	}
+	if !errors.Is(err, errors.ErrNoFreeStorageCell) {
+		return nil, err
+	}
+
+	cells, err := uc.cellRepo.ListByPVZID(ctx, pvz.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) == 0 {
+		return nil, nil
+	}
+	return nil, errors.ErrNoFreeStorageCell
+}
+
+// placeBatch раскладывает товары пачки по ячейкам. Выполняется в транзакции пачки,
+// поэтому ячейки, занятые до товара, который не поместился, освобождает откат.
+func (uc *ProductUseCase) placeBatch(ctx context.Context, pvz *models.PVZ, products []*models.Product, inputs []usecase.ProductInput) error {
+	cells, err := uc.cellRepo.ListByPVZID(ctx, pvz.ID)
+	if err != nil {
+		return err
+	}
+
+	for i, product := range products {
+		// Без схемы хранения товар принимается без ячейки, искать свободную не нужно
+		if len(cells) == 0 && inputs[i].CellID == nil {
+			continue
+		}
+		cellID, err := uc.placeProduct(ctx, pvz, inputs[i].CellID)
+		if err != nil {
+			return batchItemError(i, err)
+		}
+		product.CellID = cellID
+	}
+	return nil
+}
+
+// removeProduct удаляет товар из приемки и освобождает его место в квоте приемки, ПВЗ и ячейке одной транзакцией
+func (uc *ProductUseCase) removeProduct(ctx context.Context, pvz *models.PVZ, product *models.Product) error {
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.productRepo.Delete(ctx, product.ID); err != nil {
+			return err
 		}
+		if err := uc.receptionRepo.RemoveItems(ctx, product.ReceptionID, 1); err != nil {
+			return err
+		}
+		return uc.release(ctx, pvz, product)
+	})
+}
+
+// release освобождает место товара, покинувшего полку, в ПВЗ и в ячейке, если он лежал в ячейке
+func (uc *ProductUseCase) release(ctx context.Context, pvz *models.PVZ, product *models.Product) error {
+	if err := uc.pvzRepo.Release(ctx, pvz.ID, 1); err != nil {
 		return err
 	}
-	
-	if err := uc.productRepo.Delete(ctx, product.ID); err != nil {
+	if product.CellID == nil {
+		return nil
+	}
+	return uc.cellRepo.Release(ctx, *product.CellID)
+}
+
+// getPVZProduct находит товар и его приемку. Товар другого ПВЗ для этого ПВЗ не существует.
+func (uc *ProductUseCase) getPVZProduct(ctx context.Context, pvz *models.PVZ, productID uuid.UUID) (*models.Product, *models.Reception, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reception, err := uc.receptionRepo.GetByID(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if reception.PVZID != pvz.ID {
+		return nil, nil, errors.ErrProductNotFound
+	}
+
+	return product, reception, nil
+}
+
+func (uc *ProductUseCase) validateProductInput(ctx context.Context, input usecase.ProductInput) error {
+	active, err := uc.catalog.IsActive(ctx, models.CatalogProductTypes, string(input.Type))
+	if err != nil {
 		return err
 	}
+	if !active {
+		return errors.ErrInvalidProductType
+	}
+	return validateProductIdentifiers(input)
+}
+
+// validateBatch проверяет все товары пачки до обращения к ПВЗ.
+// Каждая категория проверяется по справочнику один раз на пачку.
+func (uc *ProductUseCase) validateBatch(ctx context.Context, inputs []usecase.ProductInput) error {
+	activeTypes := make(map[models.ProductType]bool)
+	barcodes := make(map[string]struct{}, len(inputs))
 
+	for i, input := range inputs {
+		active, checked := activeTypes[input.Type]
+		if !checked {
+			var err error
+			active, err = uc.catalog.IsActive(ctx, models.CatalogProductTypes, string(input.Type))
+			if err != nil {
+				return err
+			}
+			activeTypes[input.Type] = active
+		}
+		if !active {
+			return batchItemError(i, errors.ErrInvalidProductType)
+		}
+		if err := validateProductIdentifiers(input); err != nil {
+			return batchItemError(i, err)
+		}
+
+		if input.Barcode == "" {
+			continue
+		}
+		if _, ok := barcodes[input.Barcode]; ok {
+			return batchItemError(i, errors.ErrDuplicateBarcode)
+		}
+		barcodes[input.Barcode] = struct{}{}
+	}
+	return nil
+}
+
+// batchItemError указывает в ошибке и ее сведениях позицию товара в пачке, сохраняя исходную ошибку
+func batchItemError(i int, err error) error {
+	return errors.WithDetails(errors.Wrap(err, fmt.Sprintf("products[%d]", i)), map[string]string{"index": strconv.Itoa(i)})
+}
+
+func validateProductIdentifiers(input usecase.ProductInput) error {
+	if !models.IsValidProductIdentifier(input.OrderID) {
+		return errors.ErrInvalidOrderID
+	}
+	if !models.IsValidProductIdentifier(input.Barcode) {
+		return errors.ErrInvalidBarcode
+	}
+	return nil
+}
+
+// validateReturnDetails проверяет данные возврата: они обязательны в приемке возвратов и запрещены в поставке
+func (uc *ProductUseCase) validateReturnDetails(ctx context.Context, reception *models.Reception, input usecase.ProductInput) error {
+	if !reception.IsCustomerReturn() {
+		if input.ReturnReason != "" || input.OriginalProductID != nil {
+			return errors.ErrReturnDetailsNotAllowed
+		}
+		return nil
+	}
+
+	if !models.IsValidReturnReason(input.ReturnReason) {
+		return errors.ErrInvalidReturnReason
+	}
+	if input.OriginalProductID == nil {
+		if input.OrderID == "" {
+			return errors.ErrReturnReferenceRequired
+		}
+		return nil
+	}
+
+	// Вернуть можно только товар, который был выдан получателю
+	original, err := uc.productRepo.GetByID(ctx, *input.OriginalProductID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrReturnedProductNotFound
+		}
+		return err
+	}
+	if original.Status != models.ProductStatusIssued {
+		return errors.ErrReturnedProductNotIssued
+	}
 	return nil
 }
@@ -11,19 +11,51 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
 	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
 )
 
+// newTestPVZRepository возвращает хранилище ПВЗ без ограничения вместимости
+func newTestPVZRepository(ctrl *gomock.Controller) *mock.MockPVZRepository {
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	pvzRepo.EXPECT().Occupy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	pvzRepo.EXPECT().Release(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return pvzRepo
+}
+
+// newTestReceptionRepository возвращает хранилище приемок без ограничения квоты
+func newTestReceptionRepository(ctrl *gomock.Controller) *mock.MockReceptionRepository {
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	receptionRepo.EXPECT().AddItems(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	receptionRepo.EXPECT().RemoveItems(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return receptionRepo
+}
+
+// newTestTransactor выполняет переданную функцию без транзакции
+func newTestTransactor(ctrl *gomock.Controller) *mock.MockTransactor {
+	tx := mock.NewMockTransactor(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+	return tx
+}
+
 func TestProductUseCase_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
 
 	pvzID := uuid.New()
 	receptionID := uuid.New()
@@ -33,6 +65,7 @@ func TestProductUseCase_Create(t *testing.T) {
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -54,26 +87,33 @@ func TestProductUseCase_Create(t *testing.T) {
 		return nil
 	})
 
-	product, err := uc.Create(context.Background(), productType, pvzID)
+	product, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ProductInput{Type: productType})
 	require.NoError(t, err)
 	assert.Equal(t, receptionID, product.ReceptionID)
 	assert.Equal(t, productType, product.Type)
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventProductAdded, event.Type)
+	assert.Equal(t, pvzID, event.PVZID)
+	assert.Equal(t, models.CityMoscow, event.City)
+	assert.Equal(t, product, event.Product)
 }
 
 func TestProductUseCase_Create_InvalidProductType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 	invalidProductType := models.ProductType("Invalid Type")
 
-	_, err := uc.Create(context.Background(), invalidProductType, pvzID)
+	_, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ProductInput{Type: invalidProductType})
 	assert.ErrorIs(t, err, errors.ErrInvalidProductType)
 }
 
@@ -81,11 +121,11 @@ func TestProductUseCase_Create_PVZNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 	productType := models.ProductTypeElectronics
@@ -93,19 +133,57 @@ func TestProductUseCase_Create_PVZNotFound(t *testing.T) {
 	// ПВЗ не найден
 	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
 
-	_, err := uc.Create(context.Background(), productType, pvzID)
+	_, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ProductInput{Type: productType})
 	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
 }
 
+func TestProductUseCase_Create_PVZNotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assignmentRepo := mock.NewMockPVZAssignmentRepository(ctrl)
+	access := NewPVZAssignmentUseCase(assignmentRepo, mock.NewMockPVZRepository(ctrl), mock.NewMockUserRepository(ctrl))
+
+	uc := NewProductUseCase(newTestPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), access, events.NewBroker(), metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+	employee := models.NewUser("employee@example.com", "hash", models.EmployeeRole)
+
+	assignmentRepo.EXPECT().Exists(gomock.Any(), employee.ID, pvzID).Return(false, nil)
+
+	ctx := domainUsecase.WithCaller(context.Background(), employee)
+	_, err := uc.Create(ctx, pvzID, domainUsecase.ProductInput{Type: models.ProductTypeElectronics})
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+}
+
+func TestProductUseCase_Create_PVZNotActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusArchived, "закрыт")
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeElectronics})
+	assert.ErrorIs(t, err, errors.ErrPVZNotActive)
+}
+
 func TestProductUseCase_Create_NoOpenReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 	productType := models.ProductTypeElectronics
@@ -114,6 +192,7 @@ func TestProductUseCase_Create_NoOpenReception(t *testing.T) {
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -121,7 +200,7 @@ func TestProductUseCase_Create_NoOpenReception(t *testing.T) {
 
 	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvzID).Return(nil, errors.ErrOpenReceptionNotFound)
 
-	_, err := uc.Create(context.Background(), productType, pvzID)
+	_, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ProductInput{Type: productType})
 	assert.ErrorIs(t, err, errors.ErrOpenReceptionNotFound)
 }
 
@@ -129,11 +208,11 @@ func TestProductUseCase_DeleteLastFromReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 	receptionID := uuid.New()
@@ -143,6 +222,7 @@ func TestProductUseCase_DeleteLastFromReception(t *testing.T) {
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -159,6 +239,7 @@ func TestProductUseCase_DeleteLastFromReception(t *testing.T) {
 		DateTime:    time.Now(),
 		Type:        models.ProductTypeElectronics,
 		ReceptionID: receptionID,
+		Status:      models.ProductStatusAccepted,
 		CreatedAt:   time.Now(),
 	}
 
@@ -170,25 +251,152 @@ func TestProductUseCase_DeleteLastFromReception(t *testing.T) {
 
 	productRepo.EXPECT().Delete(gomock.Any(), productID).Return(nil)
 
-	err := uc.DeleteLastFromReception(context.Background(), pvzID)
+	err := uc.DeleteLastFromReception(newSystemContext(), pvzID)
 	require.NoError(t, err)
 }
 
-func TestProductUseCase_DeleteLastFromReception_PVZNotFound(t *testing.T) {
+func TestProductUseCase_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{PVZID: pvz.ID})
+	defer unsubscribe()
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	productRepo.EXPECT().Delete(gomock.Any(), product.ID).Return(nil)
+
+	deleted, err := uc.Delete(newSystemContext(), pvz.ID, product.ID)
+	require.NoError(t, err)
+	assert.Equal(t, product, deleted)
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventProductDeleted, event.Type)
+	assert.Equal(t, product, event.Product)
+}
+
+func TestProductUseCase_Delete_ReleaseInTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
+	tx := mock.NewMockTransactor(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), tx, newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+
+	// Контекст транзакции отличается от исходного, по нему видно, что запрос выполнен в ней
+	type txKey struct{}
+	inTx := func(ctx context.Context) bool { return ctx.Value(txKey{}) != nil }
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	})
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	productRepo.EXPECT().Delete(gomock.Any(), product.ID).DoAndReturn(func(ctx context.Context, _ uuid.UUID) error {
+		assert.True(t, inTx(ctx))
+		return nil
+	})
+	receptionRepo.EXPECT().RemoveItems(gomock.Any(), reception.ID, 1).DoAndReturn(func(ctx context.Context, _ uuid.UUID, _ int) error {
+		assert.True(t, inTx(ctx))
+		return nil
+	})
+	// Ошибка освобождения места откатывает и удаление товара
+	pvzRepo.EXPECT().Release(gomock.Any(), pvz.ID, 1).DoAndReturn(func(ctx context.Context, _ uuid.UUID, _ int) error {
+		assert.True(t, inTx(ctx))
+		return errors.ErrDBQuery
+	})
+
+	_, err := uc.Delete(newSystemContext(), pvz.ID, product.ID)
+	assert.ErrorIs(t, err, errors.ErrDBQuery)
+	assert.Empty(t, pvzEvents)
+}
+
+func TestProductUseCase_Delete_ClosedReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Close()
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+	_, err := uc.Delete(newSystemContext(), pvz.ID, product.ID)
+	assert.ErrorIs(t, err, errors.ErrReceptionAlreadyClosed)
+}
+
+func TestProductUseCase_Delete_OtherPVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(uuid.New())
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+	// Товар принят в другом ПВЗ
+	_, err := uc.Delete(newSystemContext(), pvz.ID, product.ID)
+	assert.ErrorIs(t, err, errors.ErrProductNotFound)
+}
+
+func TestProductUseCase_DeleteLastFromReception_PVZNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 
 	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
 
-	err := uc.DeleteLastFromReception(context.Background(), pvzID)
+	err := uc.DeleteLastFromReception(newSystemContext(), pvzID)
 	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
 }
 
@@ -196,11 +404,11 @@ func TestProductUseCase_DeleteLastFromReception_NoOpenReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 
@@ -208,6 +416,7 @@ func TestProductUseCase_DeleteLastFromReception_NoOpenReception(t *testing.T) {
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -215,7 +424,7 @@ func TestProductUseCase_DeleteLastFromReception_NoOpenReception(t *testing.T) {
 
 	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvzID).Return(nil, errors.ErrOpenReceptionNotFound)
 
-	err := uc.DeleteLastFromReception(context.Background(), pvzID)
+	err := uc.DeleteLastFromReception(newSystemContext(), pvzID)
 	assert.ErrorIs(t, err, errors.ErrOpenReceptionNotFound)
 }
 
@@ -223,11 +432,11 @@ func TestProductUseCase_DeleteLastFromReception_NoProductsToDelete(t *testing.T)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	pvzRepo := mock.NewMockPVZRepository(ctrl)
-	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
 
 	pvzID := uuid.New()
 	receptionID := uuid.New()
@@ -236,6 +445,7 @@ func TestProductUseCase_DeleteLastFromReception_NoProductsToDelete(t *testing.T)
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -253,6 +463,851 @@ func TestProductUseCase_DeleteLastFromReception_NoProductsToDelete(t *testing.T)
 
 	productRepo.EXPECT().GetLastByReceptionID(gomock.Any(), receptionID).Return(nil, errors.ErrProductNotFound)
 
-	err := uc.DeleteLastFromReception(context.Background(), pvzID)
+	err := uc.DeleteLastFromReception(newSystemContext(), pvzID)
 	assert.ErrorIs(t, err, errors.ErrNoProductsToDelete)
 }
+
+func TestProductUseCase_StartScanSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil)
+
+	session, err := uc.StartScanSession(newSystemContext(), pvz.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pvz, session.PVZ)
+	assert.Equal(t, reception, session.Reception)
+}
+
+func TestProductUseCase_StartScanSession_OpensReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+
+	// Открытой приемки нет, сессия открывает новую
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(nil, errors.ErrOpenReceptionNotFound)
+
+	receptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reception *models.Reception) error {
+		assert.Equal(t, pvz.ID, reception.PVZID)
+		assert.Equal(t, models.ReceptionStatusInProgress, reception.Status)
+		return nil
+	})
+
+	session, err := uc.StartScanSession(newSystemContext(), pvz.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pvz.ID, session.Reception.PVZID)
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventReceptionOpened, event.Type)
+	assert.Equal(t, session.Reception, event.Reception)
+}
+
+func TestProductUseCase_StartScanSession_PVZNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvzID := uuid.New()
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
+
+	_, err := uc.StartScanSession(newSystemContext(), pvzID)
+	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
+}
+
+func TestProductUseCase_AddToSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+
+	// Статус ПВЗ проверяется перед каждой командой, приемка повторно не запрашивается
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).Times(2)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		product, err := uc.AddToSession(newSystemContext(), session, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+		require.NoError(t, err)
+		assert.Equal(t, session.Reception.ID, product.ReceptionID)
+		assert.Equal(t, models.ProductTypeShoes, product.Type)
+	}
+
+	_, err := uc.AddToSession(newSystemContext(), session, domainUsecase.ProductInput{Type: models.ProductType("Invalid Type")})
+	assert.ErrorIs(t, err, errors.ErrInvalidProductType)
+}
+
+func TestProductUseCase_UndoInSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+	product := models.NewProduct(models.ProductTypeClothes, session.Reception.ID)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).Times(2)
+	productRepo.EXPECT().GetLastByReceptionID(gomock.Any(), session.Reception.ID).Return(product, nil)
+	productRepo.EXPECT().Delete(gomock.Any(), product.ID).Return(nil)
+
+	deleted, err := uc.UndoInSession(newSystemContext(), session)
+	require.NoError(t, err)
+	assert.Equal(t, product, deleted)
+
+	productRepo.EXPECT().GetLastByReceptionID(gomock.Any(), session.Reception.ID).Return(nil, errors.ErrProductNotFound)
+
+	_, err = uc.UndoInSession(newSystemContext(), session)
+	assert.ErrorIs(t, err, errors.ErrNoProductsToDelete)
+}
+
+func TestProductUseCase_AddToSession_StateChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	session := &domainUsecase.ScanSession{PVZ: pvz, Reception: models.NewReception(pvz.ID)}
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes}
+
+	// Приемку закрыли, пока сессия была открыта: товар не сохраняется
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().AddItems(gomock.Any(), session.Reception, 1).Return(errors.ErrReceptionAlreadyClosed)
+
+	_, err := uc.AddToSession(newSystemContext(), session, input)
+	assert.ErrorIs(t, err, errors.ErrReceptionAlreadyClosed)
+
+	// ПВЗ приостановили
+	suspended := *pvz
+	suspended.Status = models.PVZStatusSuspended
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(&suspended, nil)
+
+	_, err = uc.AddToSession(newSystemContext(), session, input)
+	assert.ErrorIs(t, err, errors.ErrPVZNotActive)
+
+	// Сотруднику больше не доступен ПВЗ сессии
+	employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole, Dummy: true, PVZScope: []uuid.UUID{uuid.New()}}
+
+	_, err = uc.UndoInSession(domainUsecase.WithCaller(context.Background(), employee), session)
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+}
+
+func TestProductUseCase_Create_WithBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes, OrderID: "ORD-42", Barcode: "4600000000001"}
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).Times(2)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).Times(2)
+
+	// Первое сканирование сохраняет товар с номером заказа и штрихкодом
+	productRepo.EXPECT().GetByReceptionIDAndBarcode(gomock.Any(), reception.ID, input.Barcode).Return(nil, errors.ErrProductNotFound)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	product, err := uc.Create(newSystemContext(), pvz.ID, input)
+	require.NoError(t, err)
+	assert.Equal(t, input.OrderID, product.OrderID)
+	assert.Equal(t, input.Barcode, product.Barcode)
+
+	// Повторное сканирование того же штрихкода отклоняется
+	productRepo.EXPECT().GetByReceptionIDAndBarcode(gomock.Any(), reception.ID, input.Barcode).Return(product, nil)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, input)
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+}
+
+func TestProductUseCase_Create_InvalidIdentifiers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewProductUseCase(newTestPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	_, err := uc.Create(newSystemContext(), uuid.New(), domainUsecase.ProductInput{Type: models.ProductTypeShoes, Barcode: "46 00"})
+	assert.ErrorIs(t, err, errors.ErrInvalidBarcode)
+
+	_, err = uc.Create(newSystemContext(), uuid.New(), domainUsecase.ProductInput{Type: models.ProductTypeShoes, OrderID: string(make([]byte, 65))})
+	assert.ErrorIs(t, err, errors.ErrInvalidOrderID)
+}
+
+func TestProductUseCase_Create_CustomerReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Kind = models.ReceptionKindCustomerReturn
+
+	original := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	original.SetStatus(models.ProductStatusIssued)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).Times(2)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).Times(2)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	// Возврат выданного товара ссылается на исходный товар
+	productRepo.EXPECT().GetByID(gomock.Any(), original.ID).Return(original, nil)
+
+	product, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{
+		Type:              models.ProductTypeShoes,
+		ReturnReason:      "не подошел размер",
+		OriginalProductID: &original.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &original.ID, product.OriginalProductID)
+	assert.Equal(t, "не подошел размер", product.ReturnReason)
+
+	// Без исходного товара достаточно номера заказа
+	product, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{
+		Type:         models.ProductTypeShoes,
+		OrderID:      "ORD-42",
+		ReturnReason: "брак",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, product.OriginalProductID)
+	assert.Equal(t, "ORD-42", product.OrderID)
+}
+
+func TestProductUseCase_Create_ReturnDetailsErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	inbound := models.NewReception(pvz.ID)
+	returns := models.NewReception(pvz.ID)
+	returns.Kind = models.ReceptionKindCustomerReturn
+
+	accepted := models.NewProduct(models.ProductTypeShoes, inbound.ID)
+	missingID := uuid.New()
+
+	productRepo.EXPECT().GetByID(gomock.Any(), accepted.ID).Return(accepted, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), missingID).Return(nil, errors.ErrProductNotFound)
+
+	tests := []struct {
+		name      string
+		reception *models.Reception
+		input     domainUsecase.ProductInput
+		wantErr   error
+	}{
+		{
+			name:      "Return details in inbound reception",
+			reception: inbound,
+			input:     domainUsecase.ProductInput{Type: models.ProductTypeShoes, ReturnReason: "брак"},
+			wantErr:   errors.ErrReturnDetailsNotAllowed,
+		},
+		{
+			name:      "Missing return reason",
+			reception: returns,
+			input:     domainUsecase.ProductInput{Type: models.ProductTypeShoes, OrderID: "ORD-42"},
+			wantErr:   errors.ErrInvalidReturnReason,
+		},
+		{
+			name:      "Missing reference",
+			reception: returns,
+			input:     domainUsecase.ProductInput{Type: models.ProductTypeShoes, ReturnReason: "брак"},
+			wantErr:   errors.ErrReturnReferenceRequired,
+		},
+		{
+			name:      "Original product not issued",
+			reception: returns,
+			input:     domainUsecase.ProductInput{Type: models.ProductTypeShoes, ReturnReason: "брак", OriginalProductID: &accepted.ID},
+			wantErr:   errors.ErrReturnedProductNotIssued,
+		},
+		{
+			name:      "Original product not found",
+			reception: returns,
+			input:     domainUsecase.ProductInput{Type: models.ProductTypeShoes, ReturnReason: "брак", OriginalProductID: &missingID},
+			wantErr:   errors.ErrReturnedProductNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+			receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(tt.reception, nil)
+
+			_, err := uc.Create(newSystemContext(), pvz.ID, tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProductUseCase_Create_StorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, cellRepo, newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	suggested := models.NewStorageCell(pvz.ID, "A", "1", "1", 10)
+	chosen := models.NewStorageCell(pvz.ID, "B", "3", "7", 1)
+	foreign := models.NewStorageCell(uuid.New(), "A", "1", "1", 10)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).AnyTimes()
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).AnyTimes()
+
+	// Без ячейки в запросе товар кладется в первую свободную
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(suggested, nil)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	product, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+	require.NoError(t, err)
+	assert.Equal(t, &suggested.ID, product.CellID)
+
+	// Выбранная сотрудником ячейка
+	cellRepo.EXPECT().GetByID(gomock.Any(), chosen.ID).Return(chosen, nil)
+	cellRepo.EXPECT().Occupy(gomock.Any(), chosen.ID).Return(nil)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	product, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes, CellID: &chosen.ID})
+	require.NoError(t, err)
+	assert.Equal(t, &chosen.ID, product.CellID)
+
+	// Заполненная ячейка отклоняется
+	cellRepo.EXPECT().GetByID(gomock.Any(), chosen.ID).Return(chosen, nil)
+	cellRepo.EXPECT().Occupy(gomock.Any(), chosen.ID).Return(errors.ErrStorageCellFull)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes, CellID: &chosen.ID})
+	assert.ErrorIs(t, err, errors.ErrStorageCellFull)
+
+	// Ячейка другого ПВЗ не находится
+	cellRepo.EXPECT().GetByID(gomock.Any(), foreign.ID).Return(foreign, nil)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes, CellID: &foreign.ID})
+	assert.ErrorIs(t, err, errors.ErrStorageCellNotFound)
+
+	// Все ячейки ПВЗ заполнены
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(nil, errors.ErrNoFreeStorageCell)
+	cellRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID).Return([]*models.StorageCell{suggested, chosen}, nil)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+	assert.ErrorIs(t, err, errors.ErrNoFreeStorageCell)
+
+	// Если товар не сохранился, место в ячейке возвращает откат транзакции
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(suggested, nil)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.ErrDBQuery)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+	assert.ErrorIs(t, err, errors.ErrDBQuery)
+}
+
+// nearlyFullMetrics запоминает города, по которым сработал сигнал о заполнении ПВЗ
+type nearlyFullMetrics struct {
+	*metrics.MockMetrics
+	cities []string
+}
+
+func (m *nearlyFullMetrics) IncPVZNearlyFull(city string) {
+	m.cities = append(m.cities, city)
+}
+
+func TestProductUseCase_Create_Capacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+
+	broker := events.NewBroker()
+	signals := &nearlyFullMetrics{MockMetrics: metrics.NewMockMetrics()}
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, cellRepo, newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, signals)
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	input := domainUsecase.ProductInput{Type: models.ProductTypeShoes}
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).AnyTimes()
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).AnyTimes()
+	cellRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID).Return(nil, nil).AnyTimes()
+
+	occupy := func(capacity, occupied int) func(context.Context, *models.PVZ, int) error {
+		return func(_ context.Context, pvz *models.PVZ, _ int) error {
+			pvz.Capacity, pvz.Occupied = capacity, occupied
+			return nil
+		}
+	}
+
+	// Товар доводит ПВЗ до порога: кроме события о товаре публикуется сигнал о заполнении,
+	// а счетчик для логистики увеличивается сразу, не дожидаясь подписчиков брокера
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 1).DoAndReturn(occupy(10, 9))
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(nil, errors.ErrNoFreeStorageCell)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, input)
+	require.NoError(t, err)
+
+	require.Len(t, pvzEvents, 2)
+	assert.Equal(t, models.PVZEventProductAdded, (<-pvzEvents).Type)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventNearlyFull, event.Type)
+	assert.Equal(t, 9, event.PVZ.Occupied)
+	assert.Equal(t, []string{string(models.CityMoscow)}, signals.cities)
+
+	// Следующий товар сигнал не повторяет
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 1).DoAndReturn(occupy(10, 10))
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(nil, errors.ErrNoFreeStorageCell)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, input)
+	require.NoError(t, err)
+
+	require.Len(t, pvzEvents, 1)
+	assert.Equal(t, models.PVZEventProductAdded, (<-pvzEvents).Type)
+	assert.Len(t, signals.cities, 1)
+
+	// ПВЗ заполнен
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 1).Return(errors.ErrPVZFull)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, input)
+	assert.ErrorIs(t, err, errors.ErrPVZFull)
+
+	// Если товар не удалось положить в ячейку, место в ПВЗ возвращает откат транзакции
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 1).Return(nil)
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(nil, errors.ErrDBQuery)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, input)
+	assert.ErrorIs(t, err, errors.ErrDBQuery)
+}
+
+func TestProductUseCase_Create_ReceptionQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.MaxItems = 2
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).AnyTimes()
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).AnyTimes()
+
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 1).Return(nil)
+	productRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+	require.NoError(t, err)
+
+	// Приемка заполнена: товар не сохраняется
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 1).Return(errors.ErrReceptionQuotaExceeded)
+
+	_, err = uc.Create(newSystemContext(), pvz.ID, domainUsecase.ProductInput{Type: models.ProductTypeShoes})
+	assert.ErrorIs(t, err, errors.ErrReceptionQuotaExceeded)
+}
+
+func TestProductUseCase_CreateBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	inputs := []domainUsecase.ProductInput{
+		{Type: models.ProductTypeShoes, Barcode: "4600000000001"},
+		{Type: models.ProductTypeClothes, OrderID: "ORD-1"},
+		{Type: models.ProductTypeShoes, Barcode: "4600000000002"},
+	}
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil)
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 3).Return(nil)
+	productRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, products []*models.Product) error {
+		require.Len(t, products, 3)
+		for i := 1; i < len(products); i++ {
+			assert.True(t, products[i].DateTime.After(products[i-1].DateTime))
+		}
+		return nil
+	})
+
+	products, err := uc.CreateBatch(newSystemContext(), pvz.ID, inputs)
+	require.NoError(t, err)
+	require.Len(t, products, 3)
+	for i, product := range products {
+		assert.Equal(t, inputs[i].Type, product.Type)
+		assert.Equal(t, inputs[i].Barcode, product.Barcode)
+		assert.Equal(t, inputs[i].OrderID, product.OrderID)
+		assert.Equal(t, reception.ID, product.ReceptionID)
+		assert.Nil(t, product.CellID)
+	}
+
+	require.Len(t, pvzEvents, 3)
+	for _, product := range products {
+		event := <-pvzEvents
+		assert.Equal(t, models.PVZEventProductAdded, event.Type)
+		assert.Equal(t, product.ID, event.Product.ID)
+	}
+}
+
+func TestProductUseCase_CreateBatch_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, cellRepo, newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.MaxItems = 3
+	shoes := domainUsecase.ProductInput{Type: models.ProductTypeShoes}
+
+	// Ошибки в данных отклоняют пачку целиком до обращения к хранилищу
+	_, err := uc.CreateBatch(newSystemContext(), pvz.ID, nil)
+	assert.ErrorIs(t, err, errors.ErrInvalidBatchSize)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, make([]domainUsecase.ProductInput, models.MaxProductBatchSize+1))
+	assert.ErrorIs(t, err, errors.ErrInvalidBatchSize)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{shoes, {Type: "furniture"}})
+	assert.ErrorIs(t, err, errors.ErrInvalidProductType)
+	assert.Contains(t, err.Error(), "products[1]")
+	assert.Equal(t, map[string]string{"index": "1"}, errors.Details(err))
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{
+		{Type: models.ProductTypeShoes, Barcode: "4600000000001"},
+		{Type: models.ProductTypeClothes, Barcode: "4600000000001"},
+	})
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).AnyTimes()
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(reception, nil).AnyTimes()
+
+	// Пачка не помещается в квоту приемки
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 2).Return(errors.ErrReceptionQuotaExceeded)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{shoes, shoes})
+	assert.ErrorIs(t, err, errors.ErrReceptionQuotaExceeded)
+
+	// Пачка не помещается в ПВЗ
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 2).Return(nil)
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 2).Return(errors.ErrPVZFull)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{shoes, shoes})
+	assert.ErrorIs(t, err, errors.ErrPVZFull)
+
+	// Второму товару не хватило ячейки: место первого и место в ПВЗ возвращает откат транзакции
+	cell := models.NewStorageCell(pvz.ID, "A", "1", "1", 1)
+
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 2).Return(nil)
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 2).Return(nil)
+	cellRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID).Return([]*models.StorageCell{cell}, nil).Times(2)
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(cell, nil)
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), pvz.ID).Return(nil, errors.ErrNoFreeStorageCell)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{shoes, shoes})
+	assert.ErrorIs(t, err, errors.ErrNoFreeStorageCell)
+	assert.Contains(t, err.Error(), "products[1]")
+
+	// Штрихкод уже принят в приемку: транзакция откатывается вместе с занятым местом
+	receptionRepo.EXPECT().AddItems(gomock.Any(), reception, 1).Return(nil)
+	pvzRepo.EXPECT().Occupy(gomock.Any(), pvz, 1).Return(nil)
+	cellRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID).Return(nil, nil)
+	productRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(errors.ErrDuplicateBarcode)
+
+	_, err = uc.CreateBatch(newSystemContext(), pvz.ID, []domainUsecase.ProductInput{{Type: models.ProductTypeShoes, Barcode: "4600000000001"}})
+	assert.ErrorIs(t, err, errors.ErrDuplicateBarcode)
+}
+
+func TestProductUseCase_ReleasesStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, cellRepo, newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	cell := models.NewStorageCell(pvz.ID, "A", "1", "1", 10)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).AnyTimes()
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil).AnyTimes()
+
+	// Удаленный из приемки товар освобождает ячейку
+	deleted := models.NewProduct(models.ProductTypeShoes, reception.ID)
+	deleted.CellID = &cell.ID
+	productRepo.EXPECT().GetByID(gomock.Any(), deleted.ID).Return(deleted, nil)
+	productRepo.EXPECT().Delete(gomock.Any(), deleted.ID).Return(nil)
+	cellRepo.EXPECT().Release(gomock.Any(), cell.ID).Return(nil)
+
+	_, err := uc.Delete(newSystemContext(), pvz.ID, deleted.ID)
+	require.NoError(t, err)
+
+	// Раскладка для выдачи оставляет товар в ячейке, выдача освобождает ее
+	issued := models.NewProduct(models.ProductTypeShoes, reception.ID)
+	issued.CellID = &cell.ID
+	productRepo.EXPECT().GetByID(gomock.Any(), issued.ID).Return(issued, nil).Times(2)
+	productRepo.EXPECT().UpdateStatus(gomock.Any(), issued, gomock.Any()).Return(nil).Times(2)
+
+	_, err = uc.ChangeStatus(newSystemContext(), pvz.ID, issued.ID, models.ProductStatusReadyForPickup)
+	require.NoError(t, err)
+
+	cellRepo.EXPECT().Release(gomock.Any(), cell.ID).Return(nil)
+
+	_, err = uc.ChangeStatus(newSystemContext(), pvz.ID, issued.ID, models.ProductStatusIssued)
+	require.NoError(t, err)
+}
+
+func TestProductUseCase_FindByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityKazan)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeClothes, reception.ID)
+	product.Barcode = "4600000000001"
+
+	t.Run("Success", func(t *testing.T) {
+		productRepo.EXPECT().GetLastByBarcode(gomock.Any(), product.Barcode).Return(product, nil)
+		receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+		pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+
+		location, err := uc.FindByBarcode(newSystemContext(), product.Barcode)
+		require.NoError(t, err)
+		assert.Equal(t, product, location.Product)
+		assert.Equal(t, reception, location.Reception)
+		assert.Equal(t, pvz, location.PVZ)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		productRepo.EXPECT().GetLastByBarcode(gomock.Any(), "unknown").Return(nil, errors.ErrProductNotFound)
+
+		_, err := uc.FindByBarcode(newSystemContext(), "unknown")
+		assert.ErrorIs(t, err, errors.ErrProductNotFound)
+	})
+
+	t.Run("Empty Barcode", func(t *testing.T) {
+		_, err := uc.FindByBarcode(newSystemContext(), "")
+		assert.ErrorIs(t, err, errors.ErrInvalidBarcode)
+	})
+
+	t.Run("Other PVZ", func(t *testing.T) {
+		// Товар ПВЗ, за которым сотрудник не закреплен, для него не находится
+		employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole, Dummy: true, PVZScope: []uuid.UUID{uuid.New()}}
+		productRepo.EXPECT().GetLastByBarcode(gomock.Any(), product.Barcode).Return(product, nil)
+		receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+		_, err := uc.FindByBarcode(domainUsecase.WithCaller(context.Background(), employee), product.Barcode)
+		assert.ErrorIs(t, err, errors.ErrProductNotFound)
+	})
+}
+
+func TestProductUseCase_Delete_NotAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+	product.SetStatus(models.ProductStatusReadyForPickup)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+	// Разложенный для выдачи товар уже не удаляется из приемки
+	_, err := uc.Delete(newSystemContext(), pvz.ID, product.ID)
+	assert.ErrorIs(t, err, errors.ErrProductNotAccepted)
+}
+
+func TestProductUseCase_ChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), broker, metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Close()
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+	product.SetStatus(models.ProductStatusReadyForPickup)
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{PVZID: pvz.ID})
+	defer unsubscribe()
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	productRepo.EXPECT().UpdateStatus(gomock.Any(), product, models.ProductStatusReadyForPickup).Return(nil)
+
+	issued, err := uc.ChangeStatus(newSystemContext(), pvz.ID, product.ID, models.ProductStatusIssued)
+	require.NoError(t, err)
+	assert.Equal(t, models.ProductStatusIssued, issued.Status)
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventProductStatusChanged, event.Type)
+	assert.Equal(t, product, event.Product)
+}
+
+func TestProductUseCase_ChangeStatus_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+
+	_, err := uc.ChangeStatus(newSystemContext(), pvz.ID, uuid.New(), "lost")
+	assert.ErrorIs(t, err, errors.ErrInvalidProductStatus)
+
+	// Принятый товар нельзя выдать, пока его не разложили
+	product := models.NewProduct(models.ProductTypeElectronics, reception.ID)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+	_, err = uc.ChangeStatus(newSystemContext(), pvz.ID, product.ID, models.ProductStatusIssued)
+	assert.ErrorIs(t, err, errors.ErrProductStatusTransition)
+
+	// Товар успели выдать параллельно
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().GetByID(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	productRepo.EXPECT().UpdateStatus(gomock.Any(), product, models.ProductStatusAccepted).Return(errors.ErrProductStatusTransition)
+
+	_, err = uc.ChangeStatus(newSystemContext(), pvz.ID, product.ID, models.ProductStatusReadyForPickup)
+	assert.ErrorIs(t, err, errors.ErrProductStatusTransition)
+}
+
+func TestProductUseCase_ListShelf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := newTestPVZRepository(ctrl)
+	receptionRepo := newTestReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewProductUseCase(pvzRepo, receptionRepo, productRepo, newTestCells(ctrl), newTestTransactor(ctrl), newTestCatalog(ctrl), newTestAssignments(ctrl), events.NewBroker(), metrics.NewMockMetrics())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	products := []*models.Product{models.NewProduct(models.ProductTypeShoes, uuid.New())}
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	productRepo.EXPECT().ListShelf(gomock.Any(), pvz.ID, models.ShelfProductStatuses, 1, 10).Return(products, nil)
+
+	result, err := uc.ListShelf(newSystemContext(), pvz.ID, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, products, result)
+
+	// Выданные товары в ПВЗ уже не лежат
+	_, err = uc.ListShelf(newSystemContext(), pvz.ID, []models.ProductStatus{models.ProductStatusIssued}, 1, 10)
+	assert.ErrorIs(t, err, errors.ErrInvalidProductStatus)
+}
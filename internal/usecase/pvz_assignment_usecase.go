@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+type PVZAssignmentUseCase struct {
+	assignmentRepo repository.PVZAssignmentRepository
+	pvzRepo        repository.PVZRepository
+	userRepo       repository.UserRepository
+}
+
+func NewPVZAssignmentUseCase(
+	assignmentRepo repository.PVZAssignmentRepository,
+	pvzRepo repository.PVZRepository,
+	userRepo repository.UserRepository,
+) usecase.PVZAssignmentUseCase {
+	return &PVZAssignmentUseCase{
+		assignmentRepo: assignmentRepo,
+		pvzRepo:        pvzRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (uc *PVZAssignmentUseCase) Assign(ctx context.Context, pvzID, userID uuid.UUID) (*models.PVZAssignment, error) {
+	if _, err := uc.pvzRepo.GetByID(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Модераторы работают со всеми ПВЗ, закреплять их не нужно
+	if user.Role != models.EmployeeRole {
+		return nil, errors.ErrAssigneeNotEmployee
+	}
+
+	assignment := models.NewPVZAssignment(user.ID, pvzID)
+	if err := uc.assignmentRepo.Create(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (uc *PVZAssignmentUseCase) Unassign(ctx context.Context, pvzID, userID uuid.UUID) error {
+	return uc.assignmentRepo.Delete(ctx, userID, pvzID)
+}
+
+func (uc *PVZAssignmentUseCase) List(ctx context.Context, pvzID uuid.UUID) ([]*models.PVZAssignment, error) {
+	if _, err := uc.pvzRepo.GetByID(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	return uc.assignmentRepo.ListByPVZID(ctx, pvzID)
+}
+
+func (uc *PVZAssignmentUseCase) CheckAccess(ctx context.Context, pvzID uuid.UUID) error {
+	caller, ok := usecase.CallerFromContext(ctx)
+	if !ok {
+		if usecase.IsSystemCaller(ctx) {
+			return nil
+		}
+		return errors.ErrPVZAccessDenied
+	}
+	if caller.Role != models.EmployeeRole {
+		return nil
+	}
+
+	// Тестового пользователя нет в БД, его ПВЗ перечислены в токене
+	if caller.Dummy {
+		if !caller.InPVZScope(pvzID) {
+			return errors.ErrPVZAccessDenied
+		}
+		return nil
+	}
+
+	assigned, err := uc.assignmentRepo.Exists(ctx, caller.ID, pvzID)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return errors.ErrPVZAccessDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
+)
+
+// newTestAssignments возвращает проверку доступа к ПВЗ для вызовов без пользователя в контексте:
+// такие вызовы не ограничиваются и в хранилище назначений не обращаются
+func newTestAssignments(ctrl *gomock.Controller) domainUsecase.PVZAssignmentUseCase {
+	return NewPVZAssignmentUseCase(mock.NewMockPVZAssignmentRepository(ctrl), mock.NewMockPVZRepository(ctrl), mock.NewMockUserRepository(ctrl))
+}
+
+// newSystemContext возвращает контекст внутреннего вызова, который назначения не ограничивают
+func newSystemContext() context.Context {
+	return domainUsecase.WithSystemCaller(context.Background())
+}
+
+func TestPVZAssignmentUseCase_Assign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assignmentRepo := mock.NewMockPVZAssignmentRepository(ctrl)
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+
+	uc := NewPVZAssignmentUseCase(assignmentRepo, pvzRepo, userRepo)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	employee := models.NewUser("employee@example.com", "hash", models.EmployeeRole)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	userRepo.EXPECT().GetByID(gomock.Any(), employee.ID).Return(employee, nil)
+	assignmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, assignment *models.PVZAssignment) error {
+		assert.Equal(t, employee.ID, assignment.UserID)
+		assert.Equal(t, pvz.ID, assignment.PVZID)
+		return nil
+	})
+
+	assignment, err := uc.Assign(context.Background(), pvz.ID, employee.ID)
+	require.NoError(t, err)
+	assert.Equal(t, employee.ID, assignment.UserID)
+}
+
+func TestPVZAssignmentUseCase_Assign_NotEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+
+	uc := NewPVZAssignmentUseCase(mock.NewMockPVZAssignmentRepository(ctrl), pvzRepo, userRepo)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	moderator := models.NewUser("moderator@example.com", "hash", models.ModeratorRole)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	userRepo.EXPECT().GetByID(gomock.Any(), moderator.ID).Return(moderator, nil)
+
+	_, err := uc.Assign(context.Background(), pvz.ID, moderator.ID)
+	assert.ErrorIs(t, err, errors.ErrAssigneeNotEmployee)
+}
+
+func TestPVZAssignmentUseCase_CheckAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assignmentRepo := mock.NewMockPVZAssignmentRepository(ctrl)
+	uc := NewPVZAssignmentUseCase(assignmentRepo, mock.NewMockPVZRepository(ctrl), mock.NewMockUserRepository(ctrl))
+
+	pvzID := uuid.New()
+	otherPVZID := uuid.New()
+	employee := models.NewUser("employee@example.com", "hash", models.EmployeeRole)
+	moderator := models.NewUser("moderator@example.com", "hash", models.ModeratorRole)
+
+	assignmentRepo.EXPECT().Exists(gomock.Any(), employee.ID, pvzID).Return(true, nil)
+	assignmentRepo.EXPECT().Exists(gomock.Any(), employee.ID, otherPVZID).Return(false, nil)
+
+	employeeCtx := domainUsecase.WithCaller(context.Background(), employee)
+	assert.NoError(t, uc.CheckAccess(employeeCtx, pvzID))
+	assert.ErrorIs(t, uc.CheckAccess(employeeCtx, otherPVZID), errors.ErrPVZAccessDenied)
+
+	// Модераторы и внутренние вызовы не ограничены назначениями
+	assert.NoError(t, uc.CheckAccess(domainUsecase.WithCaller(context.Background(), moderator), otherPVZID))
+	assert.NoError(t, uc.CheckAccess(newSystemContext(), otherPVZID))
+
+	// Вызов без пользователя и без метки внутреннего вызова отклоняется
+	assert.ErrorIs(t, uc.CheckAccess(context.Background(), otherPVZID), errors.ErrPVZAccessDenied)
+}
+
+func TestPVZAssignmentUseCase_CheckAccess_Dummy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewPVZAssignmentUseCase(mock.NewMockPVZAssignmentRepository(ctrl), mock.NewMockPVZRepository(ctrl), mock.NewMockUserRepository(ctrl))
+
+	pvzID := uuid.New()
+	otherPVZID := uuid.New()
+
+	scoped := &models.User{ID: uuid.New(), Role: models.EmployeeRole, Dummy: true, PVZScope: []uuid.UUID{pvzID}}
+	scopedCtx := domainUsecase.WithCaller(context.Background(), scoped)
+	assert.NoError(t, uc.CheckAccess(scopedCtx, pvzID))
+	assert.ErrorIs(t, uc.CheckAccess(scopedCtx, otherPVZID), errors.ErrPVZAccessDenied)
+
+	// Тестовый токен без списка ПВЗ не дает доступа ни к одному ПВЗ
+	unscoped := &models.User{ID: uuid.New(), Role: models.EmployeeRole, Dummy: true}
+	unscopedCtx := domainUsecase.WithCaller(context.Background(), unscoped)
+	assert.ErrorIs(t, uc.CheckAccess(unscopedCtx, pvzID), errors.ErrPVZAccessDenied)
+	assert.ErrorIs(t, uc.CheckAccess(unscopedCtx, otherPVZID), errors.ErrPVZAccessDenied)
+}
@@ -15,26 +15,34 @@ type PVZUseCase struct {
 	pvzRepo       repository.PVZRepository
 	receptionRepo repository.ReceptionRepository
 	productRepo   repository.ProductRepository
+	catalog       usecase.CatalogUseCase
 }
 
 func NewPVZUseCase(
 	pvzRepo repository.PVZRepository,
 	receptionRepo repository.ReceptionRepository,
 	productRepo repository.ProductRepository,
+	catalog usecase.CatalogUseCase,
 ) usecase.PVZUseCase {
 	return &PVZUseCase{
 		pvzRepo:       pvzRepo,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
+		catalog:       catalog,
 	}
 }
 
-func (uc *PVZUseCase) Create(ctx context.Context, city models.City) (*models.PVZ, error) {
-	if !models.IsValidCity(city) {
-		return nil, errors.ErrInvalidCity
+func (uc *PVZUseCase) Create(ctx context.Context, input usecase.PVZInput) (*models.PVZ, error) {
+	if err := uc.validatePVZInput(ctx, input); err != nil {
+		return nil, err
 	}
 
-	pvz := models.NewPVZ(city)
+	pvz := models.NewPVZ(input.City)
+	pvz.Address = input.Address
+	pvz.Coordinates = input.Coordinates
+	pvz.WorkingHours = input.WorkingHours
+	pvz.Phone = input.Phone
+	pvz.Capacity = input.Capacity
 
 	if err := uc.pvzRepo.Create(ctx, pvz); err != nil {
 		return nil, err
@@ -47,15 +55,19 @@ func (uc *PVZUseCase) GetByID(ctx context.Context, id uuid.UUID) (*models.PVZ, e
 	return uc.pvzRepo.GetByID(ctx, id)
 }
 
-func (uc *PVZUseCase) List(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*usecase.PVZWithReceptions, error) {
-	pvzs, err := uc.pvzRepo.List(ctx, startDate, endDate, page, limit)
+func (uc *PVZUseCase) List(ctx context.Context, startDate, endDate *time.Time, page, limit int, includeArchived bool, kind models.ReceptionKind) ([]*usecase.PVZWithReceptions, error) {
+	if kind != "" && !models.IsValidReceptionKind(kind) {
+		return nil, errors.ErrInvalidReceptionKind
+	}
+
+	pvzs, err := uc.pvzRepo.List(ctx, startDate, endDate, page, limit, includeArchived)
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]*usecase.PVZWithReceptions, 0, len(pvzs))
 	for _, pvz := range pvzs {
-		pvzWithReceptions, err := uc.getPVZWithReceptions(ctx, pvz)
+		pvzWithReceptions, err := uc.getPVZWithReceptions(ctx, pvz, kind)
 		if err != nil {
 			return nil, err
 		}
@@ -65,12 +77,96 @@ func (uc *PVZUseCase) List(ctx context.Context, startDate, endDate *time.Time, p
 	return result, nil
 }
 
-func (uc *PVZUseCase) GetAll(ctx context.Context) ([]*models.PVZ, error) {
-	return uc.pvzRepo.GetAll(ctx)
+func (uc *PVZUseCase) GetAll(ctx context.Context, includeArchived bool) ([]*models.PVZ, error) {
+	return uc.pvzRepo.GetAll(ctx, includeArchived)
+}
+
+// maxNearestRadius ограничивает радиус поиска ближайших ПВЗ, метры
+const maxNearestRadius = 100000
+
+func (uc *PVZUseCase) ListNearest(ctx context.Context, point models.Coordinates, radius float64, limit int) ([]*models.NearbyPVZ, error) {
+	if !point.IsValid() {
+		return nil, errors.ErrInvalidCoordinates
+	}
+	if radius <= 0 || radius > maxNearestRadius {
+		return nil, errors.ErrInvalidSearchRadius
+	}
+
+	return uc.pvzRepo.ListNearest(ctx, point, radius, limit)
+}
+
+func (uc *PVZUseCase) ChangeStatus(ctx context.Context, id uuid.UUID, status models.PVZStatus, reason string) (*models.PVZ, error) {
+	if !models.IsValidPVZStatus(status) {
+		return nil, errors.ErrInvalidPVZStatus
+	}
+	if !models.IsValidPVZText(reason) || (status != models.PVZStatusActive && reason == "") {
+		return nil, errors.ErrInvalidStatusReason
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pvz.CanTransitionTo(status) {
+		return nil, errors.ErrPVZStatusTransition
+	}
+
+	from := pvz.Status
+	pvz.SetStatus(status, reason)
+	if err := uc.pvzRepo.UpdateStatus(ctx, pvz, from); err != nil {
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+func (uc *PVZUseCase) SetCapacity(ctx context.Context, id uuid.UUID, capacity int) (*models.PVZ, error) {
+	if !models.IsValidPVZCapacity(capacity) {
+		return nil, errors.ErrInvalidPVZCapacity
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	pvz.Capacity = capacity
+	if err := uc.pvzRepo.UpdateCapacity(ctx, pvz); err != nil {
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+func (uc *PVZUseCase) validatePVZInput(ctx context.Context, input usecase.PVZInput) error {
+	active, err := uc.catalog.IsActive(ctx, models.CatalogCities, string(input.City))
+	if err != nil {
+		return err
+	}
+	if !active {
+		return errors.ErrInvalidCity
+	}
+	if !models.IsValidPVZText(input.Address) {
+		return errors.ErrInvalidAddress
+	}
+	if input.Coordinates != nil && !input.Coordinates.IsValid() {
+		return errors.ErrInvalidCoordinates
+	}
+	if !models.IsValidPVZText(input.WorkingHours) {
+		return errors.ErrInvalidWorkingHours
+	}
+	if !models.IsValidPhone(input.Phone) {
+		return errors.ErrInvalidPhone
+	}
+	if !models.IsValidPVZCapacity(input.Capacity) {
+		return errors.ErrInvalidPVZCapacity
+	}
+	return nil
 }
 
-func (uc *PVZUseCase) getPVZWithReceptions(ctx context.Context, pvz *models.PVZ) (*usecase.PVZWithReceptions, error) {
-	receptions, err := uc.receptionRepo.ListByPVZID(ctx, pvz.ID)
+func (uc *PVZUseCase) getPVZWithReceptions(ctx context.Context, pvz *models.PVZ, kind models.ReceptionKind) (*usecase.PVZWithReceptions, error) {
+	receptions, err := uc.receptionRepo.ListByPVZID(ctx, pvz.ID, kind)
 	if err != nil {
 		return nil, err
 	}
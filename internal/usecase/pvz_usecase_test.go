@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
 	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
 )
@@ -23,7 +24,7 @@ func TestPVZUseCase_Create(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	city := models.CityMoscow
 
@@ -32,7 +33,7 @@ func TestPVZUseCase_Create(t *testing.T) {
 		return nil
 	})
 
-	pvz, err := uc.Create(context.Background(), city)
+	pvz, err := uc.Create(context.Background(), domainUsecase.PVZInput{City: city})
 	require.NoError(t, err)
 	assert.Equal(t, city, pvz.City)
 }
@@ -45,11 +46,30 @@ func TestPVZUseCase_Create_InvalidCity(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	invalidCity := models.City("Invalid City")
 
-	_, err := uc.Create(context.Background(), invalidCity)
+	_, err := uc.Create(context.Background(), domainUsecase.PVZInput{City: invalidCity})
+	assert.ErrorIs(t, err, errors.ErrInvalidCity)
+}
+
+func TestPVZUseCase_Create_RetiredCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
+
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, NewCatalogUseCase(catalogRepo))
+
+	retired := models.NewCatalogEntry(string(models.CityKazan))
+	retired.Active = false
+	catalogRepo.EXPECT().List(gomock.Any(), models.CatalogCities).Return([]*models.CatalogEntry{retired}, nil)
+
+	_, err := uc.Create(context.Background(), domainUsecase.PVZInput{City: models.CityKazan})
 	assert.ErrorIs(t, err, errors.ErrInvalidCity)
 }
 
@@ -61,13 +81,14 @@ func TestPVZUseCase_GetByID(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	pvzID := uuid.New()
 	expectedPVZ := &models.PVZ{
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -86,7 +107,7 @@ func TestPVZUseCase_GetByID_NotFound(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	pvzID := uuid.New()
 
@@ -104,7 +125,7 @@ func TestPVZUseCase_List(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	startDate := time.Now().Add(-24 * time.Hour)
 	endDate := time.Now()
@@ -115,6 +136,7 @@ func TestPVZUseCase_List(t *testing.T) {
 		ID:               uuid.New(),
 		RegistrationDate: time.Now().Add(-12 * time.Hour),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now().Add(-12 * time.Hour),
 	}
 
@@ -122,12 +144,13 @@ func TestPVZUseCase_List(t *testing.T) {
 		ID:               uuid.New(),
 		RegistrationDate: time.Now().Add(-6 * time.Hour),
 		City:             models.CitySaintPetersburg,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now().Add(-6 * time.Hour),
 	}
 
 	pvzs := []*models.PVZ{pvz1, pvz2}
 
-	pvzRepo.EXPECT().List(gomock.Any(), &startDate, &endDate, page, limit).Return(pvzs, nil)
+	pvzRepo.EXPECT().List(gomock.Any(), &startDate, &endDate, page, limit, false).Return(pvzs, nil)
 
 	for _, pvz := range pvzs {
 		reception1 := &models.Reception{
@@ -148,7 +171,7 @@ func TestPVZUseCase_List(t *testing.T) {
 
 		receptions := []*models.Reception{reception1, reception2}
 
-		receptionRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID).Return(receptions, nil)
+		receptionRepo.EXPECT().ListByPVZID(gomock.Any(), pvz.ID, models.ReceptionKind("")).Return(receptions, nil)
 
 		for _, reception := range receptions {
 			product1 := &models.Product{
@@ -173,7 +196,7 @@ func TestPVZUseCase_List(t *testing.T) {
 		}
 	}
 
-	result, err := uc.List(context.Background(), &startDate, &endDate, page, limit)
+	result, err := uc.List(context.Background(), &startDate, &endDate, page, limit, false, "")
 	require.NoError(t, err)
 	assert.Len(t, result, 2)
 
@@ -188,6 +211,16 @@ func TestPVZUseCase_List(t *testing.T) {
 	}
 }
 
+func TestPVZUseCase_List_InvalidReceptionKind(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewPVZUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	_, err := uc.List(context.Background(), nil, nil, 1, 10, false, "transfer")
+	assert.ErrorIs(t, err, errors.ErrInvalidReceptionKind)
+}
+
 func TestPVZUseCase_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -196,12 +229,13 @@ func TestPVZUseCase_GetAll(t *testing.T) {
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
 
-	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo)
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
 
 	pvz1 := &models.PVZ{
 		ID:               uuid.New(),
 		RegistrationDate: time.Now().Add(-12 * time.Hour),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now().Add(-12 * time.Hour),
 	}
 
@@ -209,14 +243,221 @@ func TestPVZUseCase_GetAll(t *testing.T) {
 		ID:               uuid.New(),
 		RegistrationDate: time.Now().Add(-6 * time.Hour),
 		City:             models.CitySaintPetersburg,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now().Add(-6 * time.Hour),
 	}
 
 	pvzs := []*models.PVZ{pvz1, pvz2}
 
-	pvzRepo.EXPECT().GetAll(gomock.Any()).Return(pvzs, nil)
+	pvzRepo.EXPECT().GetAll(gomock.Any(), true).Return(pvzs, nil)
 
-	result, err := uc.GetAll(context.Background())
+	result, err := uc.GetAll(context.Background(), true)
 	require.NoError(t, err)
 	assert.Equal(t, pvzs, result)
 }
+
+func TestPVZUseCase_Create_WithDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
+
+	input := domainUsecase.PVZInput{
+		City:         models.CityMoscow,
+		Address:      "ул. Тверская, 1",
+		Coordinates:  &models.Coordinates{Latitude: 55.7576, Longitude: 37.6137},
+		WorkingHours: "ежедневно 09:00-21:00",
+		Phone:        "+74951234567",
+	}
+
+	pvzRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	pvz, err := uc.Create(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, input.Address, pvz.Address)
+	assert.Equal(t, input.Coordinates, pvz.Coordinates)
+	assert.Equal(t, input.WorkingHours, pvz.WorkingHours)
+	assert.Equal(t, input.Phone, pvz.Phone)
+}
+
+func TestPVZUseCase_Create_InvalidDetails(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    domainUsecase.PVZInput
+		expected error
+	}{
+		{
+			name:     "Address With Line Break",
+			input:    domainUsecase.PVZInput{City: models.CityMoscow, Address: "ул. Тверская,\n1"},
+			expected: errors.ErrInvalidAddress,
+		},
+		{
+			name:     "Latitude Out Of Range",
+			input:    domainUsecase.PVZInput{City: models.CityMoscow, Coordinates: &models.Coordinates{Latitude: 95, Longitude: 37.6}},
+			expected: errors.ErrInvalidCoordinates,
+		},
+		{
+			name:     "Phone With Letters",
+			input:    domainUsecase.PVZInput{City: models.CityMoscow, Phone: "+7495CALLME"},
+			expected: errors.ErrInvalidPhone,
+		},
+		{
+			name:     "Short Phone",
+			input:    domainUsecase.PVZInput{City: models.CityMoscow, Phone: "12345"},
+			expected: errors.ErrInvalidPhone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewPVZUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+			_, err := uc.Create(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestPVZUseCase_ListNearest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewPVZUseCase(pvzRepo, receptionRepo, productRepo, newTestCatalog(ctrl))
+
+	point := models.Coordinates{Latitude: 55.75, Longitude: 37.61}
+	expected := []*models.NearbyPVZ{{PVZ: models.NewPVZ(models.CityMoscow), Distance: 872.5}}
+	pvzRepo.EXPECT().ListNearest(gomock.Any(), point, 5000.0, 10).Return(expected, nil)
+
+	result, err := uc.ListNearest(context.Background(), point, 5000, 10)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestPVZUseCase_ListNearest_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewPVZUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	_, err := uc.ListNearest(context.Background(), models.Coordinates{Latitude: 55.75, Longitude: 190}, 5000, 10)
+	assert.ErrorIs(t, err, errors.ErrInvalidCoordinates)
+
+	_, err = uc.ListNearest(context.Background(), models.Coordinates{Latitude: 55.75, Longitude: 37.61}, maxNearestRadius+1, 10)
+	assert.ErrorIs(t, err, errors.ErrInvalidSearchRadius)
+}
+
+func TestPVZUseCase_ChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	uc := NewPVZUseCase(pvzRepo, mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	pvzRepo.EXPECT().UpdateStatus(gomock.Any(), pvz, models.PVZStatusActive).Return(nil)
+
+	result, err := uc.ChangeStatus(context.Background(), pvz.ID, models.PVZStatusSuspended, "ремонт")
+	require.NoError(t, err)
+	assert.Equal(t, models.PVZStatusSuspended, result.Status)
+	assert.Equal(t, "ремонт", result.StatusReason)
+	assert.False(t, result.IsActive())
+}
+
+func TestPVZUseCase_SetCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	uc := NewPVZUseCase(pvzRepo, mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	_, err := uc.SetCapacity(context.Background(), pvz.ID, -1)
+	assert.ErrorIs(t, err, errors.ErrInvalidPVZCapacity)
+
+	_, err = uc.SetCapacity(context.Background(), pvz.ID, models.MaxPVZCapacity+1)
+	assert.ErrorIs(t, err, errors.ErrInvalidPVZCapacity)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	pvzRepo.EXPECT().UpdateCapacity(gomock.Any(), pvz).DoAndReturn(func(_ context.Context, pvz *models.PVZ) error {
+		pvz.Occupied = 280
+		return nil
+	})
+
+	result, err := uc.SetCapacity(context.Background(), pvz.ID, 300)
+	require.NoError(t, err)
+	assert.Equal(t, 300, result.Capacity)
+	assert.True(t, result.IsNearlyFull())
+	assert.False(t, result.IsFull())
+}
+
+func TestPVZUseCase_ChangeStatus_Reopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	uc := NewPVZUseCase(pvzRepo, mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	pvzRepo.EXPECT().UpdateStatus(gomock.Any(), pvz, models.PVZStatusSuspended).Return(nil)
+
+	// Для возобновления работы причина не нужна
+	result, err := uc.ChangeStatus(context.Background(), pvz.ID, models.PVZStatusActive, "")
+	require.NoError(t, err)
+	assert.True(t, result.IsActive())
+	assert.Empty(t, result.StatusReason)
+}
+
+func TestPVZUseCase_ChangeStatus_NotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	uc := NewPVZUseCase(pvzRepo, mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	archived := models.NewPVZ(models.CityMoscow)
+	archived.SetStatus(models.PVZStatusArchived, "закрыт")
+	active := models.NewPVZ(models.CityKazan)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), archived.ID).Return(archived, nil)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), active.ID).Return(active, nil)
+
+	// Из архива ПВЗ не возвращается
+	_, err := uc.ChangeStatus(context.Background(), archived.ID, models.PVZStatusActive, "")
+	assert.ErrorIs(t, err, errors.ErrPVZStatusTransition)
+
+	_, err = uc.ChangeStatus(context.Background(), active.ID, models.PVZStatusActive, "")
+	assert.ErrorIs(t, err, errors.ErrPVZStatusTransition)
+}
+
+func TestPVZUseCase_ChangeStatus_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewPVZUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestCatalog(ctrl))
+
+	_, err := uc.ChangeStatus(context.Background(), uuid.New(), models.PVZStatus("closed"), "ремонт")
+	assert.ErrorIs(t, err, errors.ErrInvalidPVZStatus)
+
+	_, err = uc.ChangeStatus(context.Background(), uuid.New(), models.PVZStatusSuspended, "")
+	assert.ErrorIs(t, err, errors.ErrInvalidStatusReason)
+
+	_, err = uc.ChangeStatus(context.Background(), uuid.New(), models.PVZStatusArchived, "закрыт\n")
+	assert.ErrorIs(t, err, errors.ErrInvalidStatusReason)
+}
@@ -2,54 +2,146 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
 	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
 )
 
+// staleReceptionBatchSize ограничивает число приемок, закрываемых одним запросом
+const staleReceptionBatchSize = 100
+
 type ReceptionUseCase struct {
 	pvzRepo       repository.PVZRepository
 	receptionRepo repository.ReceptionRepository
+	access        usecase.PVZAssignmentUseCase
+	events        events.Publisher
 }
 
 func NewReceptionUseCase(
 	pvzRepo repository.PVZRepository,
 	receptionRepo repository.ReceptionRepository,
+	access usecase.PVZAssignmentUseCase,
+	eventPublisher events.Publisher,
 ) usecase.ReceptionUseCase {
 	return &ReceptionUseCase{
 		pvzRepo:       pvzRepo,
 		receptionRepo: receptionRepo,
+		access:        access,
+		events:        eventPublisher,
 	}
 }
 
-func (uc *ReceptionUseCase) Create(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error) {
-	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+func (uc *ReceptionUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.ReceptionInput) (*models.Reception, error) {
+	if !models.IsValidReceptionKind(input.Kind) {
+		return nil, errors.ErrInvalidReceptionKind
+	}
+	if !models.IsValidReceptionMaxItems(input.MaxItems) {
+		return nil, errors.ErrInvalidMaxItems
+	}
+
+	// Сотрудник открывает приемки только в ПВЗ, за которыми закреплен
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
 	if err != nil {
 		return nil, err
 	}
 
-	lastOpenReception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
-	if err == nil && lastOpenReception != nil {
-		return nil, errors.ErrOpenReceptionExists
+	// Приостановленные и архивные ПВЗ не принимают новые поставки
+	if !pvz.IsActive() {
+		return nil, errors.ErrPVZNotActive
 	}
-	if err != nil && !errors.IsNotFound(err) {
+
+	if err := uc.ensureNoOpenReception(ctx, pvzID); err != nil {
 		return nil, err
 	}
 
 	reception := models.NewReception(pvzID)
+	reception.Kind = input.Kind
+	reception.MaxItems = input.MaxItems
 
 	if err := uc.receptionRepo.Create(ctx, reception); err != nil {
 		return nil, err
 	}
 
+	uc.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionOpened, pvz, reception))
+
+	return reception, nil
+}
+
+func (uc *ReceptionUseCase) Reopen(ctx context.Context, receptionID uuid.UUID, reason string) (*models.Reception, error) {
+	if !models.IsValidReopenReason(reason) {
+		return nil, errors.ErrInvalidReopenReason
+	}
+
+	reception, err := uc.receptionRepo.GetByID(ctx, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	if reception.IsInProgress() {
+		return nil, errors.ErrReceptionNotClosed
+	}
+	if err := uc.access.CheckAccess(ctx, reception.PVZID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, reception.PVZID)
+	if err != nil {
+		return nil, err
+	}
+	if !pvz.IsActive() {
+		return nil, errors.ErrPVZNotActive
+	}
+
+	// Открыть можно только последнюю приемку ПВЗ, иначе товары попадут в прошлую поставку
+	lastReception, err := uc.receptionRepo.GetLastByPVZID(ctx, pvz.ID)
+	if err != nil {
+		return nil, err
+	}
+	if lastReception.ID != reception.ID {
+		return nil, errors.ErrNewerReceptionExists
+	}
+
+	if err := uc.ensureNoOpenReception(ctx, pvz.ID); err != nil {
+		return nil, err
+	}
+
+	reception.Reopen(reason)
+
+	if err := uc.receptionRepo.Update(ctx, reception); err != nil {
+		return nil, err
+	}
+
+	uc.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionReopened, pvz, reception))
+
 	return reception, nil
 }
 
+// ensureNoOpenReception проверяет, что в ПВЗ нет открытой приемки: одновременно может быть открыта только одна
+func (uc *ReceptionUseCase) ensureNoOpenReception(ctx context.Context, pvzID uuid.UUID) error {
+	lastOpenReception, err := uc.receptionRepo.GetLastOpenByPVZID(ctx, pvzID)
+	if err == nil && lastOpenReception != nil {
+		return errors.ErrOpenReceptionExists
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (uc *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*models.Reception, error) {
-	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
 	if err != nil {
 		return nil, err
 	}
@@ -65,5 +157,34 @@ func (uc *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.U
 		return nil, err
 	}
 
+	uc.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionClosed, pvz, reception))
+
 	return reception, nil
 }
+
+func (uc *ReceptionUseCase) CloseStale(ctx context.Context, idleFor time.Duration) ([]*models.Reception, error) {
+	idleSince := time.Now().Add(-idleFor)
+
+	var closed []*models.Reception
+	for {
+		receptions, err := uc.receptionRepo.CloseStale(ctx, idleSince, staleReceptionBatchSize)
+		if err != nil {
+			return closed, err
+		}
+
+		for _, reception := range receptions {
+			// Приемка уже закрыта, поэтому событие отправляется, даже если ПВЗ не удалось загрузить:
+			// подписчики ПВЗ получат его по reception.PVZID, не узнают о нем только подписчики города
+			pvz, err := uc.pvzRepo.GetByID(ctx, reception.PVZID)
+			if err != nil {
+				pvz = &models.PVZ{ID: reception.PVZID}
+			}
+			uc.events.Publish(models.NewReceptionEvent(models.PVZEventReceptionClosed, pvz, reception))
+		}
+		closed = append(closed, receptions...)
+
+		if len(receptions) < staleReceptionBatchSize {
+			return closed, nil
+		}
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
 	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
 )
 
@@ -22,13 +24,18 @@ func TestReceptionUseCase_Create(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	broker := events.NewBroker()
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), broker)
 
 	pvzID := uuid.New()
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{PVZID: pvzID})
+	defer unsubscribe()
+
 	pvz := &models.PVZ{
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -42,10 +49,15 @@ func TestReceptionUseCase_Create(t *testing.T) {
 		return nil
 	})
 
-	reception, err := uc.Create(context.Background(), pvzID)
+	reception, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound})
 	require.NoError(t, err)
 	assert.Equal(t, pvzID, reception.PVZID)
 	assert.Equal(t, models.ReceptionStatusInProgress, reception.Status)
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventReceptionOpened, event.Type)
+	assert.Equal(t, reception, event.Reception)
 }
 
 func TestReceptionUseCase_Create_PVZNotFound(t *testing.T) {
@@ -55,17 +67,56 @@ func TestReceptionUseCase_Create_PVZNotFound(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
 
 	pvzID := uuid.New()
 
 	// ПВЗ не найден
 	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
 
-	_, err := uc.Create(context.Background(), pvzID)
+	_, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound})
 	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
 }
 
+func TestReceptionUseCase_Create_PVZNotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assignmentRepo := mock.NewMockPVZAssignmentRepository(ctrl)
+	access := NewPVZAssignmentUseCase(assignmentRepo, mock.NewMockPVZRepository(ctrl), mock.NewMockUserRepository(ctrl))
+
+	uc := NewReceptionUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockReceptionRepository(ctrl), access, events.NewBroker())
+
+	pvzID := uuid.New()
+	employee := models.NewUser("employee@example.com", "hash", models.EmployeeRole)
+
+	// Сотрудник не закреплен за ПВЗ: до ПВЗ и приемок дело не доходит
+	assignmentRepo.EXPECT().Exists(gomock.Any(), employee.ID, pvzID).Return(false, nil)
+
+	ctx := domainUsecase.WithCaller(context.Background(), employee)
+	_, err := uc.Create(ctx, pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound})
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+}
+
+func TestReceptionUseCase_Create_PVZNotActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvz.SetStatus(models.PVZStatusSuspended, "ремонт")
+
+	// Приостановленный ПВЗ не принимает поставки
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound})
+	assert.ErrorIs(t, err, errors.ErrPVZNotActive)
+}
+
 func TestReceptionUseCase_Create_OpenReceptionExists(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -73,13 +124,14 @@ func TestReceptionUseCase_Create_OpenReceptionExists(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
 
 	pvzID := uuid.New()
 	pvz := &models.PVZ{
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -96,10 +148,59 @@ func TestReceptionUseCase_Create_OpenReceptionExists(t *testing.T) {
 	// Уже есть открытая приемка
 	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvzID).Return(existingReception, nil)
 
-	_, err := uc.Create(context.Background(), pvzID)
+	_, err := uc.Create(newSystemContext(), pvzID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound})
 	assert.ErrorIs(t, err, errors.ErrOpenReceptionExists)
 }
 
+func TestReceptionUseCase_Create_CustomerReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ReceptionInput{Kind: "transfer"})
+	assert.ErrorIs(t, err, errors.ErrInvalidReceptionKind)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(nil, errors.ErrOpenReceptionNotFound)
+	receptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reception *models.Reception) error {
+		assert.Equal(t, models.ReceptionKindCustomerReturn, reception.Kind)
+		return nil
+	})
+
+	reception, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindCustomerReturn})
+	require.NoError(t, err)
+	assert.True(t, reception.IsCustomerReturn())
+}
+
+func TestReceptionUseCase_Create_MaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+
+	_, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound, MaxItems: -1})
+	assert.ErrorIs(t, err, errors.ErrInvalidMaxItems)
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(nil, errors.ErrOpenReceptionNotFound)
+	receptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	reception, err := uc.Create(newSystemContext(), pvz.ID, domainUsecase.ReceptionInput{Kind: models.ReceptionKindInbound, MaxItems: 50})
+	require.NoError(t, err)
+	assert.Equal(t, 50, reception.MaxItems)
+}
+
 func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -107,13 +208,14 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
 
 	pvzID := uuid.New()
 	pvz := &models.PVZ{
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -136,7 +238,7 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 		return nil
 	})
 
-	result, err := uc.CloseLastReception(context.Background(), pvzID)
+	result, err := uc.CloseLastReception(newSystemContext(), pvzID)
 	require.NoError(t, err)
 	assert.Equal(t, reception.ID, result.ID)
 	assert.Equal(t, models.ReceptionStatusClose, result.Status)
@@ -149,13 +251,13 @@ func TestReceptionUseCase_CloseLastReception_PVZNotFound(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
 
 	pvzID := uuid.New()
 
 	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrPVZNotFound)
 
-	_, err := uc.CloseLastReception(context.Background(), pvzID)
+	_, err := uc.CloseLastReception(newSystemContext(), pvzID)
 	assert.ErrorIs(t, err, errors.ErrPVZNotFound)
 }
 
@@ -166,13 +268,14 @@ func TestReceptionUseCase_CloseLastReception_NoOpenReception(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 
-	uc := NewReceptionUseCase(pvzRepo, receptionRepo)
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
 
 	pvzID := uuid.New()
 	pvz := &models.PVZ{
 		ID:               pvzID,
 		RegistrationDate: time.Now(),
 		City:             models.CityMoscow,
+		Status:           models.PVZStatusActive,
 		CreatedAt:        time.Now(),
 	}
 
@@ -180,6 +283,200 @@ func TestReceptionUseCase_CloseLastReception_NoOpenReception(t *testing.T) {
 
 	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvzID).Return(nil, errors.ErrOpenReceptionNotFound)
 
-	_, err := uc.CloseLastReception(context.Background(), pvzID)
+	_, err := uc.CloseLastReception(newSystemContext(), pvzID)
 	assert.ErrorIs(t, err, errors.ErrOpenReceptionNotFound)
 }
+
+func TestReceptionUseCase_Reopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), broker)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Close()
+
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{PVZID: pvz.ID})
+	defer unsubscribe()
+
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastByPVZID(gomock.Any(), pvz.ID).Return(reception, nil)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(nil, errors.ErrOpenReceptionNotFound)
+	receptionRepo.EXPECT().Update(gomock.Any(), reception).DoAndReturn(func(_ context.Context, reception *models.Reception) error {
+		assert.Equal(t, models.ReceptionStatusInProgress, reception.Status)
+		assert.Equal(t, "машина еще разгружается", reception.ReopenReason)
+		assert.NotNil(t, reception.ReopenedAt)
+		return nil
+	})
+
+	result, err := uc.Reopen(newSystemContext(), reception.ID, "машина еще разгружается")
+	require.NoError(t, err)
+	assert.True(t, result.IsInProgress())
+
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventReceptionReopened, event.Type)
+	assert.Equal(t, reception, event.Reception)
+}
+
+func TestReceptionUseCase_Reopen_NewerReceptionExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Close()
+	newer := models.NewReception(pvz.ID)
+
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastByPVZID(gomock.Any(), pvz.ID).Return(newer, nil)
+
+	_, err := uc.Reopen(newSystemContext(), reception.ID, "ошибка сотрудника")
+	assert.ErrorIs(t, err, errors.ErrNewerReceptionExists)
+}
+
+func TestReceptionUseCase_Reopen_OpenReceptionExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	reception := models.NewReception(pvz.ID)
+	reception.Close()
+
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	receptionRepo.EXPECT().GetLastByPVZID(gomock.Any(), pvz.ID).Return(reception, nil)
+	receptionRepo.EXPECT().GetLastOpenByPVZID(gomock.Any(), pvz.ID).Return(models.NewReception(pvz.ID), nil)
+
+	_, err := uc.Reopen(newSystemContext(), reception.ID, "ошибка сотрудника")
+	assert.ErrorIs(t, err, errors.ErrOpenReceptionExists)
+}
+
+func TestReceptionUseCase_Reopen_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	uc := NewReceptionUseCase(mock.NewMockPVZRepository(ctrl), receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	_, err := uc.Reopen(newSystemContext(), uuid.New(), "")
+	assert.ErrorIs(t, err, errors.ErrInvalidReopenReason)
+
+	// Открытую приемку открыть повторно нельзя
+	reception := models.NewReception(uuid.New())
+	receptionRepo.EXPECT().GetByID(gomock.Any(), reception.ID).Return(reception, nil)
+
+	_, err = uc.Reopen(newSystemContext(), reception.ID, "ошибка сотрудника")
+	assert.ErrorIs(t, err, errors.ErrReceptionNotClosed)
+}
+
+// newTimedOutReception возвращает приемку в том виде, в каком ее закрывает хранилище в CloseStale
+func newTimedOutReception(pvzID uuid.UUID) *models.Reception {
+	reception := models.NewReception(pvzID)
+	reception.Status = models.ReceptionStatusClose
+	reception.ClosedBy = models.SystemActor
+	reception.CloseReason = models.ReceptionCloseReasonTimedOut
+	return reception
+}
+
+func TestReceptionUseCase_CloseStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), broker)
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{})
+	defer unsubscribe()
+
+	// Первая пачка заполнена целиком, поэтому use case запрашивает следующую
+	batch := make([]*models.Reception, 0, staleReceptionBatchSize)
+	for i := 0; i < staleReceptionBatchSize; i++ {
+		batch = append(batch, newTimedOutReception(pvz.ID))
+	}
+	last := newTimedOutReception(pvz.ID)
+
+	before := time.Now()
+	gomock.InOrder(
+		receptionRepo.EXPECT().CloseStale(gomock.Any(), gomock.Any(), uint64(staleReceptionBatchSize)).DoAndReturn(func(_ context.Context, idleSince time.Time, _ uint64) ([]*models.Reception, error) {
+			assert.WithinDuration(t, before.Add(-2*time.Hour), idleSince, time.Second)
+			return batch, nil
+		}),
+		receptionRepo.EXPECT().CloseStale(gomock.Any(), gomock.Any(), uint64(staleReceptionBatchSize)).Return([]*models.Reception{last}, nil),
+	)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil).Times(staleReceptionBatchSize + 1)
+
+	closed, err := uc.CloseStale(newSystemContext(), 2*time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, closed, staleReceptionBatchSize+1)
+
+	// Буфер подписчика меньше пачки, лишние события теряются, но первые доходят
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventReceptionClosed, event.Type)
+	assert.True(t, event.Reception.IsTimedOut())
+}
+
+func TestReceptionUseCase_CloseStale_PVZLookupFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+
+	broker := events.NewBroker()
+	uc := NewReceptionUseCase(pvzRepo, receptionRepo, newTestAssignments(ctrl), broker)
+
+	pvzID := uuid.New()
+	pvzEvents, unsubscribe := broker.Subscribe(models.PVZEventFilter{PVZID: pvzID})
+	defer unsubscribe()
+
+	reception := newTimedOutReception(pvzID)
+	receptionRepo.EXPECT().CloseStale(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.Reception{reception}, nil)
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(nil, errors.ErrDBQuery)
+
+	closed, err := uc.CloseStale(newSystemContext(), time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, closed, 1)
+
+	// Приемка уже закрыта, подписчики ПВЗ получают событие и без данных ПВЗ
+	require.Len(t, pvzEvents, 1)
+	event := <-pvzEvents
+	assert.Equal(t, models.PVZEventReceptionClosed, event.Type)
+	assert.Equal(t, pvzID, event.PVZID)
+	assert.Equal(t, reception.ID, event.Reception.ID)
+}
+
+func TestReceptionUseCase_CloseStale_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receptionRepo := mock.NewMockReceptionRepository(ctrl)
+	uc := NewReceptionUseCase(mock.NewMockPVZRepository(ctrl), receptionRepo, newTestAssignments(ctrl), events.NewBroker())
+
+	receptionRepo.EXPECT().CloseStale(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrDBQuery)
+
+	closed, err := uc.CloseStale(newSystemContext(), time.Hour)
+	assert.ErrorIs(t, err, errors.ErrDBQuery)
+	assert.Empty(t, closed)
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+)
+
+type StorageCellUseCase struct {
+	pvzRepo     repository.PVZRepository
+	cellRepo    repository.StorageCellRepository
+	productRepo repository.ProductRepository
+	access      usecase.PVZAssignmentUseCase
+}
+
+func NewStorageCellUseCase(
+	pvzRepo repository.PVZRepository,
+	cellRepo repository.StorageCellRepository,
+	productRepo repository.ProductRepository,
+	access usecase.PVZAssignmentUseCase,
+) usecase.StorageCellUseCase {
+	return &StorageCellUseCase{
+		pvzRepo:     pvzRepo,
+		cellRepo:    cellRepo,
+		productRepo: productRepo,
+		access:      access,
+	}
+}
+
+func (uc *StorageCellUseCase) Create(ctx context.Context, pvzID uuid.UUID, input usecase.StorageCellInput) (*models.StorageCell, error) {
+	if !models.IsValidStorageCellPart(input.Rack) ||
+		!models.IsValidStorageCellPart(input.Shelf) ||
+		!models.IsValidStorageCellPart(input.Cell) ||
+		!models.IsValidStorageCellCapacity(input.Capacity) {
+		return nil, errors.ErrInvalidStorageCell
+	}
+
+	if _, err := uc.pvzRepo.GetByID(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	cell := models.NewStorageCell(pvzID, input.Rack, input.Shelf, input.Cell, input.Capacity)
+	if err := uc.cellRepo.Create(ctx, cell); err != nil {
+		return nil, err
+	}
+
+	return cell, nil
+}
+
+func (uc *StorageCellUseCase) List(ctx context.Context, pvzID uuid.UUID) ([]*models.StorageCell, error) {
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.pvzRepo.GetByID(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	return uc.cellRepo.ListByPVZID(ctx, pvzID)
+}
+
+func (uc *StorageCellUseCase) ListProducts(ctx context.Context, pvzID, cellID uuid.UUID) ([]*models.Product, error) {
+	if err := uc.access.CheckAccess(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	cell, err := uc.cellRepo.GetByID(ctx, cellID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ячейка другого ПВЗ для этого ПВЗ не существует
+	if cell.PVZID != pvzID {
+		return nil, errors.ErrStorageCellNotFound
+	}
+
+	return uc.productRepo.ListByCellID(ctx, cell.ID, models.ShelfProductStatuses)
+}
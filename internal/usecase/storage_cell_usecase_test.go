@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smthjapanese/avito_pvz/internal/domain/models"
+	"github.com/smthjapanese/avito_pvz/internal/domain/repository"
+	domainUsecase "github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/errors"
+	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
+)
+
+// newTestCells возвращает хранилище ячеек ПВЗ без схемы хранения: товары принимаются без ячейки
+func newTestCells(ctrl *gomock.Controller) repository.StorageCellRepository {
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+	cellRepo.EXPECT().OccupyFree(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNoFreeStorageCell).AnyTimes()
+	cellRepo.EXPECT().ListByPVZID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return cellRepo
+}
+
+func TestStorageCellUseCase_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzRepo := mock.NewMockPVZRepository(ctrl)
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+
+	uc := NewStorageCellUseCase(pvzRepo, cellRepo, mock.NewMockProductRepository(ctrl), newTestAssignments(ctrl))
+
+	pvz := models.NewPVZ(models.CityMoscow)
+	input := domainUsecase.StorageCellInput{Rack: "A", Shelf: "2", Cell: "14", Capacity: 5}
+
+	pvzRepo.EXPECT().GetByID(gomock.Any(), pvz.ID).Return(pvz, nil)
+	cellRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cell *models.StorageCell) error {
+		assert.Equal(t, pvz.ID, cell.PVZID)
+		assert.Equal(t, "A", cell.Rack)
+		assert.Equal(t, 5, cell.Capacity)
+		assert.Zero(t, cell.Occupied)
+		return nil
+	})
+
+	cell, err := uc.Create(context.Background(), pvz.ID, input)
+	require.NoError(t, err)
+	assert.Equal(t, "14", cell.Cell)
+}
+
+func TestStorageCellUseCase_Create_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewStorageCellUseCase(mock.NewMockPVZRepository(ctrl), mock.NewMockStorageCellRepository(ctrl), mock.NewMockProductRepository(ctrl), newTestAssignments(ctrl))
+
+	tests := []struct {
+		name  string
+		input domainUsecase.StorageCellInput
+	}{
+		{name: "Empty rack", input: domainUsecase.StorageCellInput{Shelf: "1", Cell: "1", Capacity: 1}},
+		{name: "Space in cell", input: domainUsecase.StorageCellInput{Rack: "A", Shelf: "1", Cell: "1 2", Capacity: 1}},
+		{name: "Zero capacity", input: domainUsecase.StorageCellInput{Rack: "A", Shelf: "1", Cell: "1"}},
+		{name: "Too large capacity", input: domainUsecase.StorageCellInput{Rack: "A", Shelf: "1", Cell: "1", Capacity: models.MaxStorageCellCapacity + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Create(context.Background(), uuid.New(), tt.input)
+			assert.ErrorIs(t, err, errors.ErrInvalidStorageCell)
+		})
+	}
+}
+
+func TestStorageCellUseCase_ListProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cellRepo := mock.NewMockStorageCellRepository(ctrl)
+	productRepo := mock.NewMockProductRepository(ctrl)
+
+	uc := NewStorageCellUseCase(mock.NewMockPVZRepository(ctrl), cellRepo, productRepo, newTestAssignments(ctrl))
+
+	pvzID := uuid.New()
+	cell := models.NewStorageCell(pvzID, "A", "1", "1", 3)
+	product := models.NewProduct(models.ProductTypeShoes, uuid.New())
+	product.CellID = &cell.ID
+
+	cellRepo.EXPECT().GetByID(gomock.Any(), cell.ID).Return(cell, nil).Times(2)
+	productRepo.EXPECT().ListByCellID(gomock.Any(), cell.ID, models.ShelfProductStatuses).Return([]*models.Product{product}, nil)
+
+	products, err := uc.ListProducts(newSystemContext(), pvzID, cell.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.Product{product}, products)
+
+	// Ячейка другого ПВЗ не находится
+	_, err = uc.ListProducts(newSystemContext(), uuid.New(), cell.ID)
+	assert.ErrorIs(t, err, errors.ErrStorageCellNotFound)
+
+	// Сотрудник видит ячейки и их содержимое только в назначенных ПВЗ
+	employee := &models.User{ID: uuid.New(), Role: models.EmployeeRole, Dummy: true, PVZScope: []uuid.UUID{uuid.New()}}
+	employeeCtx := domainUsecase.WithCaller(context.Background(), employee)
+
+	_, err = uc.ListProducts(employeeCtx, pvzID, cell.ID)
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+
+	_, err = uc.List(employeeCtx, pvzID)
+	assert.ErrorIs(t, err, errors.ErrPVZAccessDenied)
+}
@@ -1,23 +1,38 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/smthjapanese/avito_pvz/internal/domain/usecase"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/jwt"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
 	repoProvider "github.com/smthjapanese/avito_pvz/internal/repository"
 )
 
 type UseCases struct {
-	User      usecase.UserUseCase
-	PVZ       usecase.PVZUseCase
-	Reception usecase.ReceptionUseCase
-	Product   usecase.ProductUseCase
+	User        usecase.UserUseCase
+	PVZ         usecase.PVZUseCase
+	Reception   usecase.ReceptionUseCase
+	Product     usecase.ProductUseCase
+	Catalog     usecase.CatalogUseCase
+	Cell        usecase.StorageCellUseCase
+	Idempotency usecase.IdempotencyUseCase
+	Assignment  usecase.PVZAssignmentUseCase
 }
 
-func NewUseCases(repos *repoProvider.Repositories, tokenManager *jwt.Manager) *UseCases {
+func NewUseCases(repos *repoProvider.Repositories, tokenManager *jwt.Manager, eventPublisher events.Publisher, metrics metrics.MetricsInterface, idempotencyTTL time.Duration) *UseCases {
+	catalog := NewCatalogUseCase(repos.Catalog)
+	assignment := NewPVZAssignmentUseCase(repos.Assignment, repos.PVZ, repos.User)
+
 	return &UseCases{
-		User:      NewUserUseCase(repos.User, tokenManager),
-		PVZ:       NewPVZUseCase(repos.PVZ, repos.Reception, repos.Product),
-		Reception: NewReceptionUseCase(repos.PVZ, repos.Reception),
-		Product:   NewProductUseCase(repos.PVZ, repos.Reception, repos.Product),
+		User:        NewUserUseCase(repos.User, tokenManager),
+		PVZ:         NewPVZUseCase(repos.PVZ, repos.Reception, repos.Product, catalog),
+		Reception:   NewReceptionUseCase(repos.PVZ, repos.Reception, assignment, eventPublisher),
+		Product:     NewProductUseCase(repos.PVZ, repos.Reception, repos.Product, repos.Cell, repos.Tx, catalog, assignment, eventPublisher, metrics),
+		Catalog:     catalog,
+		Cell:        NewStorageCellUseCase(repos.PVZ, repos.Cell, repos.Product, assignment),
+		Idempotency: NewIdempotencyUseCase(repos.Idempotency, idempotencyTTL),
+		Assignment:  assignment,
 	}
 }
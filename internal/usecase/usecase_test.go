@@ -7,7 +7,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smthjapanese/avito_pvz/internal/pkg/events"
 	"github.com/smthjapanese/avito_pvz/internal/pkg/jwt"
+	"github.com/smthjapanese/avito_pvz/internal/pkg/metrics"
 	"github.com/smthjapanese/avito_pvz/internal/repository"
 	"github.com/smthjapanese/avito_pvz/internal/repository/mock"
 )
@@ -20,21 +22,26 @@ func TestNewUseCases(t *testing.T) {
 	pvzRepo := mock.NewMockPVZRepository(ctrl)
 	receptionRepo := mock.NewMockReceptionRepository(ctrl)
 	productRepo := mock.NewMockProductRepository(ctrl)
+	catalogRepo := mock.NewMockCatalogRepository(ctrl)
 
 	repos := &repository.Repositories{
 		User:      userRepo,
 		PVZ:       pvzRepo,
 		Reception: receptionRepo,
 		Product:   productRepo,
+		Catalog:   catalogRepo,
 	}
 
 	tokenManager := jwt.NewManager("test-secret", time.Hour)
 
-	useCases := NewUseCases(repos, tokenManager)
+	useCases := NewUseCases(repos, tokenManager, events.NewBroker(), metrics.NewMockMetrics(), 24*time.Hour)
 	assert.NotNil(t, useCases.User)
 	assert.NotNil(t, useCases.PVZ)
 	assert.NotNil(t, useCases.Reception)
 	assert.NotNil(t, useCases.Product)
+	assert.NotNil(t, useCases.Catalog)
+	assert.NotNil(t, useCases.Idempotency)
+	assert.NotNil(t, useCases.Assignment)
 	
 	_, ok := useCases.User.(*UserUseCase)
 	assert.True(t, ok)
@@ -47,4 +54,7 @@ func TestNewUseCases(t *testing.T) {
 
 	_, ok = useCases.Product.(*ProductUseCase)
 	assert.True(t, ok)
+
+	_, ok = useCases.Catalog.(*CatalogUseCase)
+	assert.True(t, ok)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +27,10 @@ func NewUserUseCase(userRepo repository.UserRepository, tokenManager *jwt.Manage
 }
 
 func (uc *UserUseCase) Register(ctx context.Context, email, plainPassword string, role models.UserRole) (*models.User, error) {
+	if strings.HasPrefix(strings.ToLower(email), models.DummyEmailPrefix) {
+		return nil, errors.ErrReservedEmail
+	}
+
 	existingUser, err := uc.userRepo.GetByEmail(ctx, email)
 	if err == nil && existingUser != nil {
 		return nil, errors.ErrUserAlreadyExists
@@ -79,12 +84,12 @@ func (uc *UserUseCase) Login(ctx context.Context, email, plainPassword string) (
 	return token, nil
 }
 
-func (uc *UserUseCase) DummyLogin(ctx context.Context, role models.UserRole) (string, error) {
+func (uc *UserUseCase) DummyLogin(ctx context.Context, role models.UserRole, pvzIDs []uuid.UUID) (string, error) {
 	if role != models.EmployeeRole && role != models.ModeratorRole {
 		return "", errors.ErrInvalidInput
 	}
 
-	token, err := uc.tokenManager.GenerateDummyToken(role)
+	token, err := uc.tokenManager.GenerateDummyToken(role, pvzIDs...)
 	if err != nil {
 		return "", errors.Wrap(errors.ErrInternal, "failed to generate dummy token")
 	}
@@ -103,11 +108,23 @@ func (uc *UserUseCase) ValidateToken(ctx context.Context, tokenString string) (*
 		return nil, errors.ErrUnauthorized
 	}
 
-	if claims.Email[:6] == "dummy_" {
+	// Тестовый токен отличается подписанным признаком, а не адресом, который можно зарегистрировать
+	if claims.Dummy {
+		scope := make([]uuid.UUID, 0, len(claims.PVZIDs))
+		for _, id := range claims.PVZIDs {
+			pvzID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, errors.ErrUnauthorized
+			}
+			scope = append(scope, pvzID)
+		}
+
 		return &models.User{
-			ID:    userID,
-			Email: claims.Email,
-			Role:  claims.Role,
+			ID:       userID,
+			Email:    claims.Email,
+			Role:     claims.Role,
+			Dummy:    true,
+			PVZScope: scope,
 		}, nil
 	}
 
@@ -71,6 +71,19 @@ func TestUserUseCase_Register_UserAlreadyExists(t *testing.T) {
 	assert.ErrorIs(t, err, errors.ErrUserAlreadyExists)
 }
 
+func TestUserUseCase_Register_ReservedEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewUserUseCase(mock.NewMockUserRepository(ctrl), jwt.NewManager("test-secret", time.Hour))
+
+	// Адреса тестовых токенов не регистрируются, в хранилище запрос не доходит
+	for _, email := range []string{"dummy_x@corp.ru", "Dummy_employee@example.com"} {
+		_, err := uc.Register(context.Background(), email, "password", models.EmployeeRole)
+		assert.ErrorIs(t, err, errors.ErrReservedEmail)
+	}
+}
+
 func TestUserUseCase_Login(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -142,7 +155,7 @@ func TestUserUseCase_DummyLogin(t *testing.T) {
 
 	role := models.EmployeeRole
 
-	token, err := uc.DummyLogin(context.Background(), role)
+	token, err := uc.DummyLogin(context.Background(), role, nil)
 	require.NoError(t, err)
 	assert.NotEmpty(t, token)
 
@@ -150,6 +163,27 @@ func TestUserUseCase_DummyLogin(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, role, claims.Role)
 	assert.Contains(t, claims.Email, "dummy_")
+	assert.Empty(t, claims.PVZIDs)
+}
+
+func TestUserUseCase_DummyLogin_PVZScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewUserUseCase(mock.NewMockUserRepository(ctrl), jwt.NewManager("test-secret", time.Hour))
+
+	pvzID := uuid.New()
+
+	token, err := uc.DummyLogin(context.Background(), models.EmployeeRole, []uuid.UUID{pvzID})
+	require.NoError(t, err)
+
+	// Список ПВЗ из токена попадает в пользователя запроса
+	user, err := uc.ValidateToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.True(t, user.Dummy)
+	assert.Equal(t, []uuid.UUID{pvzID}, user.PVZScope)
+	assert.True(t, user.InPVZScope(pvzID))
+	assert.False(t, user.InPVZScope(uuid.New()))
 }
 
 func TestUserUseCase_ValidateToken(t *testing.T) {
@@ -184,20 +218,31 @@ func TestUserUseCase_ValidateToken(t *testing.T) {
 	})
 
 	t.Run("successful validation of dummy token", func(t *testing.T) {
+		token, err := tokenManager.GenerateDummyToken(models.ModeratorRole)
+		require.NoError(t, err)
+
+		// Пользователь тестового токена не ищется в БД
+		user, err := uc.ValidateToken(context.Background(), token)
+		require.NoError(t, err)
+		assert.True(t, user.Dummy)
+		assert.Equal(t, models.ModeratorRole, user.Role)
+	})
+
+	t.Run("regular token with dummy email", func(t *testing.T) {
 		userID := uuid.New()
 		email := "dummy_test@example.com"
-		role := models.UserRole("moderator")
 
-		// Создаем dummy токен
-		token, err := tokenManager.GenerateToken(userID, email, role)
+		// Адрес с префиксом тестовых токенов не делает обычный токен тестовым
+		token, err := tokenManager.GenerateToken(userID, email, models.EmployeeRole)
 		require.NoError(t, err)
 
-		// Валидируем токен
+		expectedUser := &models.User{ID: userID, Email: email, Role: models.EmployeeRole}
+		mockUserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(expectedUser, nil)
+
 		user, err := uc.ValidateToken(context.Background(), token)
 		require.NoError(t, err)
-		assert.Equal(t, userID, user.ID)
-		assert.Equal(t, email, user.Email)
-		assert.Equal(t, role, user.Role)
+		assert.False(t, user.Dummy)
+		assert.Equal(t, expectedUser, user)
 	})
 
 	t.Run("invalid token", func(t *testing.T) {
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_reception_barcode;
+DROP INDEX IF EXISTS idx_products_barcode;
+DROP INDEX IF EXISTS idx_products_order_id;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS barcode,
+    DROP COLUMN IF EXISTS order_id;
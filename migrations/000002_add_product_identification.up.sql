@@ -0,0 +1,8 @@
+ALTER TABLE products
+    ADD COLUMN order_id VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN barcode VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_products_order_id ON products(order_id) WHERE order_id <> '';
+CREATE INDEX idx_products_barcode ON products(barcode) WHERE barcode <> '';
+-- Один и тот же штрихкод нельзя отсканировать в приемке дважды
+CREATE UNIQUE INDEX idx_products_reception_barcode ON products(reception_id, barcode) WHERE barcode <> '';
@@ -0,0 +1,14 @@
+-- Откат возможен, только если в данных нет значений, добавленных через справочники
+CREATE TYPE city_type AS ENUM ('Москва', 'Санкт-Петербург', 'Казань');
+CREATE TYPE product_type AS ENUM ('электроника', 'одежда', 'обувь');
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS fk_products_type,
+    ALTER COLUMN type TYPE product_type USING type::product_type;
+
+ALTER TABLE pvzs
+    DROP CONSTRAINT IF EXISTS fk_pvzs_city,
+    ALTER COLUMN city TYPE city_type USING city::city_type;
+
+DROP TABLE IF EXISTS product_types;
+DROP TABLE IF EXISTS cities;
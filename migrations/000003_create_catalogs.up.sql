@@ -0,0 +1,30 @@
+-- Справочники городов и категорий товаров вместо ENUM.
+-- Значения не удаляются, а выводятся из оборота (active = false),
+-- чтобы на них по-прежнему ссылались исторические ПВЗ и товары.
+CREATE TABLE cities (
+                        name VARCHAR(64) PRIMARY KEY,
+                        active BOOLEAN NOT NULL DEFAULT TRUE,
+                        created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+                        updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE product_types (
+                               name VARCHAR(64) PRIMARY KEY,
+                               active BOOLEAN NOT NULL DEFAULT TRUE,
+                               created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO cities (name) VALUES ('Москва'), ('Санкт-Петербург'), ('Казань');
+INSERT INTO product_types (name) VALUES ('электроника'), ('одежда'), ('обувь');
+
+ALTER TABLE pvzs
+    ALTER COLUMN city TYPE VARCHAR(64) USING city::text,
+    ADD CONSTRAINT fk_pvzs_city FOREIGN KEY (city) REFERENCES cities(name);
+
+ALTER TABLE products
+    ALTER COLUMN type TYPE VARCHAR(64) USING type::text,
+    ADD CONSTRAINT fk_products_type FOREIGN KEY (type) REFERENCES product_types(name);
+
+DROP TYPE city_type;
+DROP TYPE product_type;
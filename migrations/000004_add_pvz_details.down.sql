@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_pvzs_coordinates;
+
+ALTER TABLE pvzs
+    DROP CONSTRAINT IF EXISTS chk_pvzs_coordinates,
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS working_hours,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS address;
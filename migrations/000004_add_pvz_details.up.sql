@@ -0,0 +1,14 @@
+ALTER TABLE pvzs
+    ADD COLUMN address VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD COLUMN working_hours VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN phone VARCHAR(16) NOT NULL DEFAULT '',
+    -- Координаты задаются либо обе, либо ни одной
+    ADD CONSTRAINT chk_pvzs_coordinates CHECK (
+        (latitude IS NULL AND longitude IS NULL) OR
+        (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+-- Поиск ближайших ПВЗ сначала отбирает точки по ограничивающему прямоугольнику
+CREATE INDEX idx_pvzs_coordinates ON pvzs(latitude, longitude) WHERE latitude IS NOT NULL;
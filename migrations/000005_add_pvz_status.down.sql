@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pvzs_status;
+
+ALTER TABLE pvzs
+    DROP CONSTRAINT IF EXISTS chk_pvzs_status,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,9 @@
+ALTER TABLE pvzs
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active',
+    ADD COLUMN status_reason VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN status_changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    ADD CONSTRAINT chk_pvzs_status CHECK (status IN ('active', 'suspended', 'archived'));
+
+UPDATE pvzs SET status_changed_at = registration_date;
+
+CREATE INDEX idx_pvzs_status ON pvzs(status);
@@ -0,0 +1,3 @@
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS reopened_at,
+    DROP COLUMN IF EXISTS reopen_reason;
@@ -0,0 +1,3 @@
+ALTER TABLE receptions
+    ADD COLUMN reopen_reason VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN reopened_at TIMESTAMP WITH TIME ZONE;
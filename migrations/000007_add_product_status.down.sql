@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_products_shelf;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS chk_products_status,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,9 @@
+ALTER TABLE products
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'accepted',
+    ADD COLUMN status_changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    ADD CONSTRAINT chk_products_status CHECK (status IN ('accepted', 'ready_for_pickup', 'issued', 'refused'));
+
+UPDATE products SET status_changed_at = date_time;
+
+-- Товары, которые сейчас находятся в ПВЗ
+CREATE INDEX idx_products_shelf ON products(reception_id, status) WHERE status IN ('accepted', 'ready_for_pickup');
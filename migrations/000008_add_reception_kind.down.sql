@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_products_original_product_id;
+DROP INDEX IF EXISTS idx_receptions_pvz_kind;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS return_reason,
+    DROP COLUMN IF EXISTS original_product_id;
+
+ALTER TABLE receptions
+    DROP CONSTRAINT IF EXISTS chk_receptions_kind,
+    DROP COLUMN IF EXISTS kind;
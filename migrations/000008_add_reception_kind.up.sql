@@ -0,0 +1,11 @@
+ALTER TABLE receptions
+    ADD COLUMN kind VARCHAR(20) NOT NULL DEFAULT 'inbound',
+    ADD CONSTRAINT chk_receptions_kind CHECK (kind IN ('inbound', 'customer_return'));
+
+-- Возвращенный товар ссылается на выданный ранее товар или только на номер заказа
+ALTER TABLE products
+    ADD COLUMN original_product_id UUID REFERENCES products(id),
+    ADD COLUMN return_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_receptions_pvz_kind ON receptions(pvz_id, kind);
+CREATE INDEX idx_products_original_product_id ON products(original_product_id) WHERE original_product_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_products_cell;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS cell_id;
+
+DROP TABLE IF EXISTS storage_cells;
@@ -0,0 +1,22 @@
+-- Схема хранения ПВЗ: стеллаж, полка и ячейка с вместимостью в товарах.
+-- occupied меняется условным UPDATE, поэтому ячейка не переполняется
+-- при одновременной приемке с нескольких терминалов.
+CREATE TABLE storage_cells (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               pvz_id UUID NOT NULL REFERENCES pvzs(id),
+                               rack VARCHAR(16) NOT NULL,
+                               shelf VARCHAR(16) NOT NULL,
+                               cell VARCHAR(16) NOT NULL,
+                               capacity INTEGER NOT NULL,
+                               occupied INTEGER NOT NULL DEFAULT 0,
+                               created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+                               CONSTRAINT uq_storage_cells_address UNIQUE (pvz_id, rack, shelf, cell),
+                               CONSTRAINT chk_storage_cells_capacity CHECK (capacity > 0),
+                               CONSTRAINT chk_storage_cells_occupied CHECK (occupied >= 0 AND occupied <= capacity)
+);
+
+ALTER TABLE products
+    ADD COLUMN cell_id UUID REFERENCES storage_cells(id);
+
+-- Содержимое ячейки для экрана отбора
+CREATE INDEX idx_products_cell ON products(cell_id, status) WHERE cell_id IS NOT NULL;
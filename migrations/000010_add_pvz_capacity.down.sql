@@ -0,0 +1,9 @@
+ALTER TABLE receptions
+    DROP CONSTRAINT IF EXISTS chk_receptions_max_items,
+    DROP COLUMN IF EXISTS max_items;
+
+ALTER TABLE pvzs
+    DROP CONSTRAINT IF EXISTS chk_pvzs_occupied,
+    DROP CONSTRAINT IF EXISTS chk_pvzs_capacity,
+    DROP COLUMN IF EXISTS occupied,
+    DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,23 @@
+-- Вместимость ПВЗ и число товаров в нем, 0 в capacity - без ограничения.
+-- occupied меняется условным UPDATE при приемке и уходе товара с полки.
+-- Вместимость можно уменьшить ниже текущей заполненности, тогда ПВЗ просто не принимает товары.
+ALTER TABLE pvzs
+    ADD COLUMN capacity INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN occupied INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_pvzs_capacity CHECK (capacity >= 0),
+    ADD CONSTRAINT chk_pvzs_occupied CHECK (occupied >= 0);
+
+-- Товары, которые уже лежат в ПВЗ
+UPDATE pvzs
+SET occupied = (
+    SELECT COUNT(*)
+    FROM products
+    JOIN receptions ON receptions.id = products.reception_id
+    WHERE receptions.pvz_id = pvzs.id
+      AND products.status IN ('accepted', 'ready_for_pickup')
+);
+
+-- Квота приемки в товарах, 0 - без ограничения
+ALTER TABLE receptions
+    ADD COLUMN max_items INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_receptions_max_items CHECK (max_items >= 0);
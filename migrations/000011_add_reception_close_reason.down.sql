@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_receptions_in_progress;
+
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS close_reason,
+    DROP COLUMN IF EXISTS closed_by;
@@ -0,0 +1,8 @@
+-- Кто и почему закрыл приемку. Пустые значения - приемку закрыл сотрудник ПВЗ.
+-- Зависшие приемки закрывает фоновая задача: closed_by = 'system', close_reason = 'timed_out'.
+ALTER TABLE receptions
+    ADD COLUMN closed_by VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN close_reason VARCHAR(32) NOT NULL DEFAULT '';
+
+-- Поиск открытых приемок для автозакрытия
+CREATE INDEX idx_receptions_in_progress ON receptions(date_time) WHERE status = 'in_progress';
@@ -0,0 +1,17 @@
+-- Ответы на запросы с заголовком Idempotency-Key. Ключ действует в пределах пользователя.
+-- Пока запрос выполняется, completed_at пуст: повтор с тем же ключом получает конфликт.
+-- Истекшую запись новый запрос с тем же ключом перезаписывает.
+CREATE TABLE idempotency_keys (
+                                  user_id UUID NOT NULL,
+                                  key VARCHAR(255) NOT NULL,
+                                  request_hash VARCHAR(64) NOT NULL,
+                                  status_code INTEGER NOT NULL DEFAULT 0,
+                                  response BYTEA,
+                                  completed_at TIMESTAMP WITH TIME ZONE,
+                                  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+                                  PRIMARY KEY (user_id, key)
+);
+
+-- Удаление истекших ключей
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pvz_assignments_pvz;
+
+DROP TABLE IF EXISTS pvz_assignments;
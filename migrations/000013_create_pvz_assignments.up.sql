@@ -0,0 +1,11 @@
+-- Сотрудники, закрепленные за ПВЗ. Сотрудник работает с приемками и товарами
+-- только тех ПВЗ, в которые его назначил модератор.
+CREATE TABLE pvz_assignments (
+                                 user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 pvz_id UUID NOT NULL REFERENCES pvzs(id),
+                                 created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+                                 PRIMARY KEY (user_id, pvz_id)
+);
+
+-- Список сотрудников ПВЗ
+CREATE INDEX idx_pvz_assignments_pvz ON pvz_assignments(pvz_id);
@@ -0,0 +1,3 @@
+ALTER TABLE receptions
+    DROP CONSTRAINT IF EXISTS chk_receptions_items_count,
+    DROP COLUMN IF EXISTS items_count;
@@ -0,0 +1,12 @@
+-- Число товаров в приемке. Квота max_items проверяется условным UPDATE этого счетчика
+-- в транзакции добавления товара, поэтому параллельные сканирования ее не превысят.
+ALTER TABLE receptions
+    ADD COLUMN items_count INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_receptions_items_count CHECK (items_count >= 0);
+
+UPDATE receptions
+SET items_count = (
+    SELECT COUNT(*)
+    FROM products
+    WHERE products.reception_id = receptions.id
+);
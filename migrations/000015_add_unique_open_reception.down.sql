@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_receptions_open_per_pvz;
@@ -0,0 +1,14 @@
+-- В ПВЗ одновременно может быть открыта только одна приемка. Проверка в сервисе
+-- не защищает от параллельных запросов, поэтому правило закрепляет уникальный индекс.
+-- Если открытых приемок уже несколько, оставляем открытой только последнюю.
+UPDATE receptions
+SET status = 'close', closed_by = 'system'
+WHERE status = 'in_progress'
+  AND id NOT IN (
+    SELECT DISTINCT ON (pvz_id) id
+    FROM receptions
+    WHERE status = 'in_progress'
+    ORDER BY pvz_id, date_time DESC
+);
+
+CREATE UNIQUE INDEX idx_receptions_open_per_pvz ON receptions(pvz_id) WHERE status = 'in_progress';
@@ -22,7 +22,8 @@ import (
 )
 
 type dummyLoginRequest struct {
-	Role models.UserRole `json:"role"`
+	Role   models.UserRole `json:"role"`
+	PVZIDs []uuid.UUID     `json:"pvzIds,omitempty"`
 }
 
 type tokenResponse struct {
@@ -104,7 +105,7 @@ func TestPVZWorkflow(t *testing.T) {
 	t.Logf("Created PVZ with ID: %s", pvz.ID)
 
 	t.Log("Step 3: Getting employee token")
-	employeeToken := getEmployeeToken(t, baseURL)
+	employeeToken := getEmployeeToken(t, baseURL, pvz.ID)
 	require.NotEmpty(t, employeeToken, "Employee token should not be empty")
 
 	t.Log("Step 4: Creating reception with employee role")
@@ -271,6 +272,109 @@ func setupTestDatabase(t *testing.T, cfg *config.Config) {
         CREATE INDEX IF NOT EXISTS idx_receptions_status ON receptions(status);
         CREATE INDEX IF NOT EXISTS idx_products_reception_id ON products(reception_id);
         CREATE INDEX IF NOT EXISTS idx_products_date_time ON products(date_time);
+
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS order_id VARCHAR(64) NOT NULL DEFAULT '';
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode VARCHAR(64) NOT NULL DEFAULT '';
+        CREATE INDEX IF NOT EXISTS idx_products_order_id ON products(order_id) WHERE order_id <> '';
+        CREATE INDEX IF NOT EXISTS idx_products_barcode ON products(barcode) WHERE barcode <> '';
+        CREATE UNIQUE INDEX IF NOT EXISTS idx_products_reception_barcode ON products(reception_id, barcode) WHERE barcode <> '';
+
+        CREATE TABLE IF NOT EXISTS cities (
+            name VARCHAR(64) PRIMARY KEY,
+            active BOOLEAN NOT NULL DEFAULT TRUE,
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+        );
+        CREATE TABLE IF NOT EXISTS product_types (
+            name VARCHAR(64) PRIMARY KEY,
+            active BOOLEAN NOT NULL DEFAULT TRUE,
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+        );
+        INSERT INTO cities (name) VALUES ('Москва'), ('Санкт-Петербург'), ('Казань') ON CONFLICT DO NOTHING;
+        INSERT INTO product_types (name) VALUES ('электроника'), ('одежда'), ('обувь') ON CONFLICT DO NOTHING;
+        ALTER TABLE pvzs ALTER COLUMN city TYPE VARCHAR(64) USING city::text;
+        ALTER TABLE products ALTER COLUMN type TYPE VARCHAR(64) USING type::text;
+
+        DO $$
+        BEGIN
+            IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_pvzs_city') THEN
+                ALTER TABLE pvzs ADD CONSTRAINT fk_pvzs_city FOREIGN KEY (city) REFERENCES cities(name);
+            END IF;
+
+            IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_products_type') THEN
+                ALTER TABLE products ADD CONSTRAINT fk_products_type FOREIGN KEY (type) REFERENCES product_types(name);
+            END IF;
+        END
+        $$;
+
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS address VARCHAR(255) NOT NULL DEFAULT '';
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS working_hours VARCHAR(255) NOT NULL DEFAULT '';
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS phone VARCHAR(16) NOT NULL DEFAULT '';
+        CREATE INDEX IF NOT EXISTS idx_pvzs_coordinates ON pvzs(latitude, longitude) WHERE latitude IS NOT NULL;
+
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active';
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS status_reason VARCHAR(255) NOT NULL DEFAULT '';
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+        CREATE INDEX IF NOT EXISTS idx_pvzs_status ON pvzs(status);
+
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopen_reason VARCHAR(255) NOT NULL DEFAULT '';
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_at TIMESTAMP WITH TIME ZONE;
+
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'accepted';
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+        CREATE INDEX IF NOT EXISTS idx_products_shelf ON products(reception_id, status) WHERE status IN ('accepted', 'ready_for_pickup');
+
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'inbound';
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS original_product_id UUID REFERENCES products(id);
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS return_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+        CREATE TABLE IF NOT EXISTS storage_cells (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            pvz_id UUID NOT NULL REFERENCES pvzs(id),
+            rack VARCHAR(16) NOT NULL,
+            shelf VARCHAR(16) NOT NULL,
+            cell VARCHAR(16) NOT NULL,
+            capacity INTEGER NOT NULL,
+            occupied INTEGER NOT NULL DEFAULT 0,
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            UNIQUE (pvz_id, rack, shelf, cell),
+            CHECK (occupied >= 0 AND occupied <= capacity)
+        );
+        ALTER TABLE products ADD COLUMN IF NOT EXISTS cell_id UUID REFERENCES storage_cells(id);
+
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS capacity INTEGER NOT NULL DEFAULT 0;
+        ALTER TABLE pvzs ADD COLUMN IF NOT EXISTS occupied INTEGER NOT NULL DEFAULT 0;
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS max_items INTEGER NOT NULL DEFAULT 0;
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS items_count INTEGER NOT NULL DEFAULT 0;
+
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_by VARCHAR(64) NOT NULL DEFAULT '';
+        ALTER TABLE receptions ADD COLUMN IF NOT EXISTS close_reason VARCHAR(32) NOT NULL DEFAULT '';
+        CREATE INDEX IF NOT EXISTS idx_receptions_in_progress ON receptions(date_time) WHERE status = 'in_progress';
+        CREATE UNIQUE INDEX IF NOT EXISTS idx_receptions_open_per_pvz ON receptions(pvz_id) WHERE status = 'in_progress';
+
+        CREATE TABLE IF NOT EXISTS idempotency_keys (
+            user_id UUID NOT NULL,
+            key VARCHAR(255) NOT NULL,
+            request_hash VARCHAR(64) NOT NULL,
+            status_code INTEGER NOT NULL DEFAULT 0,
+            response BYTEA,
+            completed_at TIMESTAMP WITH TIME ZONE,
+            created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+            expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+            PRIMARY KEY (user_id, key)
+        );
+        CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+        CREATE TABLE IF NOT EXISTS pvz_assignments (
+            user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+            pvz_id UUID NOT NULL REFERENCES pvzs(id),
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            PRIMARY KEY (user_id, pvz_id)
+        );
+        CREATE INDEX IF NOT EXISTS idx_pvz_assignments_pvz ON pvz_assignments(pvz_id);
     `)
 	if err != nil {
 		t.Logf("Warning during schema setup: %v", err)
@@ -286,13 +390,14 @@ func getModeratorToken(t *testing.T, baseURL string) string {
 	return getToken(t, baseURL, models.ModeratorRole)
 }
 
-func getEmployeeToken(t *testing.T, baseURL string) string {
-	return getToken(t, baseURL, models.EmployeeRole)
+func getEmployeeToken(t *testing.T, baseURL string, pvzIDs ...uuid.UUID) string {
+	return getToken(t, baseURL, models.EmployeeRole, pvzIDs...)
 }
 
-func getToken(t *testing.T, baseURL string, role models.UserRole) string {
+func getToken(t *testing.T, baseURL string, role models.UserRole, pvzIDs ...uuid.UUID) string {
 	req := dummyLoginRequest{
-		Role: role,
+		Role:   role,
+		PVZIDs: pvzIDs,
 	}
 	reqBody, err := json.Marshal(req)
 	require.NoError(t, err, "Failed to marshal request body")